@@ -0,0 +1,73 @@
+// Copyright 2020 The Cluster Monitoring Operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/ghodss/yaml"
+
+	"github.com/openshift/cluster-monitoring-operator/pkg/client"
+	"github.com/openshift/cluster-monitoring-operator/pkg/manifests"
+	cmo "github.com/openshift/cluster-monitoring-operator/pkg/operator"
+)
+
+// dryRun runs the operator's tasks once with server-side dry-run and writes
+// the planned changes as YAML to stdout. Changes of the tasks that failed
+// are incomplete, their errors are written to stderr.
+func dryRun(o *cmo.Operator, config *manifests.Config) int {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	term := make(chan os.Signal, 1)
+	signal.Notify(term, os.Interrupt, syscall.SIGTERM)
+	go func() {
+		select {
+		case <-term:
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+
+	plan, results, err := o.DryRun(ctx, config)
+	if plan == nil {
+		fmt.Fprint(os.Stderr, err)
+		return 1
+	}
+
+	b, merr := yaml.Marshal(struct {
+		Changes []client.Change `json:"changes"`
+	}{plan.Changes()})
+	if merr != nil {
+		fmt.Fprint(os.Stderr, merr)
+		return 1
+	}
+	os.Stdout.Write(b)
+
+	if err == nil {
+		return 0
+	}
+	for _, res := range results.Failed() {
+		fmt.Fprintf(os.Stderr, "%s: %v\n", res.Name, res.Err)
+	}
+	if len(results.Failed()) == 0 {
+		fmt.Fprintln(os.Stderr, err)
+	}
+	return 1
+}
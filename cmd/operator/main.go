@@ -24,15 +24,19 @@ import (
 	"os/signal"
 	"strings"
 	"syscall"
+	"time"
 
+	"github.com/pkg/errors"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"golang.org/x/sync/errgroup"
 	"k8s.io/apimachinery/pkg/util/yaml"
+	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/tools/clientcmd"
 	"k8s.io/klog"
 
 	cmo "github.com/openshift/cluster-monitoring-operator/pkg/operator"
+	"github.com/openshift/cluster-monitoring-operator/pkg/server"
 )
 
 type images map[string]string
@@ -82,7 +86,30 @@ type telemetryConfig struct {
 	Matches []string `json:"matches"`
 }
 
+func loadTelemetryConfig(path string) (*telemetryConfig, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("Could not open telemetry config file: %v", err)
+	}
+	defer f.Close()
+
+	telemetryConfig := &telemetryConfig{}
+	err = yaml.NewYAMLOrJSONDecoder(f, 100).Decode(telemetryConfig)
+	if err != nil {
+		return nil, fmt.Errorf("Could not parse telemetry config file: %v", err)
+	}
+
+	return telemetryConfig, nil
+}
+
 func Main() int {
+	if len(os.Args) > 1 && os.Args[1] == "render" {
+		return Render(os.Args[2:])
+	}
+	if len(os.Args) > 1 && os.Args[1] == "telemetry-preview" {
+		return TelemetryPreview(os.Args[2:])
+	}
+
 	flagset := flag.CommandLine
 	klog.InitFlags(flagset)
 	namespace := flagset.String("namespace", "openshift-monitoring", "Namespace to deploy and manage cluster monitoring stack in.")
@@ -94,25 +121,29 @@ func Main() int {
 	releaseVersion := flagset.String("release-version", "", "Currently targeted release version to be reconciled against.")
 	telemetryConfigFile := flagset.String("telemetry-config", "/etc/cluster-monitoring-operator/telemetry/metrics.yaml", "Path to telemetry-config.")
 	remoteWrite := flagset.Bool("enabled-remote-write", false, "Wether to use legacy telemetry write protocol or Prometheus remote write.")
+	leaderElect := flagset.Bool("leader-elect", false, "Whether to acquire a lease before reconciling so that multiple replicas can run.")
+	leaderElectNamespace := flagset.String("leader-elect-namespace", "", "Namespace of the leader election Lease. Defaults to the namespace of the monitoring stack.")
+	leaderElectLeaseDuration := flagset.Duration("leader-elect-lease-duration", 137*time.Second, "Duration that standby replicas wait before trying to acquire an unrenewed lease.")
+	leaderElectRenewDeadline := flagset.Duration("leader-elect-renew-deadline", 107*time.Second, "Duration that the leader retries renewing the lease before giving it up.")
+	leaderElectRetryPeriod := flagset.Duration("leader-elect-retry-period", 26*time.Second, "Duration between attempts to acquire or renew the lease.")
+	taskTimeout := flagset.Duration("task-timeout", 10*time.Minute, "Maximum duration of a single reconciliation task. Zero disables the timeout.")
+	listenAddress := flagset.String("listen-address", "127.0.0.1:8080", "Address to serve the metrics, health and pprof endpoints on.")
+	healthListenAddress := flagset.String("health-listen-address", "", "Additional address to serve only the /healthz and /readyz endpoints on, e.g. for kubelet probes. Disabled if empty.")
+	secureListenAddress := flagset.String("secure-listen-address", "", "Address to serve the metrics endpoint on over TLS to clients authorized by the API server. Disabled if empty.")
+	tlsCertFile := flagset.String("tls-cert-file", "", "Path to the serving certificate of the secure listen address. Reloaded when it changes.")
+	tlsKeyFile := flagset.String("tls-private-key-file", "", "Path to the private key of the serving certificate.")
+	tlsCipherSuites := flagset.String("tls-cipher-suites", "", "Comma-separated list of cipher suites accepted on the secure listen address. Go's defaults are used if empty.")
+	degradedThreshold := flagset.Int("degraded-failure-threshold", 1, "Number of consecutive failed syncs before the ClusterOperator reports Degraded. Failed syncs are retried with an exponential backoff starting at a few milliseconds.")
+	degradedGracePeriod := flagset.Duration("degraded-grace-period", 5*time.Minute, "Duration failed syncs must last before the ClusterOperator reports Degraded. Invalid configurations are reported right away.")
+	stallTimeout := flagset.Duration("stall-timeout", time.Hour, "Duration without progress of the reconciliation worker after which /healthz fails. Zero disables the check.")
+	dryRunMode := flagset.Bool("dry-run", false, "Reconcile once with server-side dry-run, print the planned changes and exit.")
 	images := images{}
 	flag.Var(&images, "images", "Images to use for containers managed by the cluster-monitoring-operator.")
 	flag.Parse()
 
-	f, err := os.Open(*telemetryConfigFile)
-	if err != nil {
-		fmt.Fprintf(os.Stderr, "Could not open telemetry config file: %v", err)
-		return 1
-	}
-
-	telemetryConfig := telemetryConfig{}
-	err = yaml.NewYAMLOrJSONDecoder(f, 100).Decode(&telemetryConfig)
+	telemetryConfig, err := loadTelemetryConfig(*telemetryConfigFile)
 	if err != nil {
-		fmt.Fprintf(os.Stderr, "Could not parse telemetry config file: %v", err)
-		return 1
-	}
-	err = f.Close()
-	if err != nil {
-		fmt.Fprintf(os.Stderr, "Could close telemetry config file: %v", err)
+		fmt.Fprint(os.Stderr, err)
 		return 1
 	}
 
@@ -139,6 +170,11 @@ func Main() int {
 		klog.V(4).Infof("Release version set to %v", *releaseVersion)
 	}
 
+	if *secureListenAddress != "" && (*tlsCertFile == "" || *tlsKeyFile == "") {
+		ok = false
+		fmt.Fprint(os.Stderr, "`--tls-cert-file` and `--tls-private-key-file` flags are required with `--secure-listen-address`.")
+	}
+
 	if !ok {
 		return 1
 	}
@@ -162,6 +198,36 @@ func Main() int {
 		return 1
 	}
 
+	o.SetTaskTimeout(*taskTimeout)
+	o.SetStallTimeout(*stallTimeout)
+	o.SetFailureDampening(*degradedThreshold, *degradedGracePeriod)
+	if *dryRunMode {
+		return dryRun(o, nil)
+	}
+
+	if *leaderElect {
+		// The hostname is the pod name and therefore unique among replicas.
+		identity, err := os.Hostname()
+		if err != nil {
+			fmt.Fprint(os.Stderr, err)
+			return 1
+		}
+
+		leNamespace := *leaderElectNamespace
+		if leNamespace == "" {
+			leNamespace = *namespace
+		}
+
+		o.EnableLeaderElection(cmo.LeaderElectionConfig{
+			Namespace:     leNamespace,
+			Name:          "cluster-monitoring-operator",
+			Identity:      identity,
+			LeaseDuration: *leaderElectLeaseDuration,
+			RenewDeadline: *leaderElectRenewDeadline,
+			RetryPeriod:   *leaderElectRetryPeriod,
+		})
+	}
+
 	o.RegisterMetrics(r)
 	mux := http.NewServeMux()
 	mux.Handle("/metrics", promhttp.HandlerFor(r, promhttp.HandlerOpts{}))
@@ -170,14 +236,51 @@ func Main() int {
 	mux.HandleFunc("/debug/pprof/profile", pprof.Profile)
 	mux.HandleFunc("/debug/pprof/symbol", pprof.Symbol)
 	mux.HandleFunc("/debug/pprof/trace", pprof.Trace)
-	go http.ListenAndServe("127.0.0.1:8080", mux)
+	handleHealth(mux, o)
+
+	var secureServer *server.Server
+	if *secureListenAddress != "" {
+		var suites []string
+		if *tlsCipherSuites != "" {
+			suites = strings.Split(*tlsCipherSuites, ",")
+		}
+
+		kclient, err := kubernetes.NewForConfig(config)
+		if err != nil {
+			fmt.Fprint(os.Stderr, err)
+			return 1
+		}
+
+		secureMux := http.NewServeMux()
+		secureMux.Handle("/metrics", promhttp.HandlerFor(r, promhttp.HandlerOpts{}))
+		srv, err := server.New(server.Config{
+			ListenAddress: *secureListenAddress,
+			CertFile:      *tlsCertFile,
+			KeyFile:       *tlsKeyFile,
+			CipherSuites:  suites,
+		}, kclient, secureMux)
+		if err != nil {
+			fmt.Fprint(os.Stderr, err)
+			return 1
+		}
+		secureServer = srv
+	}
 
 	ctx, cancel := context.WithCancel(context.Background())
 	wg, ctx := errgroup.WithContext(ctx)
 
-	wg.Go(func() error { return o.Run(ctx.Done()) })
+	wg.Go(func() error { return o.Run(ctx) })
+	wg.Go(func() error { return serve(ctx, *listenAddress, mux) })
+	if secureServer != nil {
+		wg.Go(func() error { return secureServer.Run(ctx) })
+	}
+	if *healthListenAddress != "" {
+		healthMux := http.NewServeMux()
+		handleHealth(healthMux, o)
+		wg.Go(func() error { return serve(ctx, *healthListenAddress, healthMux) })
+	}
 
-	term := make(chan os.Signal)
+	term := make(chan os.Signal, 1)
 	signal.Notify(term, os.Interrupt, syscall.SIGTERM)
 
 	select {
@@ -195,6 +298,42 @@ func Main() int {
 	return 0
 }
 
+// handleHealth registers the liveness and readiness endpoints of o with mux.
+func handleHealth(mux *http.ServeMux, o *cmo.Operator) {
+	mux.HandleFunc("/healthz", func(w http.ResponseWriter, _ *http.Request) {
+		writeHealth(w, o.Healthy())
+	})
+	mux.HandleFunc("/readyz", func(w http.ResponseWriter, req *http.Request) {
+		ctx, cancel := context.WithTimeout(req.Context(), 5*time.Second)
+		defer cancel()
+		writeHealth(w, o.Ready(ctx))
+	})
+}
+
+func writeHealth(w http.ResponseWriter, err error) {
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusServiceUnavailable)
+		return
+	}
+	fmt.Fprintln(w, "ok")
+}
+
+// serve serves handler on addr until ctx is done.
+func serve(ctx context.Context, addr string, handler http.Handler) error {
+	srv := &http.Server{Addr: addr, Handler: handler}
+	errc := make(chan error, 1)
+	go func() { errc <- srv.ListenAndServe() }()
+
+	select {
+	case err := <-errc:
+		return errors.Wrapf(err, "serving on %s failed", addr)
+	case <-ctx.Done():
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		return srv.Shutdown(shutdownCtx)
+	}
+}
+
 func main() {
 	os.Exit(Main())
 }
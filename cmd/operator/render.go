@@ -0,0 +1,181 @@
+// Copyright 2020 The Cluster Monitoring Operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"strings"
+
+	configv1 "github.com/openshift/api/config/v1"
+	"k8s.io/client-go/tools/clientcmd"
+
+	"github.com/openshift/cluster-monitoring-operator/pkg/manifests"
+	cmo "github.com/openshift/cluster-monitoring-operator/pkg/operator"
+	"github.com/openshift/cluster-monitoring-operator/pkg/render"
+)
+
+// Render writes the manifests the operator would reconcile for the given
+// configuration into a directory without connecting to a cluster.
+func Render(args []string) int {
+	flagset := flag.NewFlagSet("render", flag.ContinueOnError)
+	namespace := flagset.String("namespace", "openshift-monitoring", "Namespace to deploy and manage cluster monitoring stack in.")
+	namespaceUserWorkload := flagset.String("namespace-user-workload", "openshift-user-workload-monitoring", "Namespace to deploy and manage user workload monitoring stack in.")
+	configFile := flagset.String("config", "", "Path to the cluster monitoring configuration (the content of the 'config.yaml' key). Defaults are used if not set, in dry-run mode the configuration is read from the cluster instead.")
+	userWorkloadConfigFile := flagset.String("user-workload-config", "", "Path to the user workload monitoring configuration (the content of the 'config.yaml' key). In dry-run mode the configuration is read from the cluster if not set.")
+	telemetryConfigFile := flagset.String("telemetry-config", "", "Path to telemetry-config.")
+	remoteWrite := flagset.Bool("enabled-remote-write", false, "Wether to use legacy telemetry write protocol or Prometheus remote write.")
+	outputDir := flagset.String("output-dir", "", "Directory to write the rendered manifests to. One subdirectory is created per task.")
+	dryRunMode := flagset.Bool("dry-run", false, "Reconcile the configuration once against a cluster with server-side dry-run and print the planned changes instead of writing manifests. The cluster state flags below are ignored, the state is read from the cluster.")
+	kubeconfigPath := flagset.String("kubeconfig", "", "The path to the kubeconfig to connect to the apiserver with in dry-run mode.")
+	apiserver := flagset.String("apiserver", "", "The address of the apiserver to talk to in dry-run mode.")
+	namespaceSelector := flagset.String("namespace-selector", "openshift.io/cluster-monitoring=true", "Selector for namespaces to monitor in dry-run mode.")
+	configMapName := flagset.String("configmap", "cluster-monitoring-config", "ConfigMap name to read the cluster monitoring configuration from in dry-run mode.")
+	userWorkloadConfigMapName := flagset.String("user-workload-configmap", "user-workload-monitoring-config", "ConfigMap name to read the user workload monitoring configuration from in dry-run mode.")
+	images := images{}
+	flagset.Var(&images, "images", "Images to use for containers managed by the cluster-monitoring-operator.")
+
+	// Stubs for the state which is otherwise read from the cluster.
+	platform := flagset.String("platform", "", "Infrastructure platform of the cluster, e.g. AWS.")
+	httpProxy := flagset.String("http-proxy", "", "HTTP proxy of the cluster-wide proxy configuration.")
+	httpsProxy := flagset.String("https-proxy", "", "HTTPS proxy of the cluster-wide proxy configuration.")
+	noProxy := flagset.String("no-proxy", "", "No proxy list of the cluster-wide proxy configuration.")
+	clusterID := flagset.String("cluster-id", "00000000-0000-0000-0000-000000000000", "Cluster ID used if not set in the configuration.")
+	telemeterToken := flagset.String("telemeter-token", "", "Telemeter token used if not set in the configuration.")
+	appsDomain := flagset.String("apps-domain", "apps.example.com", "Domain used to generate the host names of routes.")
+	namespacesToMonitor := flagset.String("namespaces-to-monitor", "", "Comma-separated list of namespaces watched by the Prometheus operator.")
+	clusterObjectsFile := flagset.String("cluster-objects", "", "Path to YAML documents of further objects existing in the cluster, e.g. the secret referenced by grpcTLS.caSecret or the objects of unmanaged components.")
+
+	if err := flagset.Parse(args); err != nil {
+		return 2
+	}
+
+	if *outputDir == "" && !*dryRunMode {
+		fmt.Fprint(os.Stderr, "`--output-dir` flag is required, but not specified.")
+		return 1
+	}
+
+	c, err := loadRenderConfig(*configFile, *userWorkloadConfigFile)
+	if err != nil {
+		fmt.Fprint(os.Stderr, err)
+		return 1
+	}
+
+	var matches []string
+	if *telemetryConfigFile != "" {
+		telemetryConfig, err := loadTelemetryConfig(*telemetryConfigFile)
+		if err != nil {
+			fmt.Fprint(os.Stderr, err)
+			return 1
+		}
+		matches = telemetryConfig.Matches
+	}
+
+	if *dryRunMode {
+		config, err := clientcmd.BuildConfigFromFlags(*apiserver, *kubeconfigPath)
+		if err != nil {
+			fmt.Fprint(os.Stderr, err)
+			return 1
+		}
+
+		o, err := cmo.New(config, "", *namespace, *namespaceUserWorkload, *namespaceSelector, *configMapName, *userWorkloadConfigMapName, *remoteWrite, images.asMap(), matches)
+		if err != nil {
+			fmt.Fprint(os.Stderr, err)
+			return 1
+		}
+
+		// Let the operator read the configuration missing from the flags
+		// from the cluster.
+		switch {
+		case *configFile == "":
+			c = nil
+		case *userWorkloadConfigFile == "":
+			c.UserWorkloadConfiguration = nil
+		}
+		return dryRun(o, c)
+	}
+
+	cluster := &render.ClusterContext{
+		Platform:       configv1.PlatformType(*platform),
+		HTTPProxy:      *httpProxy,
+		HTTPSProxy:     *httpsProxy,
+		NoProxy:        *noProxy,
+		ClusterID:      *clusterID,
+		TelemeterToken: *telemeterToken,
+		AppsDomain:     *appsDomain,
+	}
+	if *namespacesToMonitor != "" {
+		cluster.NamespacesToMonitor = strings.Split(*namespacesToMonitor, ",")
+	}
+
+	if *clusterObjectsFile != "" {
+		f, err := os.Open(*clusterObjectsFile)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Could not open cluster objects file: %v", err)
+			return 1
+		}
+		defer f.Close()
+
+		cluster.Objects, err = render.ReadObjects(f)
+		if err != nil {
+			fmt.Fprint(os.Stderr, err)
+			return 1
+		}
+	}
+
+	tasks, err := render.New(*namespace, *namespaceUserWorkload, c, cluster, images.asMap(), matches, *remoteWrite).Render()
+	if err != nil {
+		fmt.Fprint(os.Stderr, err)
+		return 1
+	}
+
+	if err := render.Write(*outputDir, tasks); err != nil {
+		fmt.Fprint(os.Stderr, err)
+		return 1
+	}
+
+	return 0
+}
+
+func loadRenderConfig(configFile, userWorkloadConfigFile string) (*manifests.Config, error) {
+	c := manifests.NewDefaultConfig()
+	if configFile != "" {
+		b, err := ioutil.ReadFile(configFile)
+		if err != nil {
+			return nil, fmt.Errorf("Could not read config file: %v", err)
+		}
+
+		c, err = manifests.NewConfigFromString(string(b))
+		if err != nil {
+			return nil, fmt.Errorf("Could not parse config file: %v", err)
+		}
+	}
+
+	if userWorkloadConfigFile != "" && c.IsUserWorkloadEnabled() {
+		b, err := ioutil.ReadFile(userWorkloadConfigFile)
+		if err != nil {
+			return nil, fmt.Errorf("Could not read user workload config file: %v", err)
+		}
+
+		c.UserWorkloadConfiguration, err = manifests.NewUserConfigFromString(string(b))
+		if err != nil {
+			return nil, fmt.Errorf("Could not parse user workload config file: %v", err)
+		}
+	}
+
+	return c, nil
+}
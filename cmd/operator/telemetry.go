@@ -0,0 +1,128 @@
+// Copyright 2020 The Cluster Monitoring Operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"bufio"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/openshift/cluster-monitoring-operator/pkg/telemetry"
+)
+
+// TelemetryPreview prints which series of a series dump the telemetry
+// matches would send to Telemeter.
+func TelemetryPreview(args []string) int {
+	flagset := flag.NewFlagSet("telemetry-preview", flag.ContinueOnError)
+	telemetryConfigFile := flagset.String("telemetry-config", "", "Path to telemetry-config, the content of the 'metrics.yaml' key.")
+	seriesFile := flagset.String("series", "-", "Path to the series dump, either metrics in the Prometheus text exposition format or a response of the Prometheus /api/v1/series endpoint. Reads from stdin if set to '-'.")
+	showSeries := flagset.Bool("show-series", false, "List the series selected by every match.")
+
+	if err := flagset.Parse(args); err != nil {
+		return 2
+	}
+
+	if *telemetryConfigFile == "" {
+		fmt.Fprint(os.Stderr, "`--telemetry-config` flag is required, but not specified.")
+		return 1
+	}
+
+	telemetryConfig, err := loadTelemetryConfig(*telemetryConfigFile)
+	if err != nil {
+		fmt.Fprint(os.Stderr, err)
+		return 1
+	}
+
+	var r io.Reader = os.Stdin
+	if *seriesFile != "-" {
+		f, err := os.Open(*seriesFile)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Could not open series file: %v", err)
+			return 1
+		}
+		defer f.Close()
+		r = f
+	}
+
+	series, err := telemetry.ParseSeries(r)
+	if err != nil {
+		fmt.Fprint(os.Stderr, err)
+		return 1
+	}
+
+	report, err := telemetry.Simulate(telemetryConfig.Matches, series)
+	if err != nil {
+		fmt.Fprint(os.Stderr, err)
+		return 1
+	}
+
+	w := bufio.NewWriter(os.Stdout)
+	defer w.Flush()
+	printTelemetryReport(w, report, len(series), *showSeries)
+	return 0
+}
+
+func printTelemetryReport(w io.Writer, report *telemetry.Report, total int, showSeries bool) {
+	fmt.Fprintln(w, "Matches:")
+	for _, rule := range report.Rules {
+		note := ""
+		switch {
+		case len(rule.Series) == 0:
+			note = " (matches nothing)"
+		case rule.Redundant:
+			note = " (redundant)"
+		}
+		fmt.Fprintf(w, "  %s: %d series%s\n", rule.Match, len(rule.Series), note)
+		if showSeries {
+			for _, s := range rule.Series {
+				fmt.Fprintf(w, "    %s\n", s)
+			}
+		}
+	}
+
+	cardinality := report.Cardinality()
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, "Cardinality:")
+	for _, name := range telemetry.MetricNames(cardinality) {
+		fmt.Fprintf(w, "  %s: %d\n", name, cardinality[name])
+	}
+
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, "Series sent:")
+	for _, s := range report.Series {
+		fmt.Fprintf(w, "  %s\n", s)
+	}
+
+	fmt.Fprintln(w)
+	fmt.Fprintf(w, "Total: %d of %d series sent\n", len(report.Series), total)
+	fmt.Fprintf(w, "Recording rule cluster:telemetry_selected_series:count: %d\n  %s\n", report.RecordingRuleCount, report.RecordingRule)
+
+	if len(report.RelabelMismatches) > 0 {
+		fmt.Fprintln(w)
+		fmt.Fprintln(w, "Series handled differently by the remote write relabel configs:")
+		for _, m := range report.RelabelMismatches {
+			switch {
+			case m.Relabeled == nil:
+				fmt.Fprintf(w, "  %s: selected but dropped\n", m.Series)
+			case !m.Selected:
+				fmt.Fprintf(w, "  %s: not selected but kept as %s\n", m.Series, m.Relabeled)
+			default:
+				fmt.Fprintf(w, "  %s: kept as %s\n", m.Series, m.Relabeled)
+			}
+		}
+	}
+}
@@ -0,0 +1,166 @@
+// Copyright 2020 The Cluster Monitoring Operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+	v1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/klog"
+)
+
+// legacyFieldManagers are the field managers recorded for the writes of
+// earlier versions of the operator, which updated objects instead of
+// applying them. Conflicts with them aren't reported.
+var legacyFieldManagers = map[string]struct{}{
+	// Derived from the binary name before the user agent was set.
+	"operator": {},
+	// Objects created or updated with the current user agent.
+	FieldManager: {},
+}
+
+// applyPatchFunc sends an apply patch with the given options.
+type applyPatchFunc func(data []byte, opts metav1.PatchOptions) error
+
+// apply sends obj as a server-side apply patch owned by FieldManager. Fields
+// not set in obj are left to their current managers. If the patch conflicts
+// with fields owned by other field managers, e.g. after a `kubectl scale` or
+// `kubectl edit`, it is applied again with force to restore the desired
+// state. It returns the conflicting managers other than the legacy field
+// managers of the operator itself.
+func apply(obj runtime.Object, gvk schema.GroupVersionKind, patch applyPatchFunc) ([]string, error) {
+	data, err := applyConfiguration(obj, gvk)
+	if err != nil {
+		return nil, errors.Wrapf(err, "encoding %s apply configuration failed", gvk.Kind)
+	}
+
+	force := false
+	opts := metav1.PatchOptions{FieldManager: FieldManager, Force: &force}
+	err = patch(data, opts)
+
+	managers := conflictingManagers(err)
+	if len(managers) == 0 {
+		return nil, err
+	}
+
+	var others []string
+	for _, m := range managers {
+		if _, ok := legacyFieldManagers[m]; !ok {
+			others = append(others, m)
+		}
+	}
+
+	force = true
+	if err := patch(data, opts); err != nil {
+		return others, errors.Wrapf(err, "applying with force over fields managed by %s failed", strings.Join(quote(managers), ", "))
+	}
+	return others, nil
+}
+
+// applyObject records obj in the inventory of the client and applies it.
+// Fields taken over from other field managers are reported in a log line, an
+// event and the field conflicts metric.
+func (c *Client) applyObject(ctx context.Context, obj runtime.Object, gvk schema.GroupVersionKind, patch applyPatchFunc) error {
+	m, err := meta.Accessor(obj)
+	if err != nil {
+		return err
+	}
+	c.record(gvk.Kind, m.GetNamespace(), m.GetName())
+
+	managers, err := apply(obj, gvk, patch)
+	if len(managers) == 0 {
+		return err
+	}
+
+	klog.Warningf("Restoring fields of %s %s/%s changed by %s", gvk.Kind, m.GetNamespace(), m.GetName(), strings.Join(quote(managers), ", "))
+	for _, manager := range managers {
+		c.fieldConflicts.WithLabelValues(gvk.Kind, manager).Inc()
+	}
+	c.objectEventf(ctx, v1.ObjectReference{
+		APIVersion: gvk.GroupVersion().String(),
+		Kind:       gvk.Kind,
+		Namespace:  m.GetNamespace(),
+		Name:       m.GetName(),
+		UID:        m.GetUID(),
+	}, v1.EventTypeWarning, "FieldManagerConflict", "Restored the fields changed by %s", strings.Join(quote(managers), ", "))
+
+	return err
+}
+
+// applyConfiguration returns the apply patch of obj.
+func applyConfiguration(obj runtime.Object, gvk schema.GroupVersionKind) ([]byte, error) {
+	obj = obj.DeepCopyObject()
+	obj.GetObjectKind().SetGroupVersionKind(gvk)
+
+	m, err := meta.Accessor(obj)
+	if err != nil {
+		return nil, err
+	}
+	// Objects read from the API carry fields that must not be applied.
+	m.SetResourceVersion("")
+	m.SetManagedFields(nil)
+
+	return json.Marshal(obj)
+}
+
+// conflictingManagers returns the sorted field managers owning the fields
+// an apply request conflicted with.
+func conflictingManagers(err error) []string {
+	status, ok := err.(apierrors.APIStatus)
+	if !ok || status.Status().Reason != metav1.StatusReasonConflict || status.Status().Details == nil {
+		return nil
+	}
+
+	seen := map[string]struct{}{}
+	for _, cause := range status.Status().Details.Causes {
+		if cause.Type != metav1.CauseTypeFieldManagerConflict {
+			continue
+		}
+
+		// The message reads `conflict with "<manager>"`, followed by the
+		// API version and time for updates.
+		var manager string
+		if _, err := fmt.Sscanf(cause.Message, "conflict with %q", &manager); err != nil {
+			manager = cause.Message
+		}
+		seen[manager] = struct{}{}
+	}
+
+	managers := make([]string, 0, len(seen))
+	for m := range seen {
+		managers = append(managers, m)
+	}
+	sort.Strings(managers)
+
+	return managers
+}
+
+func quote(s []string) []string {
+	q := make([]string, len(s))
+	for i := range s {
+		q[i] = fmt.Sprintf("%q", s[i])
+	}
+	return q
+}
@@ -0,0 +1,207 @@
+// Copyright 2020 The Cluster Monitoring Operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	appsv1 "k8s.io/api/apps/v1"
+	v1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+)
+
+func applyConflict(managers ...string) error {
+	causes := make([]metav1.StatusCause, len(managers))
+	for i, m := range managers {
+		causes[i] = metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldManagerConflict,
+			Message: `conflict with "` + m + `" using v1 at 2020-06-01T00:00:00Z`,
+			Field:   ".data.foo",
+		}
+	}
+	return apierrors.NewApplyConflict(causes, "Apply failed")
+}
+
+func TestApplyConfiguration(t *testing.T) {
+	cm := &v1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            "foo",
+			Namespace:       "bar",
+			ResourceVersion: "42",
+			ManagedFields:   []metav1.ManagedFieldsEntry{{Manager: "kubectl"}},
+		},
+		Data: map[string]string{"foo": "bar"},
+	}
+
+	data, err := applyConfiguration(cm, v1.SchemeGroupVersion.WithKind("ConfigMap"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var applied v1.ConfigMap
+	if err := json.Unmarshal(data, &applied); err != nil {
+		t.Fatal(err)
+	}
+	if applied.APIVersion != "v1" || applied.Kind != "ConfigMap" {
+		t.Fatalf("expected v1/ConfigMap, got %s/%s", applied.APIVersion, applied.Kind)
+	}
+	if applied.ResourceVersion != "" || applied.ManagedFields != nil {
+		t.Fatalf("expected no resource version and managed fields, got %q and %v", applied.ResourceVersion, applied.ManagedFields)
+	}
+	if cm.ResourceVersion != "42" || cm.Kind != "" {
+		t.Fatal("expected the original object to be left alone")
+	}
+}
+
+func TestApply(t *testing.T) {
+	for _, tc := range []struct {
+		name string
+		errs []error
+		// forced is whether the last patch was forced.
+		forced   bool
+		managers []string
+		err      string
+	}{
+		{
+			name: "no conflict",
+			errs: []error{nil},
+		},
+		{
+			name: "other error",
+			errs: []error{apierrors.NewNotFound(v1.Resource("configmaps"), "foo")},
+			err:  "not found",
+		},
+		{
+			name:     "conflict",
+			errs:     []error{applyConflict("kubectl-edit", "some-controller", "kubectl-edit"), nil},
+			forced:   true,
+			managers: []string{"kubectl-edit", "some-controller"},
+		},
+		{
+			name:     "conflict with legacy and other managers",
+			errs:     []error{applyConflict("operator", "kubectl-edit"), nil},
+			forced:   true,
+			managers: []string{"kubectl-edit"},
+		},
+		{
+			name:   "conflict with legacy managers",
+			errs:   []error{applyConflict("operator", FieldManager), nil},
+			forced: true,
+		},
+		{
+			name:     "forced apply error",
+			errs:     []error{applyConflict("kubectl-edit"), apierrors.NewNotFound(v1.Resource("configmaps"), "foo")},
+			forced:   true,
+			managers: []string{"kubectl-edit"},
+			err:      `applying with force over fields managed by "kubectl-edit" failed`,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			var calls []metav1.PatchOptions
+			managers, err := apply(&v1.ConfigMap{}, v1.SchemeGroupVersion.WithKind("ConfigMap"), func(data []byte, opts metav1.PatchOptions) error {
+				calls = append(calls, opts)
+				return tc.errs[len(calls)-1]
+			})
+
+			if tc.err == "" && err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+			if tc.err != "" && (err == nil || !strings.Contains(err.Error(), tc.err)) {
+				t.Fatalf("expected error containing %q, got %v", tc.err, err)
+			}
+			if !reflect.DeepEqual(managers, tc.managers) {
+				t.Fatalf("expected conflicting managers %v, got %v", tc.managers, managers)
+			}
+
+			if len(calls) != len(tc.errs) {
+				t.Fatalf("expected %d patches, got %d", len(tc.errs), len(calls))
+			}
+			last := calls[len(calls)-1]
+			if last.FieldManager != FieldManager {
+				t.Fatalf("expected field manager %q, got %q", FieldManager, last.FieldManager)
+			}
+			if *last.Force != tc.forced {
+				t.Fatalf("expected force %v, got %v", tc.forced, *last.Force)
+			}
+		})
+	}
+}
+
+func TestApplyObjectConflict(t *testing.T) {
+	var events []v1.Event
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		var ev v1.Event
+		if err := json.NewDecoder(req.Body).Decode(&ev); err != nil {
+			t.Error(err)
+			return
+		}
+		events = append(events, ev)
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		json.NewEncoder(w).Encode(ev)
+	}))
+	defer srv.Close()
+
+	kclient, err := kubernetes.NewForConfig(&rest.Config{Host: srv.URL})
+	if err != nil {
+		t.Fatal(err)
+	}
+	c := &Client{fieldConflicts: newFieldConflictsCounterVec(), events: newEventRecorder(kclient.CoreV1())}
+
+	// A scaled down Deployment is restored.
+	dep := &appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Namespace: "openshift-monitoring", Name: "thanos-querier"}}
+	errs := []error{applyConflict("kubectl", "operator"), nil}
+	err = c.applyObject(context.Background(), dep, appsv1.SchemeGroupVersion.WithKind("Deployment"), func(data []byte, opts metav1.PatchOptions) error {
+		err := errs[0]
+		errs = errs[1:]
+		return err
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	r := prometheus.NewRegistry()
+	r.MustRegister(c.fieldConflicts)
+	mfs, err := r.Gather()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(mfs) != 1 || len(mfs[0].GetMetric()) != 1 || mfs[0].GetMetric()[0].GetCounter().GetValue() != 1 {
+		t.Fatalf("expected a single conflict to be counted, got %v", mfs)
+	}
+	for _, l := range mfs[0].GetMetric()[0].GetLabel() {
+		if l.GetName() == "manager" && l.GetValue() != "kubectl" {
+			t.Fatalf("expected the conflict with kubectl to be counted, got %q", l.GetValue())
+		}
+	}
+
+	if len(events) != 1 {
+		t.Fatalf("expected 1 event, got %d", len(events))
+	}
+	ev := events[0]
+	if ev.Reason != "FieldManagerConflict" || ev.InvolvedObject.Kind != "Deployment" || ev.InvolvedObject.Name != "thanos-querier" || !strings.Contains(ev.Message, `"kubectl"`) {
+		t.Fatalf("unexpected event %+v", ev)
+	}
+}
@@ -0,0 +1,158 @@
+// Copyright 2020 The Cluster Monitoring Operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"bytes"
+	"crypto/x509"
+	"encoding/pem"
+	"strconv"
+	"sync"
+
+	"github.com/prometheus/client_golang/prometheus"
+	v1 "k8s.io/api/core/v1"
+)
+
+var pemCertificateHeader = []byte("-----BEGIN CERTIFICATE-----")
+
+// certObject identifies a secret or configmap holding certificates.
+type certObject struct {
+	kind, namespace, name string
+}
+
+// certSeries identifies the series of the certificates of one type in a key
+// of an object.
+type certSeries struct {
+	key, ca, subject string
+}
+
+// certExpiry exports the expiration time of the certificates found in the
+// secrets and configmaps read or written by the operator.
+type certExpiry struct {
+	notAfter *prometheus.GaugeVec
+
+	mtx sync.Mutex
+	// series holds the series reported for each object so that the series
+	// of replaced certificates, removed keys and deleted objects can be
+	// dropped.
+	series map[certObject]map[certSeries]struct{}
+}
+
+func newCertExpiry() *certExpiry {
+	return &certExpiry{
+		notAfter: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Name: "cluster_monitoring_operator_certificate_expiration_timestamp_seconds",
+			Help: "The notAfter timestamp of the certificates read or written by the operator by object and key. CA and leaf certificates are reported apart, the earliest notAfter timestamp of each is reported along with the subject of the certificate, e.g. of all the CAs of a bundle.",
+		}, []string{"kind", "namespace", "name", "key", "ca", "subject"}),
+		series: map[certObject]map[certSeries]struct{}{},
+	}
+}
+
+// observe reports the certificates found in the data of an object. Keys
+// without PEM encoded certificates are ignored.
+func (e *certExpiry) observe(kind, namespace, name string, data map[string][]byte) {
+	o := certObject{kind: kind, namespace: namespace, name: name}
+	series := map[certSeries]struct{}{}
+	for k, v := range data {
+		for ca, cert := range earliestExpiringCertificates(v) {
+			cs := certSeries{key: k, ca: strconv.FormatBool(ca), subject: cert.Subject.CommonName}
+			series[cs] = struct{}{}
+			e.notAfter.WithLabelValues(kind, namespace, name, cs.key, cs.ca, cs.subject).Set(float64(cert.NotAfter.Unix()))
+		}
+	}
+
+	e.mtx.Lock()
+	defer e.mtx.Unlock()
+
+	for cs := range e.series[o] {
+		if _, ok := series[cs]; !ok {
+			e.notAfter.DeleteLabelValues(kind, namespace, name, cs.key, cs.ca, cs.subject)
+		}
+	}
+	if len(series) == 0 {
+		delete(e.series, o)
+		return
+	}
+	e.series[o] = series
+}
+
+// forget drops the series of a deleted object.
+func (e *certExpiry) forget(kind, namespace, name string) {
+	o := certObject{kind: kind, namespace: namespace, name: name}
+
+	e.mtx.Lock()
+	defer e.mtx.Unlock()
+
+	for cs := range e.series[o] {
+		e.notAfter.DeleteLabelValues(kind, namespace, name, cs.key, cs.ca, cs.subject)
+	}
+	delete(e.series, o)
+}
+
+func (e *certExpiry) observeSecret(s *v1.Secret) {
+	if s == nil {
+		return
+	}
+	data := make(map[string][]byte, len(s.Data)+len(s.StringData))
+	for k, v := range s.Data {
+		data[k] = v
+	}
+	for k, v := range s.StringData {
+		data[k] = []byte(v)
+	}
+	e.observe("Secret", s.Namespace, s.Name, data)
+}
+
+func (e *certExpiry) observeConfigMap(cm *v1.ConfigMap) {
+	if cm == nil {
+		return
+	}
+	data := make(map[string][]byte, len(cm.Data)+len(cm.BinaryData))
+	for k, v := range cm.Data {
+		data[k] = []byte(v)
+	}
+	for k, v := range cm.BinaryData {
+		data[k] = v
+	}
+	e.observe("ConfigMap", cm.Namespace, cm.Name, data)
+}
+
+// earliestExpiringCertificates returns the valid PEM encoded CA and leaf
+// certificates of b which expire first, keyed by whether they are CAs. CAs
+// are reported apart so that the CAs kept in a bundle after a rotation
+// don't hide the expiry of the leaf certificates and the other way around.
+func earliestExpiringCertificates(b []byte) map[bool]*x509.Certificate {
+	earliest := map[bool]*x509.Certificate{}
+	if !bytes.Contains(b, pemCertificateHeader) {
+		return earliest
+	}
+	for {
+		var block *pem.Block
+		block, b = pem.Decode(b)
+		if block == nil {
+			return earliest
+		}
+		if block.Type != "CERTIFICATE" {
+			continue
+		}
+		cert, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			continue
+		}
+		if e, ok := earliest[cert.IsCA]; !ok || cert.NotAfter.Before(e.NotAfter) {
+			earliest[cert.IsCA] = cert
+		}
+	}
+}
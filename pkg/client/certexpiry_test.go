@@ -0,0 +1,162 @@
+// Copyright 2020 The Cluster Monitoring Operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/openshift/library-go/pkg/crypto"
+	"github.com/prometheus/client_golang/prometheus"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+func gatherCertExpiry(t *testing.T, e *certExpiry) map[string]float64 {
+	t.Helper()
+
+	r := prometheus.NewRegistry()
+	r.MustRegister(e.notAfter)
+	mfs, err := r.Gather()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got := map[string]float64{}
+	for _, mf := range mfs {
+		for _, m := range mf.GetMetric() {
+			labels := map[string]string{}
+			for _, l := range m.GetLabel() {
+				labels[l.GetName()] = l.GetValue()
+			}
+			got[labels["kind"]+" "+labels["namespace"]+"/"+labels["name"]+" "+labels["key"]+" ca="+labels["ca"]+" "+labels["subject"]] = m.GetGauge().GetValue()
+		}
+	}
+	return got
+}
+
+func TestCertExpiry(t *testing.T) {
+	ca, err := crypto.MakeSelfSignedCAConfigForDuration("test-ca", 10*time.Hour)
+	if err != nil {
+		t.Fatal(err)
+	}
+	signer := &crypto.CA{Config: ca, SerialGenerator: &crypto.RandomSerialGenerator{}}
+	server, err := signer.MakeServerCertForDuration(sets.NewString("foo"), time.Hour)
+	if err != nil {
+		t.Fatal(err)
+	}
+	serverCrt, serverKey, err := server.GetPEMBytes()
+	if err != nil {
+		t.Fatal(err)
+	}
+	// The certificate is followed by the CA in serverCrt.
+	leafCrt, err := crypto.EncodeCertificates(server.Certs[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+	caCrt, _, err := ca.GetPEMBytes()
+	if err != nil {
+		t.Fatal(err)
+	}
+	nextCA, err := crypto.MakeSelfSignedCAConfigForDuration("test-ca-2", 20*time.Hour)
+	if err != nil {
+		t.Fatal(err)
+	}
+	nextCACrt, _, err := nextCA.GetPEMBytes()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	e := newCertExpiry()
+	s := &v1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "foo", Name: "bar"},
+		Data: map[string][]byte{
+			"tls.crt": serverCrt,
+			"tls.key": serverKey,
+			"other":   []byte("not a certificate"),
+		},
+	}
+	e.observeSecret(s)
+	e.observeConfigMap(&v1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "foo", Name: "ca"},
+		Data:       map[string]string{"ca-bundle.crt": string(caCrt)},
+	})
+
+	serverNotAfter := float64(server.Certs[0].NotAfter.Unix())
+	caNotAfter := float64(ca.Certs[0].NotAfter.Unix())
+	expected := map[string]float64{
+		"Secret foo/bar tls.crt ca=false foo":            serverNotAfter,
+		"Secret foo/bar tls.crt ca=true test-ca":         caNotAfter,
+		"ConfigMap foo/ca ca-bundle.crt ca=true test-ca": caNotAfter,
+	}
+	if got := gatherCertExpiry(t, e); !reflect.DeepEqual(got, expected) {
+		t.Fatalf("expected %v, got %v", expected, got)
+	}
+
+	// Removed keys are dropped.
+	s.Data = map[string][]byte{"ca.crt": caCrt}
+	e.observeSecret(s)
+	expected = map[string]float64{
+		"Secret foo/bar ca.crt ca=true test-ca":          caNotAfter,
+		"ConfigMap foo/ca ca-bundle.crt ca=true test-ca": caNotAfter,
+	}
+	if got := gatherCertExpiry(t, e); !reflect.DeepEqual(got, expected) {
+		t.Fatalf("expected %v, got %v", expected, got)
+	}
+
+	// Bundles report their earliest expiring CA and leaf certificates apart,
+	// whatever their position.
+	s.Data = map[string][]byte{"ca-bundle.crt": concat(leafCrt, nextCACrt, caCrt)}
+	e.observeSecret(s)
+	expected = map[string]float64{
+		"Secret foo/bar ca-bundle.crt ca=false foo":      serverNotAfter,
+		"Secret foo/bar ca-bundle.crt ca=true test-ca":   caNotAfter,
+		"ConfigMap foo/ca ca-bundle.crt ca=true test-ca": caNotAfter,
+	}
+	if got := gatherCertExpiry(t, e); !reflect.DeepEqual(got, expected) {
+		t.Fatalf("expected %v, got %v", expected, got)
+	}
+
+	// The series of a CA which left the bundle are replaced.
+	s.Data = map[string][]byte{"ca-bundle.crt": concat(leafCrt, nextCACrt)}
+	e.observeSecret(s)
+	expected = map[string]float64{
+		"Secret foo/bar ca-bundle.crt ca=false foo":      serverNotAfter,
+		"Secret foo/bar ca-bundle.crt ca=true test-ca-2": float64(nextCA.Certs[0].NotAfter.Unix()),
+		"ConfigMap foo/ca ca-bundle.crt ca=true test-ca": caNotAfter,
+	}
+	if got := gatherCertExpiry(t, e); !reflect.DeepEqual(got, expected) {
+		t.Fatalf("expected %v, got %v", expected, got)
+	}
+
+	// Deleted objects are dropped.
+	e.forget("Secret", "foo", "bar")
+	expected = map[string]float64{
+		"ConfigMap foo/ca ca-bundle.crt ca=true test-ca": caNotAfter,
+	}
+	if got := gatherCertExpiry(t, e); !reflect.DeepEqual(got, expected) {
+		t.Fatalf("expected %v, got %v", expected, got)
+	}
+}
+
+func concat(bs ...[]byte) []byte {
+	var res []byte
+	for _, b := range bs {
+		res = append(res, b...)
+	}
+	return res
+}
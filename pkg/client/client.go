@@ -16,8 +16,10 @@ package client
 
 import (
 	"context"
+	"encoding/json"
+	"net/http"
 	"net/url"
-	"reflect"
+	"sync"
 	"time"
 
 	"github.com/coreos/prometheus-operator/pkg/alertmanager"
@@ -33,6 +35,7 @@ import (
 	openshiftrouteclientset "github.com/openshift/client-go/route/clientset/versioned"
 	openshiftsecurityclientset "github.com/openshift/client-go/security/clientset/versioned"
 	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
 	admissionv1 "k8s.io/api/admissionregistration/v1"
 	appsv1 "k8s.io/api/apps/v1"
 	v1 "k8s.io/api/core/v1"
@@ -43,10 +46,12 @@ import (
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/transport"
 	"k8s.io/klog"
 	apiregistrationv1beta1 "k8s.io/kube-aggregator/pkg/apis/apiregistration/v1beta1"
 	aggregatorclient "k8s.io/kube-aggregator/pkg/client/clientset_generated/clientset"
@@ -54,6 +59,10 @@ import (
 
 const (
 	deploymentCreateTimeout = 5 * time.Minute
+
+	// FieldManager is the user agent of the client. The API server records
+	// it as the manager of the fields written by the operator.
+	FieldManager = "cluster-monitoring-operator"
 )
 
 type Client struct {
@@ -67,9 +76,47 @@ type Client struct {
 	mclient           monitoring.Interface
 	eclient           apiextensionsclient.Interface
 	aggclient         aggregatorclient.Interface
+
+	requests       *prometheus.CounterVec
+	fieldConflicts *prometheus.CounterVec
+	certs          *certExpiry
+	// dryRun records the changes instead of applying them if set.
+	dryRun *Plan
+
+	inventoryMtx sync.Mutex
+	// inventory holds the objects applied since the last ResetInventory
+	// call.
+	inventory map[objectRef]struct{}
+
+	dampening FailureDampening
+	failures  *failureTracker
+
+	events *eventRecorder
 }
 
 func New(cfg *rest.Config, version string, namespace string, namespaceSelector string) (*Client, error) {
+	c := &Client{
+		version:           version,
+		namespace:         namespace,
+		namespaceSelector: namespaceSelector,
+		requests:          newRequestsCounterVec(),
+		fieldConflicts:    newFieldConflictsCounterVec(),
+		certs:             newCertExpiry(),
+		failures:          &failureTracker{},
+	}
+
+	cfg = rest.CopyConfig(cfg)
+	cfg.UserAgent = FieldManager
+	cfg.WrapTransport = transport.Wrappers(
+		cfg.WrapTransport,
+		func(rt http.RoundTripper) http.RoundTripper {
+			return &requestCounter{next: rt, requests: c.requests}
+		},
+		func(rt http.RoundTripper) http.RoundTripper {
+			return &dryRunTransport{next: rt, client: c}
+		},
+	)
+
 	mclient, err := monitoring.NewForConfig(cfg)
 	if err != nil {
 		return nil, err
@@ -110,18 +157,29 @@ func New(cfg *rest.Config, version string, namespace string, namespaceSelector s
 		return nil, errors.Wrap(err, "creating kubernetes aggregator")
 	}
 
-	return &Client{
-		version:           version,
-		namespace:         namespace,
-		namespaceSelector: namespaceSelector,
-		kclient:           kclient,
-		oscclient:         oscclient,
-		ossclient:         ossclient,
-		osrclient:         osrclient,
-		mclient:           mclient,
-		eclient:           eclient,
-		aggclient:         aggclient,
-	}, nil
+	c.kclient = kclient
+	c.events = newEventRecorder(kclient.CoreV1())
+	c.oscclient = oscclient
+	c.ossclient = ossclient
+	c.osrclient = osrclient
+	c.mclient = mclient
+	c.eclient = eclient
+	c.aggclient = aggclient
+
+	return c, nil
+}
+
+// poll runs condition every interval until it returns true or an error, the
+// timeout expires or ctx is done. If ctx is done, its error is returned.
+func poll(ctx context.Context, interval, timeout time.Duration, condition wait.ConditionFunc) error {
+	pollCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	err := wait.PollUntil(interval, condition, pollCtx.Done())
+	if err == wait.ErrWaitTimeout && ctx.Err() != nil {
+		return ctx.Err()
+	}
+	return err
 }
 
 func (c *Client) KubernetesInterface() kubernetes.Interface {
@@ -140,19 +198,47 @@ func (c *Client) SecretListWatchForNamespace(ns string) *cache.ListWatch {
 	return cache.NewListWatchFromClient(c.kclient.CoreV1().RESTClient(), "secrets", ns, fields.Everything())
 }
 
-func (c *Client) AssurePrometheusOperatorCRsExist() error {
-	return wait.Poll(time.Second, time.Minute*5, func() (bool, error) {
-		_, err := c.mclient.MonitoringV1().Prometheuses(c.namespace).List(context.TODO(), metav1.ListOptions{})
+func (c *Client) DeploymentListWatchForNamespace(ns string) *cache.ListWatch {
+	return cache.NewListWatchFromClient(c.kclient.AppsV1().RESTClient(), "deployments", ns, fields.Everything())
+}
+
+func (c *Client) DaemonSetListWatchForNamespace(ns string) *cache.ListWatch {
+	return cache.NewListWatchFromClient(c.kclient.AppsV1().RESTClient(), "daemonsets", ns, fields.Everything())
+}
+
+func (c *Client) ServiceListWatchForNamespace(ns string) *cache.ListWatch {
+	return cache.NewListWatchFromClient(c.kclient.CoreV1().RESTClient(), "services", ns, fields.Everything())
+}
+
+func (c *Client) RouteListWatchForNamespace(ns string) *cache.ListWatch {
+	return cache.NewListWatchFromClient(c.osrclient.RouteV1().RESTClient(), "routes", ns, fields.Everything())
+}
+
+func (c *Client) PrometheusListWatchForNamespace(ns string) *cache.ListWatch {
+	return cache.NewListWatchFromClient(c.mclient.MonitoringV1().RESTClient(), monv1.PrometheusName, ns, fields.Everything())
+}
+
+func (c *Client) AlertmanagerListWatchForNamespace(ns string) *cache.ListWatch {
+	return cache.NewListWatchFromClient(c.mclient.MonitoringV1().RESTClient(), monv1.AlertmanagerName, ns, fields.Everything())
+}
+
+func (c *Client) ThanosRulerListWatchForNamespace(ns string) *cache.ListWatch {
+	return cache.NewListWatchFromClient(c.mclient.MonitoringV1().RESTClient(), monv1.ThanosRulerName, ns, fields.Everything())
+}
+
+func (c *Client) AssurePrometheusOperatorCRsExist(ctx context.Context) error {
+	return poll(ctx, time.Second, time.Minute*5, func() (bool, error) {
+		_, err := c.mclient.MonitoringV1().Prometheuses(c.namespace).List(ctx, metav1.ListOptions{})
 		if err != nil {
 			return false, err
 		}
 
-		_, err = c.mclient.MonitoringV1().Alertmanagers(c.namespace).List(context.TODO(), metav1.ListOptions{})
+		_, err = c.mclient.MonitoringV1().Alertmanagers(c.namespace).List(ctx, metav1.ListOptions{})
 		if err != nil {
 			return false, err
 		}
 
-		_, err = c.mclient.MonitoringV1().ServiceMonitors(c.namespace).List(context.TODO(), metav1.ListOptions{})
+		_, err = c.mclient.MonitoringV1().ServiceMonitors(c.namespace).List(ctx, metav1.ListOptions{})
 		if err != nil {
 			return false, err
 		}
@@ -161,55 +247,38 @@ func (c *Client) AssurePrometheusOperatorCRsExist() error {
 	})
 }
 
-func (c *Client) CreateOrUpdateValidatingWebhookConfiguration(w *admissionv1.ValidatingWebhookConfiguration) error {
-	admclient := c.kclient.AdmissionregistrationV1().ValidatingWebhookConfigurations()
-	existing, err := admclient.Get(context.TODO(), w.GetName(), metav1.GetOptions{})
-	if apierrors.IsNotFound(err) {
-		_, err := admclient.Create(context.TODO(), w, metav1.CreateOptions{})
-		return errors.Wrap(err, "creating ValidatingWebhookConfiguration object failed")
-	}
-	if err != nil {
-		return errors.Wrap(err, "retrieving ValidatingWebhookConfiguration object failed")
-	}
-
-	required := w.DeepCopy()
-	required.ResourceVersion = existing.ResourceVersion
-	_, err = admclient.Update(context.TODO(), required, metav1.UpdateOptions{})
-	return errors.Wrap(err, "updating ValidatingWebhookConfiguration object failed")
+func (c *Client) CreateOrUpdateValidatingWebhookConfiguration(ctx context.Context, w *admissionv1.ValidatingWebhookConfiguration) error {
+	client := c.kclient.AdmissionregistrationV1().ValidatingWebhookConfigurations()
+	err := c.applyObject(ctx, w, admissionv1.SchemeGroupVersion.WithKind("ValidatingWebhookConfiguration"), func(data []byte, opts metav1.PatchOptions) error {
+		_, err := client.Patch(ctx, w.GetName(), types.ApplyPatchType, data, opts)
+		return err
+	})
+	return errors.Wrap(err, "applying ValidatingWebhookConfiguration object failed")
 }
 
-func (c *Client) CreateOrUpdateSecurityContextConstraints(s *secv1.SecurityContextConstraints) error {
-	sccclient := c.ossclient.SecurityV1().SecurityContextConstraints()
-	existing, err := sccclient.Get(context.TODO(), s.GetName(), metav1.GetOptions{})
-	if apierrors.IsNotFound(err) {
-		_, err := sccclient.Create(context.TODO(), s, metav1.CreateOptions{})
-		return errors.Wrap(err, "creating SecurityContextConstraints object failed")
-	}
-	if err != nil {
-		return errors.Wrap(err, "retrieving SecurityContextConstraints object failed")
-	}
-
-	// the CRD version of SCC appears to require this.  We can try to chase why later.
-	required := s.DeepCopy()
-	required.ResourceVersion = existing.ResourceVersion
-
-	_, err = sccclient.Update(context.TODO(), required, metav1.UpdateOptions{})
-	return errors.Wrap(err, "updating SecurityContextConstraints object failed")
+func (c *Client) CreateOrUpdateSecurityContextConstraints(ctx context.Context, s *secv1.SecurityContextConstraints) error {
+	client := c.ossclient.SecurityV1().SecurityContextConstraints()
+	err := c.applyObject(ctx, s, secv1.GroupVersion.WithKind("SecurityContextConstraints"), func(data []byte, opts metav1.PatchOptions) error {
+		_, err := client.Patch(ctx, s.GetName(), types.ApplyPatchType, data, opts)
+		return err
+	})
+	return errors.Wrap(err, "applying SecurityContextConstraints object failed")
 }
 
-func (c *Client) CreateRouteIfNotExists(r *routev1.Route) error {
+func (c *Client) CreateRouteIfNotExists(ctx context.Context, r *routev1.Route) error {
+	c.record("Route", r.GetNamespace(), r.GetName())
 	rclient := c.osrclient.RouteV1().Routes(r.GetNamespace())
-	_, err := rclient.Get(context.TODO(), r.GetName(), metav1.GetOptions{})
+	_, err := rclient.Get(ctx, r.GetName(), metav1.GetOptions{})
 	if apierrors.IsNotFound(err) {
-		_, err := rclient.Create(context.TODO(), r, metav1.CreateOptions{})
+		_, err := rclient.Create(ctx, r, metav1.CreateOptions{})
 		return errors.Wrap(err, "creating Route object failed")
 	}
 	return nil
 }
 
-func (c *Client) GetRouteURL(r *routev1.Route) (*url.URL, error) {
+func (c *Client) GetRouteURL(ctx context.Context, r *routev1.Route) (*url.URL, error) {
 	rclient := c.osrclient.RouteV1().Routes(r.GetNamespace())
-	newRoute, err := rclient.Get(context.TODO(), r.GetName(), metav1.GetOptions{})
+	newRoute, err := rclient.Get(ctx, r.GetName(), metav1.GetOptions{})
 	if err != nil {
 		return nil, errors.Wrap(err, "getting Route object failed")
 	}
@@ -226,28 +295,38 @@ func (c *Client) GetRouteURL(r *routev1.Route) (*url.URL, error) {
 	return u, nil
 }
 
-func (c *Client) GetClusterVersion(name string) (*configv1.ClusterVersion, error) {
-	return c.oscclient.ConfigV1().ClusterVersions().Get(context.TODO(), name, metav1.GetOptions{})
+func (c *Client) GetClusterVersion(ctx context.Context, name string) (*configv1.ClusterVersion, error) {
+	return c.oscclient.ConfigV1().ClusterVersions().Get(ctx, name, metav1.GetOptions{})
 }
 
-func (c *Client) GetProxy(name string) (*configv1.Proxy, error) {
-	return c.oscclient.ConfigV1().Proxies().Get(context.TODO(), name, metav1.GetOptions{})
+func (c *Client) GetProxy(ctx context.Context, name string) (*configv1.Proxy, error) {
+	return c.oscclient.ConfigV1().Proxies().Get(ctx, name, metav1.GetOptions{})
 }
 
-func (c *Client) GetInfrastructure(name string) (*configv1.Infrastructure, error) {
-	return c.oscclient.ConfigV1().Infrastructures().Get(context.TODO(), name, metav1.GetOptions{})
+func (c *Client) GetInfrastructure(ctx context.Context, name string) (*configv1.Infrastructure, error) {
+	return c.oscclient.ConfigV1().Infrastructures().Get(ctx, name, metav1.GetOptions{})
 }
 
-func (c *Client) GetConfigmap(namespace, name string) (*v1.ConfigMap, error) {
-	return c.kclient.CoreV1().ConfigMaps(namespace).Get(context.TODO(), name, metav1.GetOptions{})
+func (c *Client) GetConfigmap(ctx context.Context, namespace, name string) (*v1.ConfigMap, error) {
+	cm, err := c.kclient.CoreV1().ConfigMaps(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	c.certs.observeConfigMap(cm)
+	return cm, nil
 }
 
-func (c *Client) GetSecret(namespace, name string) (*v1.Secret, error) {
-	return c.kclient.CoreV1().Secrets(namespace).Get(context.TODO(), name, metav1.GetOptions{})
+func (c *Client) GetSecret(ctx context.Context, namespace, name string) (*v1.Secret, error) {
+	s, err := c.kclient.CoreV1().Secrets(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	c.certs.observeSecret(s)
+	return s, nil
 }
 
-func (c *Client) NamespacesToMonitor() ([]string, error) {
-	namespaces, err := c.kclient.CoreV1().Namespaces().List(context.TODO(), metav1.ListOptions{
+func (c *Client) NamespacesToMonitor(ctx context.Context) ([]string, error) {
+	namespaces, err := c.kclient.CoreV1().Namespaces().List(ctx, metav1.ListOptions{
 		LabelSelector: c.namespaceSelector,
 	})
 	if err != nil {
@@ -262,76 +341,46 @@ func (c *Client) NamespacesToMonitor() ([]string, error) {
 	return namespaceNames, nil
 }
 
-func (c *Client) CreateOrUpdatePrometheus(p *monv1.Prometheus) error {
-	pclient := c.mclient.MonitoringV1().Prometheuses(p.GetNamespace())
-	oldProm, err := pclient.Get(context.TODO(), p.GetName(), metav1.GetOptions{})
-	if apierrors.IsNotFound(err) {
-		_, err := pclient.Create(context.TODO(), p, metav1.CreateOptions{})
-		return errors.Wrap(err, "creating Prometheus object failed")
-	}
-	if err != nil {
-		return errors.Wrap(err, "retrieving Prometheus object failed")
-	}
-
-	p.ResourceVersion = oldProm.ResourceVersion
-	_, err = pclient.Update(context.TODO(), p, metav1.UpdateOptions{})
-	return errors.Wrap(err, "updating Prometheus object failed")
+func (c *Client) CreateOrUpdatePrometheus(ctx context.Context, p *monv1.Prometheus) error {
+	client := c.mclient.MonitoringV1().Prometheuses(p.GetNamespace())
+	err := c.applyObject(ctx, p, monv1.SchemeGroupVersion.WithKind(monv1.PrometheusesKind), func(data []byte, opts metav1.PatchOptions) error {
+		_, err := client.Patch(ctx, p.GetName(), types.ApplyPatchType, data, opts)
+		return err
+	})
+	return errors.Wrap(err, "applying Prometheus object failed")
 }
 
-func (c *Client) CreateOrUpdatePrometheusRule(p *monv1.PrometheusRule) error {
-	pclient := c.mclient.MonitoringV1().PrometheusRules(p.GetNamespace())
-	oldRule, err := pclient.Get(context.TODO(), p.GetName(), metav1.GetOptions{})
-	if apierrors.IsNotFound(err) {
-		_, err := pclient.Create(context.TODO(), p, metav1.CreateOptions{})
-		return errors.Wrap(err, "creating PrometheusRule object failed")
-	}
-	if err != nil {
-		return errors.Wrap(err, "retrieving PrometheusRule object failed")
-	}
-
-	p.ResourceVersion = oldRule.ResourceVersion
-
-	_, err = pclient.Update(context.TODO(), p, metav1.UpdateOptions{})
-	return errors.Wrap(err, "updating PrometheusRule object failed")
+func (c *Client) CreateOrUpdatePrometheusRule(ctx context.Context, p *monv1.PrometheusRule) error {
+	client := c.mclient.MonitoringV1().PrometheusRules(p.GetNamespace())
+	err := c.applyObject(ctx, p, monv1.SchemeGroupVersion.WithKind(monv1.PrometheusRuleKind), func(data []byte, opts metav1.PatchOptions) error {
+		_, err := client.Patch(ctx, p.GetName(), types.ApplyPatchType, data, opts)
+		return err
+	})
+	return errors.Wrap(err, "applying PrometheusRule object failed")
 }
 
-func (c *Client) CreateOrUpdateAlertmanager(a *monv1.Alertmanager) error {
-	aclient := c.mclient.MonitoringV1().Alertmanagers(a.GetNamespace())
-	oldAm, err := aclient.Get(context.TODO(), a.GetName(), metav1.GetOptions{})
-	if apierrors.IsNotFound(err) {
-		_, err := aclient.Create(context.TODO(), a, metav1.CreateOptions{})
-		return errors.Wrap(err, "creating Alertmanager object failed")
-	}
-	if err != nil {
-		return errors.Wrap(err, "retrieving Alertmanager object failed")
-	}
-
-	a.ResourceVersion = oldAm.ResourceVersion
-
-	_, err = aclient.Update(context.TODO(), a, metav1.UpdateOptions{})
-	return errors.Wrap(err, "updating Alertmanager object failed")
+func (c *Client) CreateOrUpdateAlertmanager(ctx context.Context, a *monv1.Alertmanager) error {
+	client := c.mclient.MonitoringV1().Alertmanagers(a.GetNamespace())
+	err := c.applyObject(ctx, a, monv1.SchemeGroupVersion.WithKind(monv1.AlertmanagersKind), func(data []byte, opts metav1.PatchOptions) error {
+		_, err := client.Patch(ctx, a.GetName(), types.ApplyPatchType, data, opts)
+		return err
+	})
+	return errors.Wrap(err, "applying Alertmanager object failed")
 }
 
-func (c *Client) CreateOrUpdateThanosRuler(t *monv1.ThanosRuler) error {
-	trclient := c.mclient.MonitoringV1().ThanosRulers(t.GetNamespace())
-	oldTr, err := trclient.Get(context.TODO(), t.GetName(), metav1.GetOptions{})
-	if apierrors.IsNotFound(err) {
-		_, err := trclient.Create(context.TODO(), t, metav1.CreateOptions{})
-		return errors.Wrap(err, "creating Thanos Ruler object failed")
-	}
-	if err != nil {
-		return errors.Wrap(err, "retrieving Thanos Ruler object failed")
-	}
-
-	t.ResourceVersion = oldTr.ResourceVersion
-
-	_, err = trclient.Update(context.TODO(), t, metav1.UpdateOptions{})
-	return errors.Wrap(err, "updating Thanos Ruler object failed")
+func (c *Client) CreateOrUpdateThanosRuler(ctx context.Context, t *monv1.ThanosRuler) error {
+	client := c.mclient.MonitoringV1().ThanosRulers(t.GetNamespace())
+	err := c.applyObject(ctx, t, monv1.SchemeGroupVersion.WithKind(monv1.ThanosRulerKind), func(data []byte, opts metav1.PatchOptions) error {
+		_, err := client.Patch(ctx, t.GetName(), types.ApplyPatchType, data, opts)
+		return err
+	})
+	return errors.Wrap(err, "applying Thanos Ruler object failed")
 }
 
-func (c *Client) DeleteConfigMap(cm *v1.ConfigMap) error {
-	err := c.kclient.CoreV1().ConfigMaps(cm.GetNamespace()).Delete(context.TODO(), cm.GetName(), metav1.DeleteOptions{})
-	if apierrors.IsNotFound(err) {
+func (c *Client) DeleteConfigMap(ctx context.Context, cm *v1.ConfigMap) error {
+	err := c.kclient.CoreV1().ConfigMaps(cm.GetNamespace()).Delete(ctx, cm.GetName(), metav1.DeleteOptions{})
+	if err == nil || apierrors.IsNotFound(err) {
+		c.certs.forget("ConfigMap", cm.GetNamespace(), cm.GetName())
 		return nil
 	}
 
@@ -340,9 +389,9 @@ func (c *Client) DeleteConfigMap(cm *v1.ConfigMap) error {
 
 // DeleteHashedConfigMap deletes all configmaps in the given namespace which have
 // the specified prefix, and DO NOT have the given hash.
-func (c *Client) DeleteHashedConfigMap(namespace, prefix, newHash string) error {
+func (c *Client) DeleteHashedConfigMap(ctx context.Context, namespace, prefix, newHash string) error {
 	ls := "monitoring.openshift.io/name=" + prefix + ",monitoring.openshift.io/hash!=" + newHash
-	configMaps, err := c.KubernetesInterface().CoreV1().ConfigMaps(namespace).List(context.TODO(), metav1.ListOptions{
+	configMaps, err := c.KubernetesInterface().CoreV1().ConfigMaps(namespace).List(ctx, metav1.ListOptions{
 		LabelSelector: ls,
 	})
 	if err != nil {
@@ -350,10 +399,11 @@ func (c *Client) DeleteHashedConfigMap(namespace, prefix, newHash string) error
 	}
 
 	for _, cm := range configMaps.Items {
-		err := c.KubernetesInterface().CoreV1().ConfigMaps(namespace).Delete(context.TODO(), cm.Name, metav1.DeleteOptions{})
+		err := c.KubernetesInterface().CoreV1().ConfigMaps(namespace).Delete(ctx, cm.Name, metav1.DeleteOptions{})
 		if err != nil {
 			return errors.Wrapf(err, "error deleting configmap: %s/%s", namespace, cm.Name)
 		}
+		c.certs.forget("ConfigMap", namespace, cm.Name)
 	}
 
 	return nil
@@ -361,9 +411,9 @@ func (c *Client) DeleteHashedConfigMap(namespace, prefix, newHash string) error
 
 // DeleteHashedSecret deletes all secrets in the given namespace which have
 // the specified prefix, and DO NOT have the given hash.
-func (c *Client) DeleteHashedSecret(namespace, prefix, newHash string) error {
+func (c *Client) DeleteHashedSecret(ctx context.Context, namespace, prefix, newHash string) error {
 	ls := "monitoring.openshift.io/name=" + prefix + ",monitoring.openshift.io/hash!=" + newHash
-	secrets, err := c.KubernetesInterface().CoreV1().Secrets(namespace).List(context.TODO(), metav1.ListOptions{
+	secrets, err := c.KubernetesInterface().CoreV1().Secrets(namespace).List(ctx, metav1.ListOptions{
 		LabelSelector: ls,
 	})
 	if err != nil {
@@ -371,17 +421,18 @@ func (c *Client) DeleteHashedSecret(namespace, prefix, newHash string) error {
 	}
 
 	for _, s := range secrets.Items {
-		err := c.KubernetesInterface().CoreV1().Secrets(namespace).Delete(context.TODO(), s.Name, metav1.DeleteOptions{})
+		err := c.KubernetesInterface().CoreV1().Secrets(namespace).Delete(ctx, s.Name, metav1.DeleteOptions{})
 		if err != nil {
 			return errors.Wrapf(err, "error deleting secret: %s/%s", namespace, s.Name)
 		}
+		c.certs.forget("Secret", namespace, s.Name)
 	}
 
 	return nil
 }
 
-func (c *Client) DeleteValidatingWebhook(w *admissionv1.ValidatingWebhookConfiguration) error {
-	err := c.kclient.AdmissionregistrationV1().ValidatingWebhookConfigurations().Delete(context.TODO(), w.GetName(), metav1.DeleteOptions{})
+func (c *Client) DeleteValidatingWebhook(ctx context.Context, w *admissionv1.ValidatingWebhookConfiguration) error {
+	err := c.kclient.AdmissionregistrationV1().ValidatingWebhookConfigurations().Delete(ctx, w.GetName(), metav1.DeleteOptions{})
 	if apierrors.IsNotFound(err) {
 		return nil
 	}
@@ -389,9 +440,9 @@ func (c *Client) DeleteValidatingWebhook(w *admissionv1.ValidatingWebhookConfigu
 	return err
 }
 
-func (c *Client) DeleteDeployment(d *appsv1.Deployment) error {
+func (c *Client) DeleteDeployment(ctx context.Context, d *appsv1.Deployment) error {
 	p := metav1.DeletePropagationForeground
-	err := c.kclient.AppsV1().Deployments(d.GetNamespace()).Delete(context.TODO(), d.GetName(), metav1.DeleteOptions{PropagationPolicy: &p})
+	err := c.kclient.AppsV1().Deployments(d.GetNamespace()).Delete(ctx, d.GetName(), metav1.DeleteOptions{PropagationPolicy: &p})
 	if apierrors.IsNotFound(err) {
 		return nil
 	}
@@ -399,17 +450,20 @@ func (c *Client) DeleteDeployment(d *appsv1.Deployment) error {
 	return err
 }
 
-func (c *Client) DeletePrometheus(p *monv1.Prometheus) error {
+func (c *Client) DeletePrometheus(ctx context.Context, p *monv1.Prometheus) error {
 	pclient := c.mclient.MonitoringV1().Prometheuses(p.GetNamespace())
 
-	err := pclient.Delete(context.TODO(), p.GetName(), metav1.DeleteOptions{})
+	err := pclient.Delete(ctx, p.GetName(), metav1.DeleteOptions{})
 	if err != nil && !apierrors.IsNotFound(err) {
 		return errors.Wrap(err, "deleting Prometheus object failed")
 	}
+	if c.dryRun != nil {
+		return nil
+	}
 
 	var lastErr error
-	if err := wait.Poll(time.Second*10, time.Minute*10, func() (bool, error) {
-		pods, err := c.KubernetesInterface().CoreV1().Pods(p.GetNamespace()).List(context.TODO(), prometheusoperator.ListOptions(p.GetName()))
+	if err := poll(ctx, time.Second*10, time.Minute*10, func() (bool, error) {
+		pods, err := c.KubernetesInterface().CoreV1().Pods(p.GetNamespace()).List(ctx, prometheusoperator.ListOptions(p.GetName()))
 		if err != nil {
 			return false, errors.Wrap(err, "retrieving pods during polling failed")
 		}
@@ -429,17 +483,20 @@ func (c *Client) DeletePrometheus(p *monv1.Prometheus) error {
 	return nil
 }
 
-func (c *Client) DeleteThanosRuler(tr *monv1.ThanosRuler) error {
+func (c *Client) DeleteThanosRuler(ctx context.Context, tr *monv1.ThanosRuler) error {
 	trclient := c.mclient.MonitoringV1().ThanosRulers(tr.GetNamespace())
 
-	err := trclient.Delete(context.TODO(), tr.GetName(), metav1.DeleteOptions{})
+	err := trclient.Delete(ctx, tr.GetName(), metav1.DeleteOptions{})
 	if err != nil && !apierrors.IsNotFound(err) {
 		return errors.Wrap(err, "deleting Thanos Ruler object failed")
 	}
+	if c.dryRun != nil {
+		return nil
+	}
 
 	var lastErr error
-	if err := wait.Poll(time.Second*10, time.Minute*10, func() (bool, error) {
-		pods, err := c.KubernetesInterface().CoreV1().Pods(tr.GetNamespace()).List(context.TODO(), thanosoperator.ListOptions(tr.GetName()))
+	if err := poll(ctx, time.Second*10, time.Minute*10, func() (bool, error) {
+		pods, err := c.KubernetesInterface().CoreV1().Pods(tr.GetNamespace()).List(ctx, thanosoperator.ListOptions(tr.GetName()))
 		if err != nil {
 			return false, errors.Wrap(err, "retrieving pods during polling failed")
 		}
@@ -459,9 +516,9 @@ func (c *Client) DeleteThanosRuler(tr *monv1.ThanosRuler) error {
 	return nil
 }
 
-func (c *Client) DeleteDaemonSet(d *appsv1.DaemonSet) error {
+func (c *Client) DeleteDaemonSet(ctx context.Context, d *appsv1.DaemonSet) error {
 	orphanDependents := false
-	err := c.kclient.AppsV1().DaemonSets(d.GetNamespace()).Delete(context.TODO(), d.GetName(), metav1.DeleteOptions{OrphanDependents: &orphanDependents})
+	err := c.kclient.AppsV1().DaemonSets(d.GetNamespace()).Delete(ctx, d.GetName(), metav1.DeleteOptions{OrphanDependents: &orphanDependents})
 	if apierrors.IsNotFound(err) {
 		return nil
 	}
@@ -469,14 +526,14 @@ func (c *Client) DeleteDaemonSet(d *appsv1.DaemonSet) error {
 	return err
 }
 
-func (c *Client) DeleteServiceMonitor(sm *monv1.ServiceMonitor) error {
-	return c.DeleteServiceMonitorByNamespaceAndName(sm.Namespace, sm.GetName())
+func (c *Client) DeleteServiceMonitor(ctx context.Context, sm *monv1.ServiceMonitor) error {
+	return c.DeleteServiceMonitorByNamespaceAndName(ctx, sm.Namespace, sm.GetName())
 }
 
-func (c *Client) DeleteServiceMonitorByNamespaceAndName(namespace, name string) error {
+func (c *Client) DeleteServiceMonitorByNamespaceAndName(ctx context.Context, namespace, name string) error {
 	sclient := c.mclient.MonitoringV1().ServiceMonitors(namespace)
 
-	err := sclient.Delete(context.TODO(), name, metav1.DeleteOptions{})
+	err := sclient.Delete(ctx, name, metav1.DeleteOptions{})
 	// if the object does not exist then everything is good here
 	if err != nil && !apierrors.IsNotFound(err) {
 		return errors.Wrap(err, "deleting ServiceMonitor object failed")
@@ -485,8 +542,8 @@ func (c *Client) DeleteServiceMonitorByNamespaceAndName(namespace, name string)
 	return nil
 }
 
-func (c *Client) DeleteServiceAccount(sa *v1.ServiceAccount) error {
-	err := c.kclient.CoreV1().ServiceAccounts(sa.Namespace).Delete(context.TODO(), sa.GetName(), metav1.DeleteOptions{})
+func (c *Client) DeleteServiceAccount(ctx context.Context, sa *v1.ServiceAccount) error {
+	err := c.kclient.CoreV1().ServiceAccounts(sa.Namespace).Delete(ctx, sa.GetName(), metav1.DeleteOptions{})
 	if apierrors.IsNotFound(err) {
 		return nil
 	}
@@ -494,8 +551,8 @@ func (c *Client) DeleteServiceAccount(sa *v1.ServiceAccount) error {
 	return err
 }
 
-func (c *Client) DeleteClusterRole(cr *rbacv1.ClusterRole) error {
-	err := c.kclient.RbacV1().ClusterRoles().Delete(context.TODO(), cr.GetName(), metav1.DeleteOptions{})
+func (c *Client) DeleteClusterRole(ctx context.Context, cr *rbacv1.ClusterRole) error {
+	err := c.kclient.RbacV1().ClusterRoles().Delete(ctx, cr.GetName(), metav1.DeleteOptions{})
 	if apierrors.IsNotFound(err) {
 		return nil
 	}
@@ -503,8 +560,8 @@ func (c *Client) DeleteClusterRole(cr *rbacv1.ClusterRole) error {
 	return err
 }
 
-func (c *Client) DeleteClusterRoleBinding(crb *rbacv1.ClusterRoleBinding) error {
-	err := c.kclient.RbacV1().ClusterRoleBindings().Delete(context.TODO(), crb.GetName(), metav1.DeleteOptions{})
+func (c *Client) DeleteClusterRoleBinding(ctx context.Context, crb *rbacv1.ClusterRoleBinding) error {
+	err := c.kclient.RbacV1().ClusterRoleBindings().Delete(ctx, crb.GetName(), metav1.DeleteOptions{})
 	if apierrors.IsNotFound(err) {
 		return nil
 	}
@@ -512,8 +569,8 @@ func (c *Client) DeleteClusterRoleBinding(crb *rbacv1.ClusterRoleBinding) error
 	return err
 }
 
-func (c *Client) DeleteService(svc *v1.Service) error {
-	err := c.kclient.CoreV1().Services(svc.Namespace).Delete(context.TODO(), svc.GetName(), metav1.DeleteOptions{})
+func (c *Client) DeleteService(ctx context.Context, svc *v1.Service) error {
+	err := c.kclient.CoreV1().Services(svc.Namespace).Delete(ctx, svc.GetName(), metav1.DeleteOptions{})
 	if apierrors.IsNotFound(err) {
 		return nil
 	}
@@ -521,35 +578,40 @@ func (c *Client) DeleteService(svc *v1.Service) error {
 	return err
 }
 
-func (c *Client) DeleteRoute(r *routev1.Route) error {
-	err := c.osrclient.RouteV1().Routes(r.GetNamespace()).Delete(context.TODO(), r.GetName(), metav1.DeleteOptions{})
+func (c *Client) DeleteRoute(ctx context.Context, r *routev1.Route) error {
+	err := c.osrclient.RouteV1().Routes(r.GetNamespace()).Delete(ctx, r.GetName(), metav1.DeleteOptions{})
 	if apierrors.IsNotFound(err) {
 		return nil
 	}
 	return err
 }
 
-func (c *Client) DeletePrometheusRule(rule *monv1.PrometheusRule) error {
-	err := c.mclient.MonitoringV1().PrometheusRules(rule.GetNamespace()).Delete(context.TODO(), rule.GetName(), metav1.DeleteOptions{})
+func (c *Client) DeletePrometheusRule(ctx context.Context, rule *monv1.PrometheusRule) error {
+	err := c.mclient.MonitoringV1().PrometheusRules(rule.GetNamespace()).Delete(ctx, rule.GetName(), metav1.DeleteOptions{})
 	if apierrors.IsNotFound(err) {
 		return nil
 	}
 	return err
 }
 
-func (c *Client) DeleteSecret(s *v1.Secret) error {
-	err := c.kclient.CoreV1().Secrets(s.Namespace).Delete(context.TODO(), s.GetName(), metav1.DeleteOptions{})
-	if apierrors.IsNotFound(err) {
+func (c *Client) DeleteSecret(ctx context.Context, s *v1.Secret) error {
+	err := c.kclient.CoreV1().Secrets(s.Namespace).Delete(ctx, s.GetName(), metav1.DeleteOptions{})
+	if err == nil || apierrors.IsNotFound(err) {
+		c.certs.forget("Secret", s.GetNamespace(), s.GetName())
 		return nil
 	}
 
 	return err
 }
 
-func (c *Client) WaitForPrometheus(p *monv1.Prometheus) error {
+func (c *Client) WaitForPrometheus(ctx context.Context, p *monv1.Prometheus) error {
+	if c.dryRun != nil {
+		return nil
+	}
+
 	var lastErr error
-	if err := wait.Poll(time.Second*10, time.Minute*5, func() (bool, error) {
-		p, err := c.mclient.MonitoringV1().Prometheuses(p.GetNamespace()).Get(context.TODO(), p.GetName(), metav1.GetOptions{})
+	if err := poll(ctx, time.Second*10, time.Minute*5, func() (bool, error) {
+		p, err := c.mclient.MonitoringV1().Prometheuses(p.GetNamespace()).Get(ctx, p.GetName(), metav1.GetOptions{})
 		if err != nil {
 			return false, errors.Wrap(err, "retrieving Prometheus object failed")
 		}
@@ -579,10 +641,14 @@ func (c *Client) WaitForPrometheus(p *monv1.Prometheus) error {
 	return nil
 }
 
-func (c *Client) WaitForAlertmanager(a *monv1.Alertmanager) error {
+func (c *Client) WaitForAlertmanager(ctx context.Context, a *monv1.Alertmanager) error {
+	if c.dryRun != nil {
+		return nil
+	}
+
 	var lastErr error
-	if err := wait.Poll(time.Second*10, time.Minute*5, func() (bool, error) {
-		a, err := c.mclient.MonitoringV1().Alertmanagers(a.GetNamespace()).Get(context.TODO(), a.GetName(), metav1.GetOptions{})
+	if err := poll(ctx, time.Second*10, time.Minute*5, func() (bool, error) {
+		a, err := c.mclient.MonitoringV1().Alertmanagers(a.GetNamespace()).Get(ctx, a.GetName(), metav1.GetOptions{})
 		if err != nil {
 			return false, errors.Wrap(err, "retrieving Alertmanager object failed")
 		}
@@ -612,10 +678,14 @@ func (c *Client) WaitForAlertmanager(a *monv1.Alertmanager) error {
 	return nil
 }
 
-func (c *Client) WaitForThanosRuler(t *monv1.ThanosRuler) error {
+func (c *Client) WaitForThanosRuler(ctx context.Context, t *monv1.ThanosRuler) error {
+	if c.dryRun != nil {
+		return nil
+	}
+
 	var lastErr error
-	if err := wait.Poll(time.Second*10, time.Minute*5, func() (bool, error) {
-		tr, err := c.mclient.MonitoringV1().ThanosRulers(t.GetNamespace()).Get(context.TODO(), t.GetName(), metav1.GetOptions{})
+	if err := poll(ctx, time.Second*10, time.Minute*5, func() (bool, error) {
+		tr, err := c.mclient.MonitoringV1().ThanosRulers(t.GetNamespace()).Get(ctx, t.GetName(), metav1.GetOptions{})
 		if err != nil {
 			return false, errors.Wrap(err, "retrieving Thanos Ruler object failed")
 		}
@@ -645,62 +715,41 @@ func (c *Client) WaitForThanosRuler(t *monv1.ThanosRuler) error {
 	return nil
 }
 
-func (c *Client) CreateOrUpdateDeployment(dep *appsv1.Deployment) error {
-	d, err := c.kclient.AppsV1().Deployments(dep.GetNamespace()).Get(context.TODO(), dep.GetName(), metav1.GetOptions{})
-
-	if apierrors.IsNotFound(err) {
-		err = c.CreateDeployment(dep)
-		return errors.Wrap(err, "creating Deployment object failed")
-	}
-	if err != nil {
-		return errors.Wrap(err, "retrieving Deployment object failed")
-	}
-	if reflect.DeepEqual(dep.Spec, d.Spec) {
-		// Nothing to do, as the currently existing Telemeter client
-		// deployment is equivalent to the one that would be applied.
-		return nil
-	}
-	err = c.UpdateDeployment(dep)
-	if err != nil {
-		uErr, ok := err.(*apierrors.StatusError)
-		if ok && uErr.ErrStatus.Code == 422 && uErr.ErrStatus.Reason == metav1.StatusReasonInvalid {
-			// try to delete Deployment
-			err = c.DeleteDeployment(dep)
-			if err != nil {
-				return errors.Wrap(err, "deleting Deployment object failed")
-			}
-			err = c.CreateDeployment(dep)
-			if err != nil {
-				return errors.Wrap(err, "creating Deployment object failed after update failed")
-			}
+func (c *Client) CreateOrUpdateDeployment(ctx context.Context, dep *appsv1.Deployment) error {
+	d, err := c.applyDeployment(ctx, dep)
+	if apierrors.IsInvalid(err) {
+		// Immutable fields changed, recreate the Deployment.
+		err = c.DeleteDeployment(ctx, dep)
+		if err != nil {
+			return errors.Wrap(err, "deleting Deployment object failed")
 		}
-		return errors.Wrap(err, "updating Deployment object failed")
+		d, err = c.applyDeployment(ctx, dep)
 	}
-	return nil
-}
-
-func (c *Client) CreateDeployment(dep *appsv1.Deployment) error {
-	d, err := c.kclient.AppsV1().Deployments(dep.GetNamespace()).Create(context.TODO(), dep, metav1.CreateOptions{})
 	if err != nil {
-		return err
+		return errors.Wrap(err, "applying Deployment object failed")
 	}
 
-	return c.WaitForDeploymentRollout(d)
+	return c.WaitForDeploymentRollout(ctx, d)
 }
 
-func (c *Client) UpdateDeployment(dep *appsv1.Deployment) error {
-	updated, err := c.kclient.AppsV1().Deployments(dep.GetNamespace()).Update(context.TODO(), dep, metav1.UpdateOptions{})
-	if err != nil {
+func (c *Client) applyDeployment(ctx context.Context, dep *appsv1.Deployment) (*appsv1.Deployment, error) {
+	var applied *appsv1.Deployment
+	err := c.applyObject(ctx, dep, appsv1.SchemeGroupVersion.WithKind("Deployment"), func(data []byte, opts metav1.PatchOptions) error {
+		var err error
+		applied, err = c.kclient.AppsV1().Deployments(dep.GetNamespace()).Patch(ctx, dep.GetName(), types.ApplyPatchType, data, opts)
 		return err
-	}
-
-	return c.WaitForDeploymentRollout(updated)
+	})
+	return applied, err
 }
 
-func (c *Client) WaitForDeploymentRollout(dep *appsv1.Deployment) error {
+func (c *Client) WaitForDeploymentRollout(ctx context.Context, dep *appsv1.Deployment) error {
+	if c.dryRun != nil {
+		return nil
+	}
+
 	var lastErr error
-	if err := wait.Poll(time.Second, deploymentCreateTimeout, func() (bool, error) {
-		d, err := c.kclient.AppsV1().Deployments(dep.GetNamespace()).Get(context.TODO(), dep.GetName(), metav1.GetOptions{})
+	if err := poll(ctx, time.Second, deploymentCreateTimeout, func() (bool, error) {
+		d, err := c.kclient.AppsV1().Deployments(dep.GetNamespace()).Get(ctx, dep.GetName(), metav1.GetOptions{})
 		if err != nil {
 			return false, err
 		}
@@ -729,10 +778,14 @@ func (c *Client) WaitForDeploymentRollout(dep *appsv1.Deployment) error {
 	return nil
 }
 
-func (c *Client) WaitForStatefulsetRollout(sts *appsv1.StatefulSet) error {
+func (c *Client) WaitForStatefulsetRollout(ctx context.Context, sts *appsv1.StatefulSet) error {
+	if c.dryRun != nil {
+		return nil
+	}
+
 	var lastErr error
-	if err := wait.Poll(time.Second, deploymentCreateTimeout, func() (bool, error) {
-		s, err := c.kclient.AppsV1().StatefulSets(sts.GetNamespace()).Get(context.TODO(), sts.GetName(), metav1.GetOptions{})
+	if err := poll(ctx, time.Second, deploymentCreateTimeout, func() (bool, error) {
+		s, err := c.kclient.AppsV1().StatefulSets(sts.GetNamespace()).Get(ctx, sts.GetName(), metav1.GetOptions{})
 		if err != nil {
 			return false, err
 		}
@@ -761,12 +814,21 @@ func (c *Client) WaitForStatefulsetRollout(sts *appsv1.StatefulSet) error {
 	return nil
 }
 
-func (c *Client) WaitForSecret(s *v1.Secret) (*v1.Secret, error) {
+func (c *Client) WaitForSecret(ctx context.Context, s *v1.Secret) (*v1.Secret, error) {
+	if c.dryRun != nil {
+		// The secret is returned as is if it doesn't exist yet.
+		result, err := c.kclient.CoreV1().Secrets(s.Namespace).Get(ctx, s.Name, metav1.GetOptions{})
+		if apierrors.IsNotFound(err) {
+			return s, nil
+		}
+		return result, errors.Wrapf(err, "getting secret %s/%s", s.GetNamespace(), s.GetName())
+	}
+
 	var result *v1.Secret
 	var lastErr error
-	if err := wait.Poll(1*time.Second, 5*time.Minute, func() (bool, error) {
+	if err := poll(ctx, 1*time.Second, 5*time.Minute, func() (bool, error) {
 		var err error
-		result, err = c.kclient.CoreV1().Secrets(s.Namespace).Get(context.TODO(), s.Name, metav1.GetOptions{})
+		result, err = c.kclient.CoreV1().Secrets(s.Namespace).Get(ctx, s.Name, metav1.GetOptions{})
 
 		if apierrors.IsNotFound(err) {
 			lastErr = err
@@ -791,15 +853,28 @@ func (c *Client) WaitForSecret(s *v1.Secret) (*v1.Secret, error) {
 		}
 		return nil, errors.Wrapf(err, "waiting for secret %s/%s", s.GetNamespace(), s.GetName())
 	}
+	c.certs.observeSecret(result)
 
 	return result, nil
 }
 
-func (c *Client) WaitForRouteReady(r *routev1.Route) (string, error) {
+func (c *Client) WaitForRouteReady(ctx context.Context, r *routev1.Route) (string, error) {
+	if c.dryRun != nil {
+		// The host is empty if the route doesn't exist yet.
+		route, err := c.osrclient.RouteV1().Routes(r.GetNamespace()).Get(ctx, r.GetName(), metav1.GetOptions{})
+		if apierrors.IsNotFound(err) {
+			return "", nil
+		}
+		if err != nil {
+			return "", errors.Wrapf(err, "getting route %s/%s", r.GetNamespace(), r.GetName())
+		}
+		return route.Spec.Host, nil
+	}
+
 	host := ""
 	var lastErr error
-	if err := wait.Poll(time.Second, deploymentCreateTimeout, func() (bool, error) {
-		newRoute, err := c.osrclient.RouteV1().Routes(r.GetNamespace()).Get(context.TODO(), r.GetName(), metav1.GetOptions{})
+	if err := poll(ctx, time.Second, deploymentCreateTimeout, func() (bool, error) {
+		newRoute, err := c.osrclient.RouteV1().Routes(r.GetNamespace()).Get(ctx, r.GetName(), metav1.GetOptions{})
 		if err != nil {
 			return false, err
 		}
@@ -824,57 +899,41 @@ func (c *Client) WaitForRouteReady(r *routev1.Route) (string, error) {
 	return host, nil
 }
 
-func (c *Client) CreateOrUpdateDaemonSet(ds *appsv1.DaemonSet) error {
-	_, err := c.kclient.AppsV1().DaemonSets(ds.GetNamespace()).Get(context.TODO(), ds.GetName(), metav1.GetOptions{})
-	if apierrors.IsNotFound(err) {
-		err = c.CreateDaemonSet(ds)
-		return errors.Wrap(err, "creating DaemonSet object failed")
+func (c *Client) CreateOrUpdateDaemonSet(ctx context.Context, ds *appsv1.DaemonSet) error {
+	d, err := c.applyDaemonSet(ctx, ds)
+	if apierrors.IsInvalid(err) {
+		// Immutable fields changed, recreate the DaemonSet.
+		err = c.DeleteDaemonSet(ctx, ds)
+		if err != nil {
+			return errors.Wrap(err, "deleting DaemonSet object failed")
+		}
+		d, err = c.applyDaemonSet(ctx, ds)
 	}
 	if err != nil {
-		return errors.Wrap(err, "retrieving DaemonSet object failed")
+		return errors.Wrap(err, "applying DaemonSet object failed")
 	}
 
-	err = c.UpdateDaemonSet(ds)
-	if err != nil {
-		uErr, ok := err.(*apierrors.StatusError)
-		if ok && uErr.ErrStatus.Code == 422 && uErr.ErrStatus.Reason == metav1.StatusReasonInvalid {
-			// try to delete DaemonSet
-			err = c.DeleteDaemonSet(ds)
-			if err != nil {
-				return errors.Wrap(err, "deleting DaemonSet object failed")
-			}
-			err = c.CreateDaemonSet(ds)
-			if err != nil {
-				return errors.Wrap(err, "creating DaemonSet object failed after update failed")
-			}
-		}
-		return errors.Wrap(err, "updating DaemonSet object failed")
-	}
-	return nil
+	return c.WaitForDaemonSetRollout(ctx, d)
 }
 
-func (c *Client) CreateDaemonSet(ds *appsv1.DaemonSet) error {
-	d, err := c.kclient.AppsV1().DaemonSets(ds.GetNamespace()).Create(context.TODO(), ds, metav1.CreateOptions{})
-	if err != nil {
+func (c *Client) applyDaemonSet(ctx context.Context, ds *appsv1.DaemonSet) (*appsv1.DaemonSet, error) {
+	var applied *appsv1.DaemonSet
+	err := c.applyObject(ctx, ds, appsv1.SchemeGroupVersion.WithKind("DaemonSet"), func(data []byte, opts metav1.PatchOptions) error {
+		var err error
+		applied, err = c.kclient.AppsV1().DaemonSets(ds.GetNamespace()).Patch(ctx, ds.GetName(), types.ApplyPatchType, data, opts)
 		return err
-	}
-
-	return c.WaitForDaemonSetRollout(d)
+	})
+	return applied, err
 }
 
-func (c *Client) UpdateDaemonSet(ds *appsv1.DaemonSet) error {
-	updated, err := c.kclient.AppsV1().DaemonSets(ds.GetNamespace()).Update(context.TODO(), ds, metav1.UpdateOptions{})
-	if err != nil {
-		return err
+func (c *Client) WaitForDaemonSetRollout(ctx context.Context, ds *appsv1.DaemonSet) error {
+	if c.dryRun != nil {
+		return nil
 	}
 
-	return c.WaitForDaemonSetRollout(updated)
-}
-
-func (c *Client) WaitForDaemonSetRollout(ds *appsv1.DaemonSet) error {
 	var lastErr error
-	if err := wait.Poll(time.Second, deploymentCreateTimeout, func() (bool, error) {
-		d, err := c.kclient.AppsV1().DaemonSets(ds.GetNamespace()).Get(context.TODO(), ds.GetName(), metav1.GetOptions{})
+	if err := poll(ctx, time.Second, deploymentCreateTimeout, func() (bool, error) {
+		d, err := c.kclient.AppsV1().DaemonSets(ds.GetNamespace()).Get(ctx, ds.GetName(), metav1.GetOptions{})
 		if err != nil {
 			return false, err
 		}
@@ -903,35 +962,107 @@ func (c *Client) WaitForDaemonSetRollout(ds *appsv1.DaemonSet) error {
 	return nil
 }
 
-func (c *Client) CreateOrUpdateSecret(s *v1.Secret) error {
+func (c *Client) CreateOrUpdateSecret(ctx context.Context, s *v1.Secret) error {
+	client := c.kclient.CoreV1().Secrets(s.GetNamespace())
+	err := c.applyObject(ctx, s, v1.SchemeGroupVersion.WithKind("Secret"), func(data []byte, opts metav1.PatchOptions) error {
+		res, err := client.Patch(ctx, s.GetName(), types.ApplyPatchType, data, opts)
+		if err == nil && c.dryRun == nil {
+			c.certs.observeSecret(res)
+		}
+		return err
+	})
+	return errors.Wrap(err, "applying Secret object failed")
+}
+
+func (c *Client) CreateIfNotExistSecret(ctx context.Context, s *v1.Secret) error {
+	c.record("Secret", s.GetNamespace(), s.GetName())
 	sClient := c.kclient.CoreV1().Secrets(s.GetNamespace())
-	_, err := sClient.Get(context.TODO(), s.GetName(), metav1.GetOptions{})
+	res, err := sClient.Get(ctx, s.GetName(), metav1.GetOptions{})
 	if apierrors.IsNotFound(err) {
-		_, err := sClient.Create(context.TODO(), s, metav1.CreateOptions{})
+		res, err := sClient.Create(ctx, s, metav1.CreateOptions{})
+		if err == nil && c.dryRun == nil {
+			c.certs.observeSecret(res)
+		}
 		return errors.Wrap(err, "creating Secret object failed")
 	}
-	if err != nil {
-		return errors.Wrap(err, "retrieving Secret object failed")
+	if err == nil {
+		c.certs.observeSecret(res)
 	}
 
-	_, err = sClient.Update(context.TODO(), s, metav1.UpdateOptions{})
-	return errors.Wrap(err, "updating Secret object failed")
+	return errors.Wrap(err, "retrieving Secret object failed")
 }
 
-func (c *Client) CreateIfNotExistSecret(s *v1.Secret) error {
+// CreateOrRotateSecret creates s if it doesn't exist yet. Otherwise the
+// existing secret is passed to rotate which returns its replacement, or nil
+// to leave it as is. The secret in place is returned.
+func (c *Client) CreateOrRotateSecret(ctx context.Context, s *v1.Secret, rotate func(*v1.Secret) (*v1.Secret, error)) (*v1.Secret, error) {
+	c.record("Secret", s.GetNamespace(), s.GetName())
 	sClient := c.kclient.CoreV1().Secrets(s.GetNamespace())
-	_, err := sClient.Get(context.TODO(), s.GetName(), metav1.GetOptions{})
+	existing, err := sClient.Get(ctx, s.GetName(), metav1.GetOptions{})
 	if apierrors.IsNotFound(err) {
-		_, err := sClient.Create(context.TODO(), s, metav1.CreateOptions{})
-		return errors.Wrap(err, "creating Secret object failed")
+		res, err := sClient.Create(ctx, s, metav1.CreateOptions{})
+		if err != nil {
+			return nil, errors.Wrap(err, "creating Secret object failed")
+		}
+		if c.dryRun == nil {
+			c.certs.observeSecret(res)
+		}
+		return res, nil
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "retrieving Secret object failed")
 	}
 
-	return errors.Wrap(err, "retrieving Secret object failed")
+	replacement, err := rotate(existing)
+	if err != nil {
+		return nil, errors.Wrap(err, "rotating Secret object failed")
+	}
+	if replacement == nil {
+		c.certs.observeSecret(existing)
+		return existing, nil
+	}
+
+	replacement = replacement.DeepCopy()
+	replacement.ResourceVersion = existing.ResourceVersion
+	res, err := sClient.Update(ctx, replacement, metav1.UpdateOptions{})
+	if err != nil {
+		return nil, errors.Wrap(err, "updating Secret object failed")
+	}
+	if c.dryRun == nil {
+		c.certs.observeSecret(res)
+	}
+	return res, nil
 }
 
-func (c *Client) CreateOrUpdateConfigMapList(cml *v1.ConfigMapList) error {
+// UpdateSecret writes s as read from the API with its changes. It fails if
+// the secret was changed in the meantime.
+func (c *Client) UpdateSecret(ctx context.Context, s *v1.Secret) error {
+	c.record("Secret", s.GetNamespace(), s.GetName())
+	res, err := c.kclient.CoreV1().Secrets(s.GetNamespace()).Update(ctx, s, metav1.UpdateOptions{})
+	if err == nil && c.dryRun == nil {
+		c.certs.observeSecret(res)
+	}
+	return errors.Wrap(err, "updating Secret object failed")
+}
+
+// RemoveSecretAnnotation removes an annotation of a secret. Applying a
+// secret without the annotation only removes it if the operator set it.
+func (c *Client) RemoveSecretAnnotation(ctx context.Context, namespace, name, key string) error {
+	patch, err := json.Marshal(map[string]interface{}{
+		"metadata": map[string]interface{}{
+			"annotations": map[string]interface{}{key: nil},
+		},
+	})
+	if err != nil {
+		return err
+	}
+	_, err = c.kclient.CoreV1().Secrets(namespace).Patch(ctx, name, types.MergePatchType, patch, metav1.PatchOptions{})
+	return errors.Wrap(err, "removing Secret annotation failed")
+}
+
+func (c *Client) CreateOrUpdateConfigMapList(ctx context.Context, cml *v1.ConfigMapList) error {
 	for _, cm := range cml.Items {
-		err := c.CreateOrUpdateConfigMap(&cm)
+		err := c.CreateOrUpdateConfigMap(ctx, &cm)
 		if err != nil {
 			return err
 		}
@@ -939,39 +1070,30 @@ func (c *Client) CreateOrUpdateConfigMapList(cml *v1.ConfigMapList) error {
 	return nil
 }
 
-func (c *Client) CreateOrUpdateConfigMap(cm *v1.ConfigMap) error {
-	cmClient := c.kclient.CoreV1().ConfigMaps(cm.GetNamespace())
-	_, err := cmClient.Get(context.TODO(), cm.GetName(), metav1.GetOptions{})
-	if apierrors.IsNotFound(err) {
-		_, err := cmClient.Create(context.TODO(), cm, metav1.CreateOptions{})
-		return errors.Wrap(err, "creating ConfigMap object failed")
-	}
-	if err != nil {
-		return errors.Wrap(err, "retrieving ConfigMap object failed")
-	}
-
-	_, err = cmClient.Update(context.TODO(), cm, metav1.UpdateOptions{})
-	return errors.Wrap(err, "updating ConfigMap object failed")
+func (c *Client) CreateOrUpdateConfigMap(ctx context.Context, cm *v1.ConfigMap) error {
+	client := c.kclient.CoreV1().ConfigMaps(cm.GetNamespace())
+	err := c.applyObject(ctx, cm, v1.SchemeGroupVersion.WithKind("ConfigMap"), func(data []byte, opts metav1.PatchOptions) error {
+		res, err := client.Patch(ctx, cm.GetName(), types.ApplyPatchType, data, opts)
+		if err == nil && c.dryRun == nil {
+			c.certs.observeConfigMap(res)
+		}
+		return err
+	})
+	return errors.Wrap(err, "applying ConfigMap object failed")
 }
 
-func (c *Client) CreateOrUpdateNamespace(n *v1.Namespace) error {
-	nClient := c.kclient.CoreV1().Namespaces()
-	_, err := nClient.Get(context.TODO(), n.GetName(), metav1.GetOptions{})
-	if apierrors.IsNotFound(err) {
-		_, err := nClient.Create(context.TODO(), n, metav1.CreateOptions{})
-		return errors.Wrap(err, "creating Namespace object failed")
-	}
-	if err != nil {
-		return errors.Wrap(err, "retrieving Namespace object failed")
-	}
-
-	_, err = nClient.Update(context.TODO(), n, metav1.UpdateOptions{})
-	return errors.Wrap(err, "updating ConfigMap object failed")
+func (c *Client) CreateOrUpdateNamespace(ctx context.Context, n *v1.Namespace) error {
+	client := c.kclient.CoreV1().Namespaces()
+	err := c.applyObject(ctx, n, v1.SchemeGroupVersion.WithKind("Namespace"), func(data []byte, opts metav1.PatchOptions) error {
+		_, err := client.Patch(ctx, n.GetName(), types.ApplyPatchType, data, opts)
+		return err
+	})
+	return errors.Wrap(err, "applying Namespace object failed")
 }
 
-func (c *Client) DeleteIfExists(nsName string) error {
+func (c *Client) DeleteIfExists(ctx context.Context, nsName string) error {
 	nClient := c.kclient.CoreV1().Namespaces()
-	_, err := nClient.Get(context.TODO(), nsName, metav1.GetOptions{})
+	_, err := nClient.Get(ctx, nsName, metav1.GetOptions{})
 	if apierrors.IsNotFound(err) {
 		// Namespace already deleted
 		return nil
@@ -980,235 +1102,147 @@ func (c *Client) DeleteIfExists(nsName string) error {
 		return errors.Wrap(err, "retrieving Namespace object failed")
 	}
 
-	err = nClient.Delete(context.TODO(), nsName, metav1.DeleteOptions{})
+	err = nClient.Delete(ctx, nsName, metav1.DeleteOptions{})
 	return errors.Wrap(err, "deleting ConfigMap object failed")
 }
 
-func (c *Client) CreateIfNotExistConfigMap(cm *v1.ConfigMap) (*v1.ConfigMap, error) {
+func (c *Client) CreateIfNotExistConfigMap(ctx context.Context, cm *v1.ConfigMap) (*v1.ConfigMap, error) {
+	c.record("ConfigMap", cm.GetNamespace(), cm.GetName())
 	cClient := c.kclient.CoreV1().ConfigMaps(cm.GetNamespace())
-	res, err := cClient.Get(context.TODO(), cm.GetName(), metav1.GetOptions{})
+	res, err := cClient.Get(ctx, cm.GetName(), metav1.GetOptions{})
 	if apierrors.IsNotFound(err) {
-		res, err := cClient.Create(context.TODO(), cm, metav1.CreateOptions{})
+		res, err := cClient.Create(ctx, cm, metav1.CreateOptions{})
 		if err != nil {
 			return nil, errors.Wrap(err, "creating ConfigMap object failed")
 		}
+		if c.dryRun == nil {
+			c.certs.observeConfigMap(res)
+		}
 		return res, nil
 	}
 	if err != nil {
 		return nil, errors.Wrap(err, "retrieving ConfigMap object failed")
 	}
+	c.certs.observeConfigMap(res)
 	return res, nil
 }
 
-func (c *Client) CreateOrUpdateService(svc *v1.Service) error {
-	sclient := c.kclient.CoreV1().Services(svc.GetNamespace())
-	s, err := sclient.Get(context.TODO(), svc.GetName(), metav1.GetOptions{})
-	if apierrors.IsNotFound(err) {
-		_, err = sclient.Create(context.TODO(), svc, metav1.CreateOptions{})
-		return errors.Wrap(err, "creating Service object failed")
-	}
-	if err != nil {
-		return errors.Wrap(err, "retrieving Service object failed")
-	}
-
-	svc.ResourceVersion = s.ResourceVersion
-	if svc.Spec.Type == v1.ServiceTypeClusterIP {
-		svc.Spec.ClusterIP = s.Spec.ClusterIP
-	}
-
-	if reflect.DeepEqual(svc.Spec, s.Spec) && reflect.DeepEqual(svc.Annotations, s.Annotations) && reflect.DeepEqual(svc.Labels, s.Labels) {
-		return nil
-	}
-
-	_, err = sclient.Update(context.TODO(), svc, metav1.UpdateOptions{})
-	return errors.Wrap(err, "updating Service object failed")
+func (c *Client) CreateOrUpdateService(ctx context.Context, svc *v1.Service) error {
+	client := c.kclient.CoreV1().Services(svc.GetNamespace())
+	err := c.applyObject(ctx, svc, v1.SchemeGroupVersion.WithKind("Service"), func(data []byte, opts metav1.PatchOptions) error {
+		_, err := client.Patch(ctx, svc.GetName(), types.ApplyPatchType, data, opts)
+		return err
+	})
+	return errors.Wrap(err, "applying Service object failed")
 }
 
-func (c *Client) CreateOrUpdateEndpoints(endpoints *v1.Endpoints) error {
-	eclient := c.kclient.CoreV1().Endpoints(endpoints.GetNamespace())
-	e, err := eclient.Get(context.TODO(), endpoints.GetName(), metav1.GetOptions{})
-	if apierrors.IsNotFound(err) {
-		_, err = eclient.Create(context.TODO(), endpoints, metav1.CreateOptions{})
-		return errors.Wrap(err, "creating Endpoints object failed")
-	}
-	if err != nil {
-		return errors.Wrap(err, "retrieving Endpoints object failed")
-	}
-
-	endpoints.ResourceVersion = e.ResourceVersion
-	_, err = eclient.Update(context.TODO(), endpoints, metav1.UpdateOptions{})
-	return errors.Wrap(err, "updating Endpoints object failed")
+func (c *Client) CreateOrUpdateEndpoints(ctx context.Context, endpoints *v1.Endpoints) error {
+	client := c.kclient.CoreV1().Endpoints(endpoints.GetNamespace())
+	err := c.applyObject(ctx, endpoints, v1.SchemeGroupVersion.WithKind("Endpoints"), func(data []byte, opts metav1.PatchOptions) error {
+		_, err := client.Patch(ctx, endpoints.GetName(), types.ApplyPatchType, data, opts)
+		return err
+	})
+	return errors.Wrap(err, "applying Endpoints object failed")
 }
 
-func (c *Client) CreateOrUpdateRoleBinding(rb *rbacv1.RoleBinding) error {
-	rbClient := c.kclient.RbacV1().RoleBindings(rb.GetNamespace())
-	r, err := rbClient.Get(context.TODO(), rb.GetName(), metav1.GetOptions{})
-	if apierrors.IsNotFound(err) {
-		_, err := rbClient.Create(context.TODO(), rb, metav1.CreateOptions{})
-		return errors.Wrap(err, "creating RoleBinding object failed")
-	}
-	if err != nil {
-		return errors.Wrap(err, "retrieving RoleBinding object failed")
-	}
-
-	if reflect.DeepEqual(rb.RoleRef, r.RoleRef) &&
-		reflect.DeepEqual(rb.Subjects, r.Subjects) &&
-		reflect.DeepEqual(rb.Labels, r.Labels) &&
-		reflect.DeepEqual(rb.Annotations, r.Annotations) {
-		return nil
-	}
-
-	_, err = rbClient.Update(context.TODO(), rb, metav1.UpdateOptions{})
-	return errors.Wrap(err, "updating RoleBinding object failed")
+func (c *Client) CreateOrUpdateRoleBinding(ctx context.Context, rb *rbacv1.RoleBinding) error {
+	client := c.kclient.RbacV1().RoleBindings(rb.GetNamespace())
+	err := c.applyObject(ctx, rb, rbacv1.SchemeGroupVersion.WithKind("RoleBinding"), func(data []byte, opts metav1.PatchOptions) error {
+		_, err := client.Patch(ctx, rb.GetName(), types.ApplyPatchType, data, opts)
+		return err
+	})
+	return errors.Wrap(err, "applying RoleBinding object failed")
 }
 
-func (c *Client) CreateOrUpdateRole(r *rbacv1.Role) error {
-	rClient := c.kclient.RbacV1().Roles(r.GetNamespace())
-	_, err := rClient.Get(context.TODO(), r.GetName(), metav1.GetOptions{})
-	if apierrors.IsNotFound(err) {
-		_, err := rClient.Create(context.TODO(), r, metav1.CreateOptions{})
-		return errors.Wrap(err, "creating Role object failed")
-	}
-	if err != nil {
-		return errors.Wrap(err, "retrieving Role object failed")
-	}
-
-	_, err = rClient.Update(context.TODO(), r, metav1.UpdateOptions{})
-	return errors.Wrap(err, "updating Role object failed")
+func (c *Client) CreateOrUpdateRole(ctx context.Context, r *rbacv1.Role) error {
+	client := c.kclient.RbacV1().Roles(r.GetNamespace())
+	err := c.applyObject(ctx, r, rbacv1.SchemeGroupVersion.WithKind("Role"), func(data []byte, opts metav1.PatchOptions) error {
+		_, err := client.Patch(ctx, r.GetName(), types.ApplyPatchType, data, opts)
+		return err
+	})
+	return errors.Wrap(err, "applying Role object failed")
 }
 
-func (c *Client) CreateOrUpdateClusterRole(cr *rbacv1.ClusterRole) error {
-	crClient := c.kclient.RbacV1().ClusterRoles()
-	_, err := crClient.Get(context.TODO(), cr.GetName(), metav1.GetOptions{})
-	if apierrors.IsNotFound(err) {
-		_, err := crClient.Create(context.TODO(), cr, metav1.CreateOptions{})
-		return errors.Wrap(err, "creating ClusterRole object failed")
-	}
-	if err != nil {
-		return errors.Wrap(err, "retrieving ClusterRole object failed")
-	}
-
-	_, err = crClient.Update(context.TODO(), cr, metav1.UpdateOptions{})
-	return errors.Wrap(err, "updating ClusterRole object failed")
+func (c *Client) CreateOrUpdateClusterRole(ctx context.Context, cr *rbacv1.ClusterRole) error {
+	client := c.kclient.RbacV1().ClusterRoles()
+	err := c.applyObject(ctx, cr, rbacv1.SchemeGroupVersion.WithKind("ClusterRole"), func(data []byte, opts metav1.PatchOptions) error {
+		_, err := client.Patch(ctx, cr.GetName(), types.ApplyPatchType, data, opts)
+		return err
+	})
+	return errors.Wrap(err, "applying ClusterRole object failed")
 }
 
-func (c *Client) CreateOrUpdateClusterRoleBinding(crb *rbacv1.ClusterRoleBinding) error {
-	crbClient := c.kclient.RbacV1().ClusterRoleBindings()
-	existing, err := crbClient.Get(context.TODO(), crb.GetName(), metav1.GetOptions{})
-	if apierrors.IsNotFound(err) {
-		_, err := crbClient.Create(context.TODO(), crb, metav1.CreateOptions{})
-		return errors.Wrap(err, "creating ClusterRoleBinding object failed")
-	}
-	if err != nil {
-		return errors.Wrap(err, "retrieving ClusterRoleBinding object failed")
-	}
-
-	changed := reflect.DeepEqual(crb.RoleRef, existing.RoleRef)
-	changed = changed || reflect.DeepEqual(crb.Subjects, existing.Subjects)
-	changed = changed || reflect.DeepEqual(crb.Labels, existing.Labels)
-	changed = changed || reflect.DeepEqual(crb.Annotations, existing.Annotations)
-
-	if !changed {
-		return nil
+func (c *Client) CreateOrUpdateClusterRoleBinding(ctx context.Context, crb *rbacv1.ClusterRoleBinding) error {
+	client := c.kclient.RbacV1().ClusterRoleBindings()
+	applyCRB := func() error {
+		return c.applyObject(ctx, crb, rbacv1.SchemeGroupVersion.WithKind("ClusterRoleBinding"), func(data []byte, opts metav1.PatchOptions) error {
+			_, err := client.Patch(ctx, crb.GetName(), types.ApplyPatchType, data, opts)
+			return err
+		})
 	}
 
-	err = crbClient.Delete(context.TODO(), crb.Name, metav1.DeleteOptions{})
-	if err != nil {
-		return errors.Wrap(err, "deleting ClusterRoleBinding object failed")
+	err := applyCRB()
+	if apierrors.IsInvalid(err) {
+		// The role reference is immutable, recreate the binding.
+		err = c.DeleteClusterRoleBinding(ctx, crb)
+		if err != nil {
+			return errors.Wrap(err, "deleting ClusterRoleBinding object failed")
+		}
+		err = applyCRB()
 	}
-
-	_, err = crbClient.Create(context.TODO(), crb, metav1.CreateOptions{})
-	return errors.Wrap(err, "updating ClusterRoleBinding object failed")
+	return errors.Wrap(err, "applying ClusterRoleBinding object failed")
 }
 
-func (c *Client) CreateOrUpdateServiceAccount(sa *v1.ServiceAccount) error {
-	sClient := c.kclient.CoreV1().ServiceAccounts(sa.GetNamespace())
-	_, err := sClient.Get(context.TODO(), sa.GetName(), metav1.GetOptions{})
-	if apierrors.IsNotFound(err) {
-		_, err := sClient.Create(context.TODO(), sa, metav1.CreateOptions{})
-		return errors.Wrap(err, "creating ServiceAccount object failed")
-	}
-	return errors.Wrap(err, "retrieving ServiceAccount object failed")
-
-	// TODO(brancz): Use Patch instead of Update
-	//
-	// ServiceAccounts get a new secret generated whenever they are updated, even
-	// if nothing has changed. This is likely due to "Update" performing a PUT
-	// call signifying, that this may be a new ServiceAccount, therefore a new
-	// token is needed. The expectation is that Patch does not cause this,
-	// however, currently there has been no need to update ServiceAccounts,
-	// therefore we are skipping this effort for now until we actually need to
-	// change the ServiceAccount.
-	//
-	//if err != nil {
-	//	return errors.Wrap(err, "retrieving ServiceAccount object failed")
-	//}
-	//
-	//_, err = sClient.Update(sa)
-	//return errors.Wrap(err, "updating ServiceAccount object failed")
-}
-
-func (c *Client) CreateOrUpdateServiceMonitor(sm *monv1.ServiceMonitor) error {
-	smClient := c.mclient.MonitoringV1().ServiceMonitors(sm.GetNamespace())
-	oldSm, err := smClient.Get(context.TODO(), sm.GetName(), metav1.GetOptions{})
-	if apierrors.IsNotFound(err) {
-		_, err := smClient.Create(context.TODO(), sm, metav1.CreateOptions{})
-		return errors.Wrap(err, "creating ServiceMonitor object failed")
-	}
-	if err != nil {
-		return errors.Wrap(err, "retrieving ServiceMonitor object failed")
-	}
-
-	sm.ResourceVersion = oldSm.ResourceVersion
-	_, err = smClient.Update(context.TODO(), sm, metav1.UpdateOptions{})
-	return errors.Wrap(err, "updating ServiceMonitor object failed")
+func (c *Client) CreateOrUpdateServiceAccount(ctx context.Context, sa *v1.ServiceAccount) error {
+	client := c.kclient.CoreV1().ServiceAccounts(sa.GetNamespace())
+	err := c.applyObject(ctx, sa, v1.SchemeGroupVersion.WithKind("ServiceAccount"), func(data []byte, opts metav1.PatchOptions) error {
+		_, err := client.Patch(ctx, sa.GetName(), types.ApplyPatchType, data, opts)
+		return err
+	})
+	return errors.Wrap(err, "applying ServiceAccount object failed")
 }
 
-func (c *Client) CreateOrUpdateIngress(ing *v1betaextensions.Ingress) error {
-	ic := c.kclient.ExtensionsV1beta1().Ingresses(ing.GetNamespace())
-	_, err := ic.Get(context.TODO(), ing.GetName(), metav1.GetOptions{})
-	if apierrors.IsNotFound(err) {
-		_, err = ic.Create(context.TODO(), ing, metav1.CreateOptions{})
-		return errors.Wrap(err, "creating Ingress object failed")
-	}
-	if err != nil {
-		return errors.Wrap(err, "retrieving Ingress object failed")
-	}
+func (c *Client) CreateOrUpdateServiceMonitor(ctx context.Context, sm *monv1.ServiceMonitor) error {
+	client := c.mclient.MonitoringV1().ServiceMonitors(sm.GetNamespace())
+	err := c.applyObject(ctx, sm, monv1.SchemeGroupVersion.WithKind(monv1.ServiceMonitorsKind), func(data []byte, opts metav1.PatchOptions) error {
+		_, err := client.Patch(ctx, sm.GetName(), types.ApplyPatchType, data, opts)
+		return err
+	})
+	return errors.Wrap(err, "applying ServiceMonitor object failed")
+}
 
-	_, err = ic.Update(context.TODO(), ing, metav1.UpdateOptions{})
-	return errors.Wrap(err, "updating Ingress object failed")
+func (c *Client) CreateOrUpdateIngress(ctx context.Context, ing *v1betaextensions.Ingress) error {
+	client := c.kclient.ExtensionsV1beta1().Ingresses(ing.GetNamespace())
+	err := c.applyObject(ctx, ing, v1betaextensions.SchemeGroupVersion.WithKind("Ingress"), func(data []byte, opts metav1.PatchOptions) error {
+		_, err := client.Patch(ctx, ing.GetName(), types.ApplyPatchType, data, opts)
+		return err
+	})
+	return errors.Wrap(err, "applying Ingress object failed")
 }
 
-func (c *Client) CreateOrUpdateAPIService(apiService *apiregistrationv1beta1.APIService) error {
-	apsc := c.aggclient.ApiregistrationV1beta1().APIServices()
-	oldAPIService, err := apsc.Get(context.TODO(), apiService.GetName(), metav1.GetOptions{})
-	if apierrors.IsNotFound(err) {
-		_, err = apsc.Create(context.TODO(), apiService, metav1.CreateOptions{})
-		return errors.Wrap(err, "creating APIService object failed")
-	}
-	if err != nil {
-		return errors.Wrap(err, "retrieving APIService object failed")
-	}
+func (c *Client) CreateOrUpdateAPIService(ctx context.Context, apiService *apiregistrationv1beta1.APIService) error {
+	client := c.aggclient.ApiregistrationV1beta1().APIServices()
+	err := c.applyObject(ctx, apiService, apiregistrationv1beta1.SchemeGroupVersion.WithKind("APIService"), func(data []byte, opts metav1.PatchOptions) error {
+		_, err := client.Patch(ctx, apiService.GetName(), types.ApplyPatchType, data, opts)
+		return err
+	})
+	return errors.Wrap(err, "applying APIService object failed")
+}
 
-	apiService.ResourceVersion = oldAPIService.ResourceVersion
-	if len(oldAPIService.Spec.CABundle) > 0 {
-		apiService.Spec.CABundle = oldAPIService.Spec.CABundle
+func (c *Client) WaitForCRDReady(ctx context.Context, crd *extensionsobj.CustomResourceDefinition) error {
+	if c.dryRun != nil {
+		return nil
 	}
-	_, err = apsc.Update(context.TODO(), apiService, metav1.UpdateOptions{})
-	return errors.Wrap(err, "updating APIService object failed")
 
-}
-
-func (c *Client) WaitForCRDReady(crd *extensionsobj.CustomResourceDefinition) error {
-	return wait.Poll(5*time.Second, 5*time.Minute, func() (bool, error) {
-		return c.CRDReady(crd)
+	return poll(ctx, 5*time.Second, 5*time.Minute, func() (bool, error) {
+		return c.CRDReady(ctx, crd)
 	})
 }
 
-func (c *Client) CRDReady(crd *extensionsobj.CustomResourceDefinition) (bool, error) {
+func (c *Client) CRDReady(ctx context.Context, crd *extensionsobj.CustomResourceDefinition) (bool, error) {
 	crdClient := c.eclient.ApiextensionsV1beta1().CustomResourceDefinitions()
 
-	crdEst, err := crdClient.Get(context.TODO(), crd.ObjectMeta.Name, metav1.GetOptions{})
+	crdEst, err := crdClient.Get(ctx, crd.ObjectMeta.Name, metav1.GetOptions{})
 	if err != nil {
 		return false, err
 	}
@@ -1227,12 +1261,23 @@ func (c *Client) CRDReady(crd *extensionsobj.CustomResourceDefinition) (bool, er
 	return false, err
 }
 
+// SetFailureDampening sets how long failures are tolerated before the
+// status reporters report them as Degraded.
+func (c *Client) SetFailureDampening(d FailureDampening) {
+	c.dampening = d
+}
+
+// StatusReporter returns a status reporter for the monitoring
+// ClusterOperator. The consecutive failures are tracked across reporters.
 func (c *Client) StatusReporter() *StatusReporter {
-	return NewStatusReporter(c.oscclient.ConfigV1().ClusterOperators(), "monitoring", c.namespace, c.version)
+	r := NewStatusReporter(c.oscclient.ConfigV1().ClusterOperators(), clusterOperatorName, c.namespace, c.version)
+	r.dampening = c.dampening
+	r.failures = c.failures
+	return r
 }
 
-func (c *Client) DeleteRoleBinding(binding *rbacv1.RoleBinding) error {
-	err := c.kclient.RbacV1().RoleBindings(binding.Namespace).Delete(context.TODO(), binding.GetName(), metav1.DeleteOptions{})
+func (c *Client) DeleteRoleBinding(ctx context.Context, binding *rbacv1.RoleBinding) error {
+	err := c.kclient.RbacV1().RoleBindings(binding.Namespace).Delete(ctx, binding.GetName(), metav1.DeleteOptions{})
 	if apierrors.IsNotFound(err) {
 		return nil
 	}
@@ -1240,8 +1285,8 @@ func (c *Client) DeleteRoleBinding(binding *rbacv1.RoleBinding) error {
 	return err
 }
 
-func (c *Client) DeleteRole(role *rbacv1.Role) error {
-	err := c.kclient.RbacV1().Roles(role.Namespace).Delete(context.TODO(), role.GetName(), metav1.DeleteOptions{})
+func (c *Client) DeleteRole(ctx context.Context, role *rbacv1.Role) error {
+	err := c.kclient.RbacV1().Roles(role.Namespace).Delete(ctx, role.GetName(), metav1.DeleteOptions{})
 	if apierrors.IsNotFound(err) {
 		return nil
 	}
@@ -78,10 +78,16 @@ func (cs *conditions) setCondition(condition v1.ClusterStatusConditionType, stat
 	c, ok := cs.entryMap[condition]
 
 	if !ok || c.Status != status || c.Message != message {
+		// The transition time only changes along with the status, so that
+		// it tells how long the condition has been in its current status.
+		transitionTime := time
+		if ok && c.Status == status {
+			transitionTime = c.LastTransitionTime
+		}
 		entries[condition] = v1.ClusterOperatorStatusCondition{
 			Type:               condition,
 			Status:             status,
-			LastTransitionTime: time,
+			LastTransitionTime: transitionTime,
 			Message:            message,
 			Reason:             reason,
 		}
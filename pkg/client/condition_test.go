@@ -518,7 +518,7 @@ func TestConditions(t *testing.T) {
 				},
 			}),
 		}, {
-			name: "change due to message change",
+			name: "message change keeps the transition time",
 			conditions: func() *conditions {
 				cs := newConditions(
 					configv1.ClusterOperatorStatus{
@@ -539,7 +539,7 @@ func TestConditions(t *testing.T) {
 				{
 					Type:               configv1.OperatorAvailable,
 					Status:             configv1.ConditionTrue,
-					LastTransitionTime: v1.Unix(0, 0),
+					LastTransitionTime: v1.Time{},
 					Message:            "bar",
 					Reason:             "foo",
 				},
@@ -0,0 +1,90 @@
+// Copyright 2020 The Cluster Monitoring Operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// InvalidConfigurationReason is the reason of failures caused by an invalid
+// configuration. They aren't transient and are never dampened.
+const InvalidConfigurationReason = "InvalidConfiguration"
+
+// FailureDampening delays reporting failed syncs as Degraded so that
+// transient errors don't make the ClusterOperator flap. Failures are
+// reported once both the threshold and the grace period are exceeded. The
+// zero value reports every failure right away.
+type FailureDampening struct {
+	// Threshold is the number of consecutive failures before Degraded is
+	// reported. Failed syncs are retried after a few milliseconds at first,
+	// so a threshold alone hardly delays the report.
+	Threshold int
+	// GracePeriod is the duration failures must last before Degraded is
+	// reported.
+	GracePeriod time.Duration
+}
+
+// dampened returns true if count consecutive failures since the given time
+// shouldn't be reported yet. Failures with InvalidConfigurationReason are
+// reported right away.
+func (d FailureDampening) dampened(reason string, count int, since, now metav1.Time) bool {
+	if reason == InvalidConfigurationReason {
+		return false
+	}
+	return count < d.Threshold || now.Sub(since.Time) < d.GracePeriod
+}
+
+// failureTracker counts the consecutive failed syncs. It outlives the
+// status reporters, which are created for every sync.
+type failureTracker struct {
+	mtx   sync.Mutex
+	count int
+	since metav1.Time
+}
+
+// fail records a failure at now and returns the number of consecutive
+// failures and the time of the first one.
+func (t *failureTracker) fail(now metav1.Time) (int, metav1.Time) {
+	t.mtx.Lock()
+	defer t.mtx.Unlock()
+
+	if t.count == 0 {
+		t.since = now
+	}
+	t.count++
+
+	return t.count, t.since
+}
+
+// reset records a successful sync.
+func (t *failureTracker) reset() {
+	t.mtx.Lock()
+	defer t.mtx.Unlock()
+
+	t.count = 0
+	t.since = metav1.Time{}
+}
+
+// failureNote describes count consecutive failures since the given time.
+func failureNote(count int, since metav1.Time) string {
+	if count == 1 {
+		return fmt.Sprintf("Failed once at %s.", since.UTC().Format(time.RFC3339))
+	}
+	return fmt.Sprintf("Failed %d consecutive times since %s.", count, since.UTC().Format(time.RFC3339))
+}
@@ -0,0 +1,337 @@
+// Copyright 2020 The Cluster Monitoring Operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// Actions of planned changes.
+const (
+	ActionCreate = "create"
+	ActionUpdate = "update"
+	ActionDelete = "delete"
+)
+
+// redacted replaces the values of Secret data in field changes.
+const redacted = "<redacted>"
+
+// Plan records the changes the operator would make to the cluster. It is
+// safe for concurrent use.
+type Plan struct {
+	mtx     sync.Mutex
+	changes []Change
+}
+
+// Change is a pending change of a single object.
+type Change struct {
+	Action string `json:"action"`
+	// Resource is the group-qualified resource, e.g. "deployments.apps".
+	Resource  string `json:"resource"`
+	Kind      string `json:"kind,omitempty"`
+	Namespace string `json:"namespace,omitempty"`
+	Name      string `json:"name"`
+	// Fields lists the changed fields of updates.
+	Fields []FieldChange `json:"fields,omitempty"`
+}
+
+// FieldChange is the change of a single field. Old is unset for added
+// fields and New for removed fields.
+type FieldChange struct {
+	Path string      `json:"path"`
+	Old  interface{} `json:"old,omitempty"`
+	New  interface{} `json:"new,omitempty"`
+}
+
+// Changes returns the recorded changes ordered by resource, namespace and
+// name.
+func (p *Plan) Changes() []Change {
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+
+	changes := make([]Change, len(p.changes))
+	copy(changes, p.changes)
+	sort.SliceStable(changes, func(i, j int) bool {
+		a, b := changes[i], changes[j]
+		if a.Resource != b.Resource {
+			return a.Resource < b.Resource
+		}
+		if a.Namespace != b.Namespace {
+			return a.Namespace < b.Namespace
+		}
+		return a.Name < b.Name
+	})
+	return changes
+}
+
+func (p *Plan) add(c Change) {
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+	p.changes = append(p.changes, c)
+}
+
+// EnableDryRun makes the client send every mutating request with server-side
+// dry-run and record the resulting changes in the returned plan. Waiting for
+// objects to become ready returns right away since nothing changes. It must
+// be called before the client is used.
+func (c *Client) EnableDryRun() *Plan {
+	c.dryRun = &Plan{}
+	return c.dryRun
+}
+
+// dryRunTransport is a round tripper turning the write requests into
+// server-side dry-run requests once dry-run is enabled on the client.
+type dryRunTransport struct {
+	next   http.RoundTripper
+	client *Client
+}
+
+func (t *dryRunTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	plan := t.client.dryRun
+	action, ok := writeVerbs[req.Method]
+	if plan == nil || !ok {
+		return t.next.RoundTrip(req)
+	}
+
+	// Updates and patches may also create the object, e.g. for apply
+	// requests.
+	var current map[string]interface{}
+	if req.Method == http.MethodPut || req.Method == http.MethodPatch {
+		var err error
+		current, err = t.get(req)
+		if err != nil {
+			return nil, err
+		}
+		action = ActionUpdate
+		if current == nil {
+			action = ActionCreate
+		}
+	}
+
+	req = req.Clone(req.Context())
+	q := req.URL.Query()
+	q.Set("dryRun", metav1.DryRunAll)
+	req.URL.RawQuery = q.Encode()
+
+	resp, err := t.next.RoundTrip(req)
+	if err != nil || resp.StatusCode >= http.StatusMultipleChoices {
+		return resp, err
+	}
+
+	body, err := ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, errors.Wrap(err, "reading dry-run response failed")
+	}
+	resp.Body = ioutil.NopCloser(bytes.NewReader(body))
+
+	// Non-JSON responses are recorded without details.
+	var result map[string]interface{}
+	_ = json.Unmarshal(body, &result)
+
+	if change, ok := plannedChange(req.URL.Path, action, current, result); ok {
+		plan.add(change)
+	}
+	return resp, nil
+}
+
+// get returns the current state of the object targeted by req or nil if it
+// doesn't exist.
+func (t *dryRunTransport) get(req *http.Request) (map[string]interface{}, error) {
+	u := *req.URL
+	u.RawQuery = ""
+	get, err := http.NewRequestWithContext(req.Context(), http.MethodGet, u.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+	get.Header = req.Header.Clone()
+	get.Header.Del("Content-Type")
+	get.Header.Set("Accept", "application/json")
+
+	resp, err := t.next.RoundTrip(get)
+	if err != nil {
+		return nil, errors.Wrap(err, "getting current state failed")
+	}
+	defer resp.Body.Close()
+
+	switch {
+	case resp.StatusCode == http.StatusNotFound:
+		return nil, nil
+	case resp.StatusCode != http.StatusOK:
+		return nil, errors.Errorf("getting current state of %s failed: %s", u.Path, resp.Status)
+	}
+
+	var obj map[string]interface{}
+	if err := json.NewDecoder(resp.Body).Decode(&obj); err != nil {
+		return nil, errors.Wrapf(err, "decoding current state of %s failed", u.Path)
+	}
+	return obj, nil
+}
+
+// plannedChange returns the change made by a write request to path given the
+// object before and after the request. Updates without changes are omitted.
+func plannedChange(path, action string, before, after map[string]interface{}) (Change, bool) {
+	group, resource := parseResource(path)
+	c := Change{Action: action, Resource: resource}
+	if group != "" {
+		c.Resource += "." + group
+	}
+
+	obj := after
+	if obj == nil || obj["kind"] == "Status" {
+		obj = before
+	}
+	if obj != nil {
+		c.Kind, _ = obj["kind"].(string)
+		md, _ := obj["metadata"].(map[string]interface{})
+		c.Namespace, _ = md["namespace"].(string)
+		c.Name, _ = md["name"].(string)
+	}
+	if c.Name == "" {
+		// Deletions may only return a status.
+		c.Namespace, c.Name = parseObject(path)
+	}
+
+	if action != ActionUpdate {
+		return c, true
+	}
+
+	c.Fields = diff("", prune(before), prune(after))
+	if c.Kind == "Secret" {
+		for i := range c.Fields {
+			if !strings.HasPrefix(c.Fields[i].Path, "data") && !strings.HasPrefix(c.Fields[i].Path, "stringData") {
+				continue
+			}
+			if c.Fields[i].Old != nil {
+				c.Fields[i].Old = redacted
+			}
+			if c.Fields[i].New != nil {
+				c.Fields[i].New = redacted
+			}
+		}
+	}
+	return c, len(c.Fields) > 0
+}
+
+// parseObject returns the namespace and name of the object addressed by a
+// request path such as /apis/apps/v1/namespaces/foo/deployments/bar.
+func parseObject(path string) (string, string) {
+	parts := strings.Split(strings.Trim(path, "/"), "/")
+	switch {
+	case len(parts) >= 2 && parts[0] == "api":
+		parts = parts[2:]
+	case len(parts) >= 3 && parts[0] == "apis":
+		parts = parts[3:]
+	default:
+		return "", ""
+	}
+
+	var namespace string
+	if len(parts) >= 3 && parts[0] == "namespaces" {
+		namespace = parts[1]
+		parts = parts[2:]
+	}
+	if len(parts) < 2 {
+		return namespace, ""
+	}
+	return namespace, parts[1]
+}
+
+// prune removes the fields maintained by the API server from obj.
+func prune(obj map[string]interface{}) map[string]interface{} {
+	if obj == nil {
+		return nil
+	}
+
+	pruned := make(map[string]interface{}, len(obj))
+	for k, v := range obj {
+		pruned[k] = v
+	}
+	delete(pruned, "status")
+
+	if md, ok := obj["metadata"].(map[string]interface{}); ok {
+		prunedMD := make(map[string]interface{}, len(md))
+		for k, v := range md {
+			prunedMD[k] = v
+		}
+		for _, k := range []string{"resourceVersion", "generation", "managedFields", "creationTimestamp", "uid", "selfLink"} {
+			delete(prunedMD, k)
+		}
+		pruned["metadata"] = prunedMD
+	}
+
+	return pruned
+}
+
+// diff returns the changed fields between two decoded JSON values. Lists of
+// different lengths are reported as a whole.
+func diff(path string, a, b interface{}) []FieldChange {
+	switch av := a.(type) {
+	case map[string]interface{}:
+		bv, ok := b.(map[string]interface{})
+		if !ok {
+			break
+		}
+
+		keys := make([]string, 0, len(av)+len(bv))
+		for k := range av {
+			keys = append(keys, k)
+		}
+		for k := range bv {
+			if _, ok := av[k]; !ok {
+				keys = append(keys, k)
+			}
+		}
+		sort.Strings(keys)
+
+		var changes []FieldChange
+		for _, k := range keys {
+			p := k
+			if path != "" {
+				p = path + "." + k
+			}
+			changes = append(changes, diff(p, av[k], bv[k])...)
+		}
+		return changes
+
+	case []interface{}:
+		bv, ok := b.([]interface{})
+		if !ok || len(av) != len(bv) {
+			break
+		}
+
+		var changes []FieldChange
+		for i := range av {
+			changes = append(changes, diff(fmt.Sprintf("%s[%d]", path, i), av[i], bv[i])...)
+		}
+		return changes
+	}
+
+	if reflect.DeepEqual(a, b) {
+		return nil
+	}
+	return []FieldChange{{Path: path, Old: a, New: b}}
+}
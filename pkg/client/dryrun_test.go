@@ -0,0 +1,210 @@
+// Copyright 2020 The Cluster Monitoring Operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func decode(t *testing.T, s string) map[string]interface{} {
+	t.Helper()
+	var obj map[string]interface{}
+	if err := json.Unmarshal([]byte(s), &obj); err != nil {
+		t.Fatal(err)
+	}
+	return obj
+}
+
+func TestParseObject(t *testing.T) {
+	for _, tc := range []struct {
+		path      string
+		namespace string
+		name      string
+	}{
+		{path: "/api/v1/namespaces/openshift-monitoring", name: "openshift-monitoring"},
+		{path: "/api/v1/namespaces/openshift-monitoring/secrets", namespace: "openshift-monitoring"},
+		{path: "/api/v1/namespaces/openshift-monitoring/secrets/foo", namespace: "openshift-monitoring", name: "foo"},
+		{path: "/apis/apps/v1/namespaces/openshift-monitoring/deployments/foo", namespace: "openshift-monitoring", name: "foo"},
+		{path: "/apis/rbac.authorization.k8s.io/v1/clusterroles/foo", name: "foo"},
+		{path: "/version"},
+	} {
+		t.Run(tc.path, func(t *testing.T) {
+			namespace, name := parseObject(tc.path)
+			if namespace != tc.namespace || name != tc.name {
+				t.Fatalf("expected %q/%q, got %q/%q", tc.namespace, tc.name, namespace, name)
+			}
+		})
+	}
+}
+
+func TestDiff(t *testing.T) {
+	before := decode(t, `{
+		"metadata": {"name": "foo", "resourceVersion": "1", "labels": {"a": "1"}},
+		"spec": {"replicas": 1, "args": ["--foo", "--bar"], "ports": [1, 2]},
+		"status": {"ready": true}
+	}`)
+	after := decode(t, `{
+		"metadata": {"name": "foo", "resourceVersion": "2", "labels": {"b": "2"}},
+		"spec": {"replicas": 2, "args": ["--foo", "--baz"], "ports": [1]},
+		"status": {"ready": false}
+	}`)
+
+	got := diff("", prune(before), prune(after))
+	expected := []FieldChange{
+		{Path: "metadata.labels.a", Old: "1"},
+		{Path: "metadata.labels.b", New: "2"},
+		{Path: "spec.args[1]", Old: "--bar", New: "--baz"},
+		{Path: "spec.ports", Old: []interface{}{1.0, 2.0}, New: []interface{}{1.0}},
+		{Path: "spec.replicas", Old: 1.0, New: 2.0},
+	}
+	if !reflect.DeepEqual(got, expected) {
+		t.Fatalf("expected %v, got %v", expected, got)
+	}
+
+	if before["status"] == nil || before["metadata"].(map[string]interface{})["resourceVersion"] == nil {
+		t.Fatal("expected the original object to be left alone")
+	}
+}
+
+func TestPlannedChange(t *testing.T) {
+	for _, tc := range []struct {
+		name   string
+		path   string
+		action string
+		before string
+		after  string
+		ok     bool
+		change Change
+	}{
+		{
+			name:   "create",
+			path:   "/apis/apps/v1/namespaces/foo/deployments",
+			action: ActionCreate,
+			after:  `{"kind": "Deployment", "metadata": {"namespace": "foo", "name": "bar"}}`,
+			ok:     true,
+			change: Change{Action: ActionCreate, Resource: "deployments.apps", Kind: "Deployment", Namespace: "foo", Name: "bar"},
+		},
+		{
+			name:   "delete",
+			path:   "/api/v1/namespaces/foo/services/bar",
+			action: ActionDelete,
+			after:  `{"kind": "Status", "status": "Success"}`,
+			ok:     true,
+			change: Change{Action: ActionDelete, Resource: "services", Namespace: "foo", Name: "bar"},
+		},
+		{
+			name:   "update",
+			path:   "/api/v1/namespaces/foo/configmaps/bar",
+			action: ActionUpdate,
+			before: `{"kind": "ConfigMap", "metadata": {"namespace": "foo", "name": "bar"}, "data": {"a": "1"}}`,
+			after:  `{"kind": "ConfigMap", "metadata": {"namespace": "foo", "name": "bar"}, "data": {"a": "2"}}`,
+			ok:     true,
+			change: Change{Action: ActionUpdate, Resource: "configmaps", Kind: "ConfigMap", Namespace: "foo", Name: "bar", Fields: []FieldChange{{Path: "data.a", Old: "1", New: "2"}}},
+		},
+		{
+			name:   "update without changes",
+			path:   "/api/v1/namespaces/foo/configmaps/bar",
+			action: ActionUpdate,
+			before: `{"kind": "ConfigMap", "metadata": {"namespace": "foo", "name": "bar", "resourceVersion": "1"}, "data": {"a": "1"}}`,
+			after:  `{"kind": "ConfigMap", "metadata": {"namespace": "foo", "name": "bar", "resourceVersion": "2"}, "data": {"a": "1"}}`,
+		},
+		{
+			name:   "secret update",
+			path:   "/api/v1/namespaces/foo/secrets/bar",
+			action: ActionUpdate,
+			before: `{"kind": "Secret", "metadata": {"namespace": "foo", "name": "bar"}, "data": {"a": "MQ=="}}`,
+			after:  `{"kind": "Secret", "metadata": {"namespace": "foo", "name": "bar", "labels": {"b": "2"}}, "data": {"a": "Mg==", "b": "Mg=="}}`,
+			ok:     true,
+			change: Change{Action: ActionUpdate, Resource: "secrets", Kind: "Secret", Namespace: "foo", Name: "bar", Fields: []FieldChange{
+				{Path: "data.a", Old: redacted, New: redacted},
+				{Path: "data.b", New: redacted},
+				{Path: "metadata.labels", New: map[string]interface{}{"b": "2"}},
+			}},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			var before, after map[string]interface{}
+			if tc.before != "" {
+				before = decode(t, tc.before)
+			}
+			if tc.after != "" {
+				after = decode(t, tc.after)
+			}
+
+			change, ok := plannedChange(tc.path, tc.action, before, after)
+			if ok != tc.ok {
+				t.Fatalf("expected ok %v, got %v", tc.ok, ok)
+			}
+			if ok && !reflect.DeepEqual(change, tc.change) {
+				t.Fatalf("expected %+v, got %+v", tc.change, change)
+			}
+		})
+	}
+}
+
+func TestDryRunTransport(t *testing.T) {
+	var requests []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method+" "+r.URL.String())
+		switch r.Method {
+		case http.MethodGet:
+			w.Write([]byte(`{"kind": "ConfigMap", "metadata": {"namespace": "foo", "name": "bar"}, "data": {"a": "1"}}`))
+		case http.MethodPatch:
+			w.Write([]byte(`{"kind": "ConfigMap", "metadata": {"namespace": "foo", "name": "bar"}, "data": {"a": "2"}}`))
+		}
+	}))
+	defer srv.Close()
+
+	c := &Client{}
+	transport := &dryRunTransport{next: http.DefaultTransport, client: c}
+
+	do := func(method string) {
+		req, err := http.NewRequest(method, srv.URL+"/api/v1/namespaces/foo/configmaps/bar?fieldManager=test", strings.NewReader("{}"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		resp, err := transport.RoundTrip(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		resp.Body.Close()
+	}
+
+	// Requests are passed through until dry-run is enabled.
+	do(http.MethodPatch)
+	plan := c.EnableDryRun()
+	do(http.MethodGet)
+	do(http.MethodPatch)
+
+	expected := []string{
+		"PATCH /api/v1/namespaces/foo/configmaps/bar?fieldManager=test",
+		"GET /api/v1/namespaces/foo/configmaps/bar?fieldManager=test",
+		"GET /api/v1/namespaces/foo/configmaps/bar",
+		"PATCH /api/v1/namespaces/foo/configmaps/bar?dryRun=All&fieldManager=test",
+	}
+	if !reflect.DeepEqual(requests, expected) {
+		t.Fatalf("expected requests %v, got %v", expected, requests)
+	}
+
+	changes := plan.Changes()
+	if len(changes) != 1 || changes[0].Action != ActionUpdate || !reflect.DeepEqual(changes[0].Fields, []FieldChange{{Path: "data.a", Old: "1", New: "2"}}) {
+		t.Fatalf("expected a single update of data.a, got %+v", changes)
+	}
+}
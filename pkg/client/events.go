@@ -0,0 +1,164 @@
+// Copyright 2020 The Cluster Monitoring Operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+
+	v1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	corev1client "k8s.io/client-go/kubernetes/typed/core/v1"
+	"k8s.io/klog"
+)
+
+// maxCachedEvents bounds the number of events remembered for aggregation.
+const maxCachedEvents = 1024
+
+// eventKey identifies repetitions of an event.
+type eventKey struct {
+	object                 v1.ObjectReference
+	eventType, reason, msg string
+}
+
+// eventRecorder emits Events about the objects of the operator. Repeated
+// events increase the count of the existing Event instead of creating new
+// ones. Failing to emit an event is only logged.
+type eventRecorder struct {
+	client corev1client.EventsGetter
+
+	mtx sync.Mutex
+	// events maps the recently emitted events to their Event object.
+	events map[eventKey]*v1.Event
+}
+
+func newEventRecorder(client corev1client.EventsGetter) *eventRecorder {
+	return &eventRecorder{
+		client: client,
+		events: map[eventKey]*v1.Event{},
+	}
+}
+
+// Eventf emits an event of type eventType about object.
+func (r *eventRecorder) Eventf(ctx context.Context, object v1.ObjectReference, eventType, reason, format string, args ...interface{}) {
+	key := eventKey{
+		object:    object,
+		eventType: eventType,
+		reason:    reason,
+		msg:       fmt.Sprintf(format, args...),
+	}
+
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+
+	now := metav1.Now()
+	if ev, ok := r.events[key]; ok {
+		err := r.patchCount(ctx, ev, ev.Count+1, now)
+		if err == nil {
+			ev.Count++
+			ev.LastTimestamp = now
+			return
+		}
+		if !apierrors.IsNotFound(err) {
+			klog.Warningf("Could not update event %s/%s: %v", ev.Namespace, ev.Name, err)
+			return
+		}
+		// The event expired, start over.
+		delete(r.events, key)
+	}
+
+	// Events about cluster-scoped objects go to the default namespace.
+	namespace := object.Namespace
+	if namespace == "" {
+		namespace = metav1.NamespaceDefault
+	}
+
+	ev := &v1.Event{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      fmt.Sprintf("%v.%x", object.Name, now.UnixNano()),
+			Namespace: namespace,
+		},
+		InvolvedObject: object,
+		Reason:         reason,
+		Message:        key.msg,
+		Type:           eventType,
+		FirstTimestamp: now,
+		LastTimestamp:  now,
+		Count:          1,
+		Source:         v1.EventSource{Component: FieldManager},
+	}
+	ev, err := r.client.Events(namespace).Create(ctx, ev, metav1.CreateOptions{})
+	if err != nil {
+		klog.Warningf("Could not emit %s event %q about %s %s: %v", eventType, reason, object.Kind, object.Name, err)
+		return
+	}
+
+	if len(r.events) >= maxCachedEvents {
+		r.events = map[eventKey]*v1.Event{}
+	}
+	r.events[key] = ev
+}
+
+// patchCount sets the count and last timestamp of ev.
+func (r *eventRecorder) patchCount(ctx context.Context, ev *v1.Event, count int32, now metav1.Time) error {
+	patch, err := json.Marshal(map[string]interface{}{
+		"count":         count,
+		"lastTimestamp": now,
+	})
+	if err != nil {
+		return err
+	}
+	_, err = r.client.Events(ev.Namespace).Patch(ctx, ev.Name, types.MergePatchType, patch, metav1.PatchOptions{})
+	return err
+}
+
+// clusterOperatorName is the name of the ClusterOperator of the operator.
+const clusterOperatorName = "monitoring"
+
+// ConfigMapEventf emits an event about cm, e.g. to tell its owner why its
+// content was rejected. Nothing is emitted in dry-run mode.
+func (c *Client) ConfigMapEventf(ctx context.Context, cm *v1.ConfigMap, eventType, reason, format string, args ...interface{}) {
+	c.objectEventf(ctx, v1.ObjectReference{
+		APIVersion:      "v1",
+		Kind:            "ConfigMap",
+		Namespace:       cm.Namespace,
+		Name:            cm.Name,
+		UID:             cm.UID,
+		ResourceVersion: cm.ResourceVersion,
+	}, eventType, reason, format, args...)
+}
+
+// ClusterOperatorEventf emits an event about the ClusterOperator of the
+// operator. Nothing is emitted in dry-run mode.
+func (c *Client) ClusterOperatorEventf(ctx context.Context, eventType, reason, format string, args ...interface{}) {
+	c.objectEventf(ctx, v1.ObjectReference{
+		APIVersion: "config.openshift.io/v1",
+		Kind:       "ClusterOperator",
+		Name:       clusterOperatorName,
+	}, eventType, reason, format, args...)
+}
+
+// objectEventf emits an event about object. Nothing is emitted in dry-run
+// mode.
+func (c *Client) objectEventf(ctx context.Context, object v1.ObjectReference, eventType, reason, format string, args ...interface{}) {
+	if c.dryRun != nil {
+		return
+	}
+	c.events.Eventf(ctx, object, eventType, reason, format, args...)
+}
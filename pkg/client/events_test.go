@@ -0,0 +1,111 @@
+// Copyright 2020 The Cluster Monitoring Operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"path"
+	"reflect"
+	"testing"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+)
+
+func TestEventRecorder(t *testing.T) {
+	var (
+		requests []string
+		// expired makes the next patch fail as if the event was gone.
+		expired bool
+	)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		// The generated event names are left out.
+		p := req.URL.Path
+		if req.Method == http.MethodPatch {
+			p = path.Dir(p)
+		}
+		requests = append(requests, req.Method+" "+p)
+
+		body, err := ioutil.ReadAll(req.Body)
+		if err != nil {
+			t.Error(err)
+			return
+		}
+
+		switch req.Method {
+		case http.MethodPost:
+			w.WriteHeader(http.StatusCreated)
+			w.Write(body)
+		case http.MethodPatch:
+			if expired {
+				expired = false
+				w.WriteHeader(http.StatusNotFound)
+				fmt.Fprint(w, `{"kind": "Status", "apiVersion": "v1", "status": "Failure", "reason": "NotFound", "code": 404}`)
+				return
+			}
+			var patch struct{ Count int32 }
+			if err := json.Unmarshal(body, &patch); err != nil {
+				t.Error(err)
+				return
+			}
+			fmt.Fprintf(w, `{"count": %d}`, patch.Count)
+		default:
+			t.Errorf("unexpected request %s %s", req.Method, req.URL.Path)
+		}
+	}))
+	defer srv.Close()
+
+	kclient, err := kubernetes.NewForConfig(&rest.Config{Host: srv.URL})
+	if err != nil {
+		t.Fatal(err)
+	}
+	r := newEventRecorder(kclient.CoreV1())
+
+	ctx := context.Background()
+	cm := v1.ObjectReference{Kind: "ConfigMap", Namespace: "foo", Name: "bar"}
+	co := v1.ObjectReference{Kind: "ClusterOperator", Name: "monitoring"}
+
+	r.Eventf(ctx, cm, v1.EventTypeWarning, "InvalidConfiguration", "error %d", 1)
+	r.Eventf(ctx, cm, v1.EventTypeWarning, "InvalidConfiguration", "error %d", 1)
+	r.Eventf(ctx, cm, v1.EventTypeWarning, "InvalidConfiguration", "error %d", 2)
+	r.Eventf(ctx, co, v1.EventTypeNormal, "TaskRecovered", "recovered")
+	expired = true
+	r.Eventf(ctx, co, v1.EventTypeNormal, "TaskRecovered", "recovered")
+
+	expected := []string{
+		"POST /api/v1/namespaces/foo/events",
+		"PATCH /api/v1/namespaces/foo/events",
+		"POST /api/v1/namespaces/foo/events",
+		"POST /api/v1/namespaces/default/events",
+		"PATCH /api/v1/namespaces/default/events",
+		"POST /api/v1/namespaces/default/events",
+	}
+	if !reflect.DeepEqual(requests, expected) {
+		t.Fatalf("expected requests\n%q\ngot\n%q", expected, requests)
+	}
+
+	for key, ev := range r.events {
+		if key.msg == "error 1" && ev.Count != 2 {
+			t.Errorf("expected the repeated event to be counted twice, got %d", ev.Count)
+		}
+	}
+}
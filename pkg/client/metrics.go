@@ -0,0 +1,97 @@
+// Copyright 2020 The Cluster Monitoring Operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// writeVerbs maps the HTTP methods of write requests to API verbs.
+var writeVerbs = map[string]string{
+	http.MethodPost:   "create",
+	http.MethodPut:    "update",
+	http.MethodPatch:  "patch",
+	http.MethodDelete: "delete",
+}
+
+// requestCounter is a round tripper counting the write requests issued
+// against the API server by verb and resource.
+type requestCounter struct {
+	next     http.RoundTripper
+	requests *prometheus.CounterVec
+}
+
+func newRequestsCounterVec() *prometheus.CounterVec {
+	return prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "cluster_monitoring_operator_client_write_requests_total",
+		Help: "Number of create, update, patch and delete requests issued against the API server by resource.",
+	}, []string{"verb", "group", "resource"})
+}
+
+func newFieldConflictsCounterVec() *prometheus.CounterVec {
+	return prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "cluster_monitoring_operator_field_conflicts_total",
+		Help: "Number of applies which restored fields changed by other field managers by kind and field manager.",
+	}, []string{"kind", "manager"})
+}
+
+func (rc *requestCounter) RoundTrip(req *http.Request) (*http.Response, error) {
+	if verb, ok := writeVerbs[req.Method]; ok {
+		group, resource := parseResource(req.URL.Path)
+		rc.requests.WithLabelValues(verb, group, resource).Inc()
+	}
+	return rc.next.RoundTrip(req)
+}
+
+// parseResource returns the API group and resource of a request path such as
+// /apis/apps/v1/namespaces/foo/deployments/bar. Subresources are appended to
+// the resource, e.g. "clusteroperators/status".
+func parseResource(path string) (string, string) {
+	parts := strings.Split(strings.Trim(path, "/"), "/")
+
+	var group string
+	switch {
+	case len(parts) >= 2 && parts[0] == "api":
+		parts = parts[2:]
+	case len(parts) >= 3 && parts[0] == "apis":
+		group = parts[1]
+		parts = parts[3:]
+	default:
+		return "", ""
+	}
+
+	// Skip the namespace of namespaced resources but not the namespaces
+	// resource itself.
+	if len(parts) >= 3 && parts[0] == "namespaces" {
+		parts = parts[2:]
+	}
+
+	switch len(parts) {
+	case 0:
+		return group, ""
+	case 1, 2:
+		return group, parts[0]
+	default:
+		return group, parts[0] + "/" + parts[2]
+	}
+}
+
+// RegisterMetrics registers the client's metrics with the given registerer.
+func (c *Client) RegisterMetrics(r prometheus.Registerer) {
+	r.MustRegister(c.requests, c.fieldConflicts, c.certs.notAfter)
+}
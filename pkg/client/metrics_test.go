@@ -0,0 +1,98 @@
+// Copyright 2020 The Cluster Monitoring Operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+func TestParseResource(t *testing.T) {
+	for _, tc := range []struct {
+		path     string
+		group    string
+		resource string
+	}{
+		{path: "/api/v1/namespaces", resource: "namespaces"},
+		{path: "/api/v1/namespaces/openshift-monitoring", resource: "namespaces"},
+		{path: "/api/v1/namespaces/openshift-monitoring/secrets", resource: "secrets"},
+		{path: "/api/v1/namespaces/openshift-monitoring/secrets/foo", resource: "secrets"},
+		{path: "/apis/apps/v1/namespaces/openshift-monitoring/deployments/foo", group: "apps", resource: "deployments"},
+		{path: "/apis/rbac.authorization.k8s.io/v1/clusterroles/foo", group: "rbac.authorization.k8s.io", resource: "clusterroles"},
+		{path: "/apis/config.openshift.io/v1/clusteroperators/monitoring/status", group: "config.openshift.io", resource: "clusteroperators/status"},
+		{path: "/version"},
+	} {
+		t.Run(tc.path, func(t *testing.T) {
+			group, resource := parseResource(tc.path)
+			if group != tc.group || resource != tc.resource {
+				t.Fatalf("expected %q/%q, got %q/%q", tc.group, tc.resource, group, resource)
+			}
+		})
+	}
+}
+
+func TestRequestCounter(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer srv.Close()
+
+	requests := newRequestsCounterVec()
+	c := &http.Client{Transport: &requestCounter{next: http.DefaultTransport, requests: requests}}
+
+	for _, method := range []string{http.MethodGet, http.MethodPost, http.MethodPut, http.MethodPut, http.MethodDelete} {
+		req, err := http.NewRequest(method, srv.URL+"/api/v1/namespaces/foo/secrets/bar", nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		resp, err := c.Do(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		resp.Body.Close()
+	}
+
+	r := prometheus.NewRegistry()
+	r.MustRegister(requests)
+	mfs, err := r.Gather()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got := map[string]float64{}
+	for _, mf := range mfs {
+		for _, m := range mf.GetMetric() {
+			labels := map[string]string{}
+			for _, l := range m.GetLabel() {
+				labels[l.GetName()] = l.GetValue()
+			}
+			if labels["resource"] != "secrets" {
+				t.Errorf("unexpected resource %q", labels["resource"])
+			}
+			got[labels["verb"]] = m.GetCounter().GetValue()
+		}
+	}
+
+	expected := map[string]float64{"create": 1, "update": 2, "delete": 1}
+	if len(got) != len(expected) {
+		t.Fatalf("expected %v, got %v", expected, got)
+	}
+	for verb, v := range expected {
+		if got[verb] != v {
+			t.Errorf("expected %v %s requests, got %v", v, verb, got[verb])
+		}
+	}
+}
@@ -0,0 +1,296 @@
+// Copyright 2020 The Cluster Monitoring Operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/klog"
+
+	"github.com/openshift/cluster-monitoring-operator/pkg/manifests"
+)
+
+// objectRef identifies an object applied by the client.
+type objectRef struct {
+	kind      string
+	namespace string
+	name      string
+}
+
+// prunableKind lists and deletes the objects of a kind in all namespaces.
+type prunableKind struct {
+	kind   string
+	list   func(ctx context.Context, opts metav1.ListOptions) (runtime.Object, error)
+	delete func(ctx context.Context, namespace, name string, opts metav1.DeleteOptions) error
+}
+
+// ResetInventory forgets the objects applied so far. The objects applied
+// afterwards are kept by PruneStaleObjects.
+func (c *Client) ResetInventory() {
+	c.inventoryMtx.Lock()
+	defer c.inventoryMtx.Unlock()
+	c.inventory = map[objectRef]struct{}{}
+}
+
+// record adds an object to the inventory.
+func (c *Client) record(kind, namespace, name string) {
+	c.inventoryMtx.Lock()
+	defer c.inventoryMtx.Unlock()
+	if c.inventory == nil {
+		c.inventory = map[objectRef]struct{}{}
+	}
+	c.inventory[objectRef{kind: kind, namespace: namespace, name: name}] = struct{}{}
+}
+
+func (c *Client) inInventory(kind, namespace, name string) bool {
+	c.inventoryMtx.Lock()
+	defer c.inventoryMtx.Unlock()
+	_, ok := c.inventory[objectRef{kind: kind, namespace: namespace, name: name}]
+	return ok
+}
+
+// PruneStaleObjects deletes the objects labelled as managed by the operator
+// which weren't applied since the last ResetInventory call, e.g. because a
+// release dropped or renamed their asset. The objects of the components in
+// skip are kept. It must only be called after every task succeeded,
+// otherwise objects of failed tasks would be deleted.
+func (c *Client) PruneStaleObjects(ctx context.Context, skip map[string]struct{}) error {
+	opts := metav1.ListOptions{LabelSelector: manifests.ManagedByLabel + "=" + manifests.ManagedByValue}
+	propagation := metav1.DeletePropagationBackground
+
+	var errs []error
+	for _, k := range c.prunableKinds() {
+		list, err := k.list(ctx, opts)
+		if err != nil {
+			errs = append(errs, errors.Wrapf(err, "listing %s objects failed", k.kind))
+			continue
+		}
+
+		items, err := meta.ExtractList(list)
+		if err != nil {
+			errs = append(errs, errors.Wrapf(err, "extracting %s objects failed", k.kind))
+			continue
+		}
+
+		for _, item := range items {
+			o, err := meta.Accessor(item)
+			if err != nil {
+				errs = append(errs, err)
+				continue
+			}
+
+			if _, ok := skip[o.GetLabels()[manifests.ComponentLabel]]; ok {
+				continue
+			}
+			if o.GetDeletionTimestamp() != nil || c.inInventory(k.kind, o.GetNamespace(), o.GetName()) {
+				continue
+			}
+
+			klog.Infof("Deleting stale %s %s/%s of component %q", k.kind, o.GetNamespace(), o.GetName(), o.GetLabels()[manifests.ComponentLabel])
+			err = k.delete(ctx, o.GetNamespace(), o.GetName(), metav1.DeleteOptions{PropagationPolicy: &propagation})
+			if err != nil && !apierrors.IsNotFound(err) {
+				errs = append(errs, errors.Wrapf(err, "deleting %s %s/%s failed", k.kind, o.GetNamespace(), o.GetName()))
+				continue
+			}
+			c.certs.forget(k.kind, o.GetNamespace(), o.GetName())
+		}
+	}
+
+	return utilerrors.NewAggregate(errs)
+}
+
+// prunableKinds returns the kinds of objects pruned by PruneStaleObjects.
+// Namespaces are never pruned.
+func (c *Client) prunableKinds() []prunableKind {
+	return []prunableKind{
+		{
+			kind: "ConfigMap",
+			list: func(ctx context.Context, opts metav1.ListOptions) (runtime.Object, error) {
+				return c.kclient.CoreV1().ConfigMaps(metav1.NamespaceAll).List(ctx, opts)
+			},
+			delete: func(ctx context.Context, namespace, name string, opts metav1.DeleteOptions) error {
+				return c.kclient.CoreV1().ConfigMaps(namespace).Delete(ctx, name, opts)
+			},
+		},
+		{
+			kind: "Secret",
+			list: func(ctx context.Context, opts metav1.ListOptions) (runtime.Object, error) {
+				return c.kclient.CoreV1().Secrets(metav1.NamespaceAll).List(ctx, opts)
+			},
+			delete: func(ctx context.Context, namespace, name string, opts metav1.DeleteOptions) error {
+				return c.kclient.CoreV1().Secrets(namespace).Delete(ctx, name, opts)
+			},
+		},
+		{
+			kind: "Service",
+			list: func(ctx context.Context, opts metav1.ListOptions) (runtime.Object, error) {
+				return c.kclient.CoreV1().Services(metav1.NamespaceAll).List(ctx, opts)
+			},
+			delete: func(ctx context.Context, namespace, name string, opts metav1.DeleteOptions) error {
+				return c.kclient.CoreV1().Services(namespace).Delete(ctx, name, opts)
+			},
+		},
+		{
+			kind: "ServiceAccount",
+			list: func(ctx context.Context, opts metav1.ListOptions) (runtime.Object, error) {
+				return c.kclient.CoreV1().ServiceAccounts(metav1.NamespaceAll).List(ctx, opts)
+			},
+			delete: func(ctx context.Context, namespace, name string, opts metav1.DeleteOptions) error {
+				return c.kclient.CoreV1().ServiceAccounts(namespace).Delete(ctx, name, opts)
+			},
+		},
+		{
+			kind: "Deployment",
+			list: func(ctx context.Context, opts metav1.ListOptions) (runtime.Object, error) {
+				return c.kclient.AppsV1().Deployments(metav1.NamespaceAll).List(ctx, opts)
+			},
+			delete: func(ctx context.Context, namespace, name string, opts metav1.DeleteOptions) error {
+				return c.kclient.AppsV1().Deployments(namespace).Delete(ctx, name, opts)
+			},
+		},
+		{
+			kind: "DaemonSet",
+			list: func(ctx context.Context, opts metav1.ListOptions) (runtime.Object, error) {
+				return c.kclient.AppsV1().DaemonSets(metav1.NamespaceAll).List(ctx, opts)
+			},
+			delete: func(ctx context.Context, namespace, name string, opts metav1.DeleteOptions) error {
+				return c.kclient.AppsV1().DaemonSets(namespace).Delete(ctx, name, opts)
+			},
+		},
+		{
+			kind: "Role",
+			list: func(ctx context.Context, opts metav1.ListOptions) (runtime.Object, error) {
+				return c.kclient.RbacV1().Roles(metav1.NamespaceAll).List(ctx, opts)
+			},
+			delete: func(ctx context.Context, namespace, name string, opts metav1.DeleteOptions) error {
+				return c.kclient.RbacV1().Roles(namespace).Delete(ctx, name, opts)
+			},
+		},
+		{
+			kind: "RoleBinding",
+			list: func(ctx context.Context, opts metav1.ListOptions) (runtime.Object, error) {
+				return c.kclient.RbacV1().RoleBindings(metav1.NamespaceAll).List(ctx, opts)
+			},
+			delete: func(ctx context.Context, namespace, name string, opts metav1.DeleteOptions) error {
+				return c.kclient.RbacV1().RoleBindings(namespace).Delete(ctx, name, opts)
+			},
+		},
+		{
+			kind: "ClusterRole",
+			list: func(ctx context.Context, opts metav1.ListOptions) (runtime.Object, error) {
+				return c.kclient.RbacV1().ClusterRoles().List(ctx, opts)
+			},
+			delete: func(ctx context.Context, _, name string, opts metav1.DeleteOptions) error {
+				return c.kclient.RbacV1().ClusterRoles().Delete(ctx, name, opts)
+			},
+		},
+		{
+			kind: "ClusterRoleBinding",
+			list: func(ctx context.Context, opts metav1.ListOptions) (runtime.Object, error) {
+				return c.kclient.RbacV1().ClusterRoleBindings().List(ctx, opts)
+			},
+			delete: func(ctx context.Context, _, name string, opts metav1.DeleteOptions) error {
+				return c.kclient.RbacV1().ClusterRoleBindings().Delete(ctx, name, opts)
+			},
+		},
+		{
+			kind: "ValidatingWebhookConfiguration",
+			list: func(ctx context.Context, opts metav1.ListOptions) (runtime.Object, error) {
+				return c.kclient.AdmissionregistrationV1().ValidatingWebhookConfigurations().List(ctx, opts)
+			},
+			delete: func(ctx context.Context, _, name string, opts metav1.DeleteOptions) error {
+				return c.kclient.AdmissionregistrationV1().ValidatingWebhookConfigurations().Delete(ctx, name, opts)
+			},
+		},
+		{
+			kind: "APIService",
+			list: func(ctx context.Context, opts metav1.ListOptions) (runtime.Object, error) {
+				return c.aggclient.ApiregistrationV1beta1().APIServices().List(ctx, opts)
+			},
+			delete: func(ctx context.Context, _, name string, opts metav1.DeleteOptions) error {
+				return c.aggclient.ApiregistrationV1beta1().APIServices().Delete(ctx, name, opts)
+			},
+		},
+		{
+			kind: "SecurityContextConstraints",
+			list: func(ctx context.Context, opts metav1.ListOptions) (runtime.Object, error) {
+				return c.ossclient.SecurityV1().SecurityContextConstraints().List(ctx, opts)
+			},
+			delete: func(ctx context.Context, _, name string, opts metav1.DeleteOptions) error {
+				return c.ossclient.SecurityV1().SecurityContextConstraints().Delete(ctx, name, opts)
+			},
+		},
+		{
+			kind: "Route",
+			list: func(ctx context.Context, opts metav1.ListOptions) (runtime.Object, error) {
+				return c.osrclient.RouteV1().Routes(metav1.NamespaceAll).List(ctx, opts)
+			},
+			delete: func(ctx context.Context, namespace, name string, opts metav1.DeleteOptions) error {
+				return c.osrclient.RouteV1().Routes(namespace).Delete(ctx, name, opts)
+			},
+		},
+		{
+			kind: "Prometheus",
+			list: func(ctx context.Context, opts metav1.ListOptions) (runtime.Object, error) {
+				return c.mclient.MonitoringV1().Prometheuses(metav1.NamespaceAll).List(ctx, opts)
+			},
+			delete: func(ctx context.Context, namespace, name string, opts metav1.DeleteOptions) error {
+				return c.mclient.MonitoringV1().Prometheuses(namespace).Delete(ctx, name, opts)
+			},
+		},
+		{
+			kind: "Alertmanager",
+			list: func(ctx context.Context, opts metav1.ListOptions) (runtime.Object, error) {
+				return c.mclient.MonitoringV1().Alertmanagers(metav1.NamespaceAll).List(ctx, opts)
+			},
+			delete: func(ctx context.Context, namespace, name string, opts metav1.DeleteOptions) error {
+				return c.mclient.MonitoringV1().Alertmanagers(namespace).Delete(ctx, name, opts)
+			},
+		},
+		{
+			kind: "ThanosRuler",
+			list: func(ctx context.Context, opts metav1.ListOptions) (runtime.Object, error) {
+				return c.mclient.MonitoringV1().ThanosRulers(metav1.NamespaceAll).List(ctx, opts)
+			},
+			delete: func(ctx context.Context, namespace, name string, opts metav1.DeleteOptions) error {
+				return c.mclient.MonitoringV1().ThanosRulers(namespace).Delete(ctx, name, opts)
+			},
+		},
+		{
+			kind: "ServiceMonitor",
+			list: func(ctx context.Context, opts metav1.ListOptions) (runtime.Object, error) {
+				return c.mclient.MonitoringV1().ServiceMonitors(metav1.NamespaceAll).List(ctx, opts)
+			},
+			delete: func(ctx context.Context, namespace, name string, opts metav1.DeleteOptions) error {
+				return c.mclient.MonitoringV1().ServiceMonitors(namespace).Delete(ctx, name, opts)
+			},
+		},
+		{
+			kind: "PrometheusRule",
+			list: func(ctx context.Context, opts metav1.ListOptions) (runtime.Object, error) {
+				return c.mclient.MonitoringV1().PrometheusRules(metav1.NamespaceAll).List(ctx, opts)
+			},
+			delete: func(ctx context.Context, namespace, name string, opts metav1.DeleteOptions) error {
+				return c.mclient.MonitoringV1().PrometheusRules(namespace).Delete(ctx, name, opts)
+			},
+		},
+	}
+}
@@ -0,0 +1,87 @@
+// Copyright 2020 The Cluster Monitoring Operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"sync"
+	"testing"
+
+	"k8s.io/client-go/rest"
+
+	"github.com/openshift/cluster-monitoring-operator/pkg/manifests"
+)
+
+func TestPruneStaleObjects(t *testing.T) {
+	configMap := func(name, component string) string {
+		return fmt.Sprintf(`{"metadata": {"namespace": "foo", "name": %q, "labels": {%q: %q, %q: %q}}}`,
+			name, manifests.ManagedByLabel, manifests.ManagedByValue, manifests.ComponentLabel, component)
+	}
+
+	var (
+		mtx     sync.Mutex
+		deleted []string
+	)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		switch req.Method {
+		case http.MethodGet:
+			if sel := req.URL.Query().Get("labelSelector"); sel != manifests.ManagedByLabel+"="+manifests.ManagedByValue {
+				t.Errorf("unexpected label selector %q", sel)
+			}
+			if req.URL.Path == "/api/v1/configmaps" {
+				fmt.Fprintf(w, `{"items": [%s, %s, %s]}`,
+					configMap("applied", "grafana"), configMap("stale", "grafana"), configMap("unmanaged", "alertmanager"))
+				return
+			}
+			fmt.Fprint(w, `{"items": []}`)
+
+		case http.MethodDelete:
+			mtx.Lock()
+			deleted = append(deleted, req.URL.Path)
+			mtx.Unlock()
+			fmt.Fprint(w, `{"kind": "Status", "apiVersion": "v1", "status": "Success"}`)
+
+		default:
+			t.Errorf("unexpected request %s %s", req.Method, req.URL.Path)
+		}
+	}))
+	defer srv.Close()
+
+	c, err := New(&rest.Config{Host: srv.URL}, "", "openshift-monitoring", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	c.ResetInventory()
+	c.record("ConfigMap", "foo", "applied")
+	// Objects of other kinds don't keep objects of the same name.
+	c.record("Secret", "foo", "stale")
+
+	err = c.PruneStaleObjects(context.Background(), map[string]struct{}{"alertmanager": {}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := []string{"/api/v1/namespaces/foo/configmaps/stale"}
+	if !reflect.DeepEqual(deleted, expected) {
+		t.Fatalf("expected deletions %v, got %v", expected, deleted)
+	}
+}
@@ -17,7 +17,10 @@ package client
 import (
 	"context"
 	"fmt"
+	"sort"
+	gostrings "strings"
 
+	"github.com/openshift/cluster-monitoring-operator/pkg/manifests"
 	"github.com/openshift/cluster-monitoring-operator/pkg/strings"
 
 	v1 "github.com/openshift/api/config/v1"
@@ -26,11 +29,29 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
+// ComponentsNotManaged is the condition listing the components which the
+// operator doesn't reconcile as usual.
+const ComponentsNotManaged v1.ClusterStatusConditionType = "ComponentsNotManaged"
+
 type StatusReporter struct {
 	client              clientv1.ClusterOperatorInterface
 	clusterOperatorName string
 	namespace           string
 	version             string
+
+	// notManaged maps the components which aren't managed to their
+	// management state. It is nil if unknown.
+	notManaged map[string]string
+	// operandVersions maps the operands rolled out by the last sync to
+	// their version. Operands mapped to an empty version aren't deployed.
+	operandVersions map[string]string
+	// upgradeBlockers are the reasons for the cluster not to be upgraded.
+	upgradeBlockers []manifests.UpgradeBlocker
+	// upgradeAdvisories are the upgrade risks which don't block upgrades.
+	upgradeAdvisories []manifests.UpgradeBlocker
+
+	dampening FailureDampening
+	failures  *failureTracker
 }
 
 func NewStatusReporter(client clientv1.ClusterOperatorInterface, name, namespace, version string) *StatusReporter {
@@ -39,6 +60,7 @@ func NewStatusReporter(client clientv1.ClusterOperatorInterface, name, namespace
 		clusterOperatorName: name,
 		namespace:           namespace,
 		version:             version,
+		failures:            &failureTracker{},
 	}
 }
 
@@ -54,40 +76,153 @@ func newRelatedObjects(namespace string) []v1.ObjectReference {
 	}
 }
 
-func (r *StatusReporter) SetDone() error {
-	co, err := r.client.Get(context.TODO(), r.clusterOperatorName, metav1.GetOptions{})
+// SetManagementStates sets the management state of the components which
+// aren't managed, keyed by component. They are reported in the
+// ComponentsNotManaged condition with every subsequent status update.
+func (r *StatusReporter) SetManagementStates(notManaged map[string]string) {
+	r.notManaged = notManaged
+	if r.notManaged == nil {
+		r.notManaged = map[string]string{}
+	}
+}
+
+// SetOperandVersions sets the versions of the operands which were fully
+// rolled out, keyed by operand. They are reported along with the versions of
+// the other operands as of earlier updates by SetDone and SetFailed.
+// Operands mapped to an empty version are no longer reported.
+func (r *StatusReporter) SetOperandVersions(versions map[string]string) {
+	r.operandVersions = versions
+}
+
+// versions returns the versions to report given the current ones. The
+// operator version is replaced if operatorVersion is set.
+func (r *StatusReporter) versions(current []v1.OperandVersion, operatorVersion string) []v1.OperandVersion {
+	versions := map[string]string{}
+	for _, v := range current {
+		versions[v.Name] = v.Version
+	}
+	for name, version := range r.operandVersions {
+		versions[name] = version
+	}
+	if operatorVersion != "" {
+		versions["operator"] = operatorVersion
+	}
+
+	var res []v1.OperandVersion
+	for name, version := range versions {
+		if version == "" {
+			continue
+		}
+		res = append(res, v1.OperandVersion{Name: name, Version: version})
+	}
+	// The operator version comes first, followed by the operands by name.
+	sort.Slice(res, func(i, j int) bool {
+		if res[i].Name == "operator" || res[j].Name == "operator" {
+			return res[i].Name == "operator"
+		}
+		return res[i].Name < res[j].Name
+	})
+
+	return res
+}
+
+// SetUpgradeBlockers sets the reasons for the cluster not to be upgraded.
+// While there are any, every subsequent status update sets the Upgradeable
+// condition to false.
+func (r *StatusReporter) SetUpgradeBlockers(blockers []manifests.UpgradeBlocker) {
+	r.upgradeBlockers = blockers
+}
+
+// SetUpgradeAdvisories sets the upgrade risks which don't block upgrades.
+// Every subsequent status update reports them in the message of the
+// Upgradeable condition while it is true.
+func (r *StatusReporter) SetUpgradeAdvisories(advisories []manifests.UpgradeBlocker) {
+	r.upgradeAdvisories = advisories
+}
+
+// setUpgradeableCondition sets the Upgradeable condition to false if there
+// are upgrade blockers, otherwise to true with message and reason followed
+// by the upgrade advisories.
+func (r *StatusReporter) setUpgradeableCondition(conditions *conditions, message, reason string, time metav1.Time) {
+	switch len(r.upgradeBlockers) {
+	case 0:
+		messages := []string{}
+		if message != "" {
+			messages = append(messages, message)
+		}
+		for _, a := range r.upgradeAdvisories {
+			messages = append(messages, a.Message)
+		}
+		if reason == "" && len(r.upgradeAdvisories) > 0 {
+			reason = r.upgradeAdvisories[0].Reason
+			if len(r.upgradeAdvisories) > 1 {
+				reason = "MultipleReasons"
+			}
+		}
+		conditions.setCondition(v1.OperatorUpgradeable, v1.ConditionTrue, gostrings.Join(messages, " "), reason, time)
+	case 1:
+		conditions.setCondition(v1.OperatorUpgradeable, v1.ConditionFalse, r.upgradeBlockers[0].Message, r.upgradeBlockers[0].Reason, time)
+	default:
+		messages := make([]string, 0, len(r.upgradeBlockers))
+		for _, b := range r.upgradeBlockers {
+			messages = append(messages, b.Message)
+		}
+		conditions.setCondition(v1.OperatorUpgradeable, v1.ConditionFalse, gostrings.Join(messages, " "), "MultipleReasons", time)
+	}
+}
+
+func (r *StatusReporter) setNotManagedCondition(conditions *conditions, time metav1.Time) {
+	if r.notManaged == nil {
+		return
+	}
+	if len(r.notManaged) == 0 {
+		conditions.setCondition(ComponentsNotManaged, v1.ConditionFalse, "", "", time)
+		return
+	}
+
+	components := make([]string, 0, len(r.notManaged))
+	for c, state := range r.notManaged {
+		components = append(components, fmt.Sprintf("%s (%s)", c, state))
+	}
+	sort.Strings(components)
+	conditions.setCondition(ComponentsNotManaged, v1.ConditionTrue,
+		fmt.Sprintf("The following components are not managed by the operator: %s.", gostrings.Join(components, ", ")),
+		"ManagementStateOverridden",
+		time,
+	)
+}
+
+func (r *StatusReporter) SetDone(ctx context.Context) error {
+	co, err := r.client.Get(ctx, r.clusterOperatorName, metav1.GetOptions{})
 	if apierrors.IsNotFound(err) {
 		co = r.newClusterOperator()
-		co, err = r.client.Create(context.TODO(), co, metav1.CreateOptions{})
+		co, err = r.client.Create(ctx, co, metav1.CreateOptions{})
 	}
 	if err != nil && !apierrors.IsNotFound(err) {
 		return err
 	}
 
 	time := metav1.Now()
+	r.failures.reset()
 
 	conditions := newConditions(co.Status, r.version, time)
 	conditions.setCondition(v1.OperatorAvailable, v1.ConditionTrue, "Successfully rolled out the stack.", "RollOutDone", time)
 	conditions.setCondition(v1.OperatorProgressing, v1.ConditionFalse, "", "", time)
 	conditions.setCondition(v1.OperatorDegraded, v1.ConditionFalse, "", "", time)
-	conditions.setCondition(v1.OperatorUpgradeable, v1.ConditionTrue, "", "", time)
+	r.setUpgradeableCondition(conditions, "", "", time)
+	r.setNotManagedCondition(conditions, time)
 	co.Status.Conditions = conditions.entries()
 
 	// If we have reached "level" for the operator, report that we are at the version
 	// injected into us during update. We require that all components be rolled out
 	// and available at the new version before reporting this value.
 	if len(r.version) > 0 {
-		co.Status.Versions = []v1.OperandVersion{
-			{
-				Name:    "operator",
-				Version: r.version,
-			},
-		}
+		co.Status.Versions = r.versions(co.Status.Versions, r.version)
 	} else {
 		co.Status.Versions = nil
 	}
 
-	_, err = r.client.UpdateStatus(context.TODO(), co, metav1.UpdateOptions{})
+	_, err = r.client.UpdateStatus(ctx, co, metav1.UpdateOptions{})
 	return err
 }
 
@@ -98,11 +233,11 @@ func (r *StatusReporter) SetDone() error {
 // This will ensure that the progressing state will be only set initially or in case of failure.
 // Once controller operator versions are available, an additional check will be introduced that toggles
 // the OperatorProgressing state in case of version upgrades.
-func (r *StatusReporter) SetInProgress() error {
-	co, err := r.client.Get(context.TODO(), r.clusterOperatorName, metav1.GetOptions{})
+func (r *StatusReporter) SetInProgress(ctx context.Context) error {
+	co, err := r.client.Get(ctx, r.clusterOperatorName, metav1.GetOptions{})
 	if apierrors.IsNotFound(err) {
 		co = r.newClusterOperator()
-		co, err = r.client.Create(context.TODO(), co, metav1.CreateOptions{})
+		co, err = r.client.Create(ctx, co, metav1.CreateOptions{})
 	}
 	if err != nil && !apierrors.IsNotFound(err) {
 		return err
@@ -112,27 +247,78 @@ func (r *StatusReporter) SetInProgress() error {
 	reasonInProgress := "RollOutInProgress"
 	conditions := newConditions(co.Status, r.version, time)
 	conditions.setCondition(v1.OperatorProgressing, v1.ConditionTrue, "Rolling out the stack.", reasonInProgress, time)
-	conditions.setCondition(v1.OperatorUpgradeable, v1.ConditionTrue,
+	r.setUpgradeableCondition(conditions,
 		"Rollout of the monitoring stack is in progress. Please wait until it finishes.",
 		reasonInProgress,
 		time,
 	)
+	r.setNotManagedCondition(conditions, time)
 	co.Status.Conditions = conditions.entries()
 	co.Status.RelatedObjects = newRelatedObjects(r.namespace)
 
-	_, err = r.client.UpdateStatus(context.TODO(), co, metav1.UpdateOptions{})
+	_, err = r.client.UpdateStatus(ctx, co, metav1.UpdateOptions{})
 	return err
 }
 
-func (r *StatusReporter) Get() (*v1.ClusterOperator, error) {
-	return r.client.Get(context.TODO(), r.clusterOperatorName, metav1.GetOptions{})
+func (r *StatusReporter) Get(ctx context.Context) (*v1.ClusterOperator, error) {
+	return r.client.Get(ctx, r.clusterOperatorName, metav1.GetOptions{})
+}
+
+// FailedComponents lists the components which failed to roll out by
+// criticality.
+type FailedComponents struct {
+	// Core are the failed components without which the monitoring stack
+	// isn't available.
+	Core []string
+	// Optional are the failed components which only degrade the
+	// monitoring stack.
+	Optional []string
+	// CoreSkipped is true if core components weren't rolled out because a
+	// component they depend on failed.
+	CoreSkipped bool
+}
+
+// SetFailed reports a failure making the monitoring stack unavailable.
+func (r *StatusReporter) SetFailed(ctx context.Context, statusErr error, reason string) error {
+	return r.setFailed(ctx, fmt.Sprintf("Failed to rollout the stack. Error: %v", statusErr), reason, func(conditions *conditions, reason string, time metav1.Time) {
+		conditions.setCondition(v1.OperatorAvailable, v1.ConditionFalse, "", "", time)
+	})
 }
 
-func (r *StatusReporter) SetFailed(statusErr error, reason string) error {
-	co, err := r.client.Get(context.TODO(), r.clusterOperatorName, metav1.GetOptions{})
+// SetComponentsFailed reports a rollout in which components failed. The
+// monitoring stack is unavailable if core components failed, otherwise it
+// is only degraded. If core components were skipped, the Available
+// condition is left as it was.
+func (r *StatusReporter) SetComponentsFailed(ctx context.Context, statusErr error, reason string, failed FailedComponents) error {
+	components := append(append([]string(nil), failed.Core...), failed.Optional...)
+	message := fmt.Sprintf("Failed to rollout the stack. Error: %v", statusErr)
+	if len(components) > 0 {
+		message = fmt.Sprintf("Failed to rollout %s. Error: %v", gostrings.Join(components, ", "), statusErr)
+	}
+
+	return r.setFailed(ctx, message, reason, func(conditions *conditions, reason string, time metav1.Time) {
+		switch {
+		case len(failed.Core) > 0:
+			conditions.setCondition(v1.OperatorAvailable, v1.ConditionFalse,
+				fmt.Sprintf("The following core components are unavailable: %s.", gostrings.Join(failed.Core, ", ")),
+				reason,
+				time,
+			)
+		case !failed.CoreSkipped:
+			conditions.setCondition(v1.OperatorAvailable, v1.ConditionTrue, "Successfully rolled out the core components.", "CoreRollOutDone", time)
+		}
+	})
+}
+
+// setFailed sets the Degraded condition to true with message and reason
+// and the Available condition with setAvailable. While the failure is
+// dampened, both conditions keep their status and the Degraded message
+// tells about the pending failure.
+func (r *StatusReporter) setFailed(ctx context.Context, message, reason string, setAvailable func(*conditions, string, metav1.Time)) error {
+	co, err := r.client.Get(ctx, r.clusterOperatorName, metav1.GetOptions{})
 	if apierrors.IsNotFound(err) {
 		co = r.newClusterOperator()
-		co, err = r.client.Create(context.TODO(), co, metav1.CreateOptions{})
+		co, err = r.client.Create(ctx, co, metav1.CreateOptions{})
 	}
 	if err != nil && !apierrors.IsNotFound(err) {
 		return err
@@ -141,19 +327,37 @@ func (r *StatusReporter) SetFailed(statusErr error, reason string) error {
 	time := metav1.Now()
 	// The Reason should be upper case camelCase (PascalCase) according to the API docs.
 	reason = strings.ToPascalCase(reason)
+	count, since := r.failures.fail(time)
 
 	conditions := newConditions(co.Status, r.version, time)
-	conditions.setCondition(v1.OperatorAvailable, v1.ConditionFalse, "", "", time)
+	if degraded := conditions.entryMap[v1.OperatorDegraded]; degraded.Status != v1.ConditionTrue && r.dampening.dampened(reason, count, since, time) {
+		conditions.setCondition(v1.OperatorDegraded, degraded.Status,
+			fmt.Sprintf("%s Degraded isn't reported until the failure persists. %s", failureNote(count, since), message),
+			reason,
+			time,
+		)
+	} else {
+		if count > 1 {
+			message = fmt.Sprintf("%s %s", message, failureNote(count, since))
+		}
+		setAvailable(conditions, reason, time)
+		conditions.setCondition(v1.OperatorDegraded, v1.ConditionTrue, message, reason, time)
+	}
 	conditions.setCondition(v1.OperatorProgressing, v1.ConditionFalse, "", "", time)
-	conditions.setCondition(v1.OperatorDegraded, v1.ConditionTrue, fmt.Sprintf("Failed to rollout the stack. Error: %v", statusErr), reason, time)
-	conditions.setCondition(v1.OperatorUpgradeable, v1.ConditionTrue,
+	r.setUpgradeableCondition(conditions,
 		"Rollout of the monitoring stack failed and is degraded. Please investigate the degraded status error.",
 		reason,
 		time,
 	)
+	r.setNotManagedCondition(conditions, time)
 	co.Status.Conditions = conditions.entries()
+	// Operands rolled out before the failure are at their new version, the
+	// operator isn't yet.
+	if len(r.version) > 0 {
+		co.Status.Versions = r.versions(co.Status.Versions, "")
+	}
 
-	_, err = r.client.UpdateStatus(context.TODO(), co, metav1.UpdateOptions{})
+	_, err = r.client.UpdateStatus(ctx, co, metav1.UpdateOptions{})
 	return err
 }
 
@@ -20,10 +20,14 @@ import (
 	"fmt"
 	"reflect"
 	"sort"
+	"strings"
 	"testing"
+	"time"
 
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 
+	"github.com/openshift/cluster-monitoring-operator/pkg/manifests"
+
 	v1 "github.com/openshift/api/config/v1"
 	clientv1 "github.com/openshift/client-go/config/clientset/versioned/typed/config/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -93,6 +97,88 @@ func TestStatusReporterSetDone(t *testing.T) {
 				),
 			},
 		},
+		{
+			name: "components not managed",
+
+			given: givenStatusReporter{
+				operatorName: "foo",
+				namespace:    "bar",
+				version:      "1.0",
+				notManaged:   map[string]string{"grafana": "Unmanaged"},
+			},
+
+			when: []whenFunc{
+				getReturnsClusterOperator(&v1.ClusterOperator{}),
+				updateStatusReturnsError(nil),
+			},
+
+			check: []checkFunc{
+				hasUpdatedStatusConditions(
+					"Available", "True",
+					"ComponentsNotManaged", "True",
+					"Degraded", "False",
+					"Progressing", "False",
+					"Upgradeable", "True",
+				),
+			},
+		},
+		{
+			name: "all components managed",
+
+			given: givenStatusReporter{
+				operatorName: "foo",
+				namespace:    "bar",
+				version:      "1.0",
+				notManaged:   map[string]string{},
+			},
+
+			when: []whenFunc{
+				getReturnsClusterOperator(&v1.ClusterOperator{}),
+				updateStatusReturnsError(nil),
+			},
+
+			check: []checkFunc{
+				hasUpdatedStatusConditions(
+					"Available", "True",
+					"ComponentsNotManaged", "False",
+					"Degraded", "False",
+					"Progressing", "False",
+					"Upgradeable", "True",
+				),
+			},
+		},
+		{
+			name: "operand versions",
+
+			given: givenStatusReporter{
+				operatorName: "foo",
+				namespace:    "bar",
+				version:      "1.0",
+				operandVersions: map[string]string{
+					"prometheus": "v2.20.0",
+					"thanos":     "0.12.0",
+					"grafana":    "",
+				},
+			},
+
+			when: []whenFunc{
+				getReturnsClusterOperator(&v1.ClusterOperator{
+					Status: v1.ClusterOperatorStatus{
+						Versions: []v1.OperandVersion{
+							{Name: "operator", Version: "0.9"},
+							{Name: "grafana", Version: "7.0.3"},
+							{Name: "alertmanager", Version: "v0.21.0"},
+						},
+					},
+				}),
+				updateStatusReturnsError(nil),
+			},
+
+			check: []checkFunc{
+				// operator, alertmanager, prometheus and thanos.
+				hasUpdatedStatusVersions("1.0", "v0.21.0", "v2.20.0", "0.12.0"),
+			},
+		},
 	} {
 		t.Run(tc.name, func(t *testing.T) {
 			mock := &clusterOperatorMock{}
@@ -103,12 +189,16 @@ func TestStatusReporterSetDone(t *testing.T) {
 				tc.given.namespace,
 				tc.given.version,
 			)
+			if tc.given.notManaged != nil {
+				sr.SetManagementStates(tc.given.notManaged)
+			}
+			sr.SetOperandVersions(tc.given.operandVersions)
 
 			for _, w := range tc.when {
 				w(mock)
 			}
 
-			got := sr.SetDone()
+			got := sr.SetDone(context.Background())
 
 			for _, check := range tc.check {
 				if err := check(mock, got); err != nil {
@@ -196,7 +286,7 @@ func TestStatusReporterSetInProgress(t *testing.T) {
 				w(mock)
 			}
 
-			got := sr.SetInProgress()
+			got := sr.SetInProgress(context.Background())
 
 			for _, check := range tc.check {
 				if err := check(mock, got); err != nil {
@@ -272,6 +362,33 @@ func TestStatusReporterSetFailed(t *testing.T) {
 				),
 			},
 		},
+		{
+			name: "operand versions",
+
+			given: givenStatusReporter{
+				operatorName:    "foo",
+				namespace:       "bar",
+				version:         "1.0",
+				operandVersions: map[string]string{"prometheus": "v2.20.0"},
+			},
+
+			when: []whenFunc{
+				getReturnsClusterOperator(&v1.ClusterOperator{
+					Status: v1.ClusterOperatorStatus{
+						Versions: []v1.OperandVersion{
+							{Name: "prometheus", Version: "v2.19.0"},
+							{Name: "operator", Version: "0.9"},
+						},
+					},
+				}),
+				updateStatusReturnsError(nil),
+			},
+
+			check: []checkFunc{
+				// The operator keeps its version until the rollout succeeds.
+				hasUpdatedStatusVersions("0.9", "v2.20.0"),
+			},
+		},
 	} {
 		t.Run(tc.name, func(t *testing.T) {
 			mock := &clusterOperatorMock{}
@@ -282,12 +399,13 @@ func TestStatusReporterSetFailed(t *testing.T) {
 				tc.given.namespace,
 				tc.given.version,
 			)
+			sr.SetOperandVersions(tc.given.operandVersions)
 
 			for _, w := range tc.when {
 				w(mock)
 			}
 
-			got := sr.SetFailed(tc.given.err, "")
+			got := sr.SetFailed(context.Background(), tc.given.err, "")
 
 			for _, check := range tc.check {
 				if err := check(mock, got); err != nil {
@@ -298,9 +416,317 @@ func TestStatusReporterSetFailed(t *testing.T) {
 	}
 }
 
+func TestStatusReporterSetComponentsFailed(t *testing.T) {
+	failedErr := errors.New("foo")
+	available := &v1.ClusterOperator{
+		Status: v1.ClusterOperatorStatus{
+			Conditions: []v1.ClusterOperatorStatusCondition{
+				{Type: v1.OperatorAvailable, Status: v1.ConditionTrue},
+			},
+		},
+	}
+
+	for _, tc := range []struct {
+		name   string
+		co     *v1.ClusterOperator
+		failed FailedComponents
+		check  []checkFunc
+	}{
+		{
+			name:   "core component failed",
+			co:     available,
+			failed: FailedComponents{Core: []string{"Alertmanager"}, Optional: []string{"Grafana"}},
+			check: []checkFunc{
+				hasUpdatedStatusConditions(
+					"Available", "False",
+					"Degraded", "True",
+					"Progressing", "False",
+					"Upgradeable", "True",
+				),
+				hasUpdatedStatusConditionMessage("Available", "The following core components are unavailable: Alertmanager."),
+				hasUpdatedStatusConditionMessage("Degraded", "Failed to rollout Alertmanager, Grafana. Error: foo"),
+			},
+		},
+		{
+			name:   "optional component failed",
+			co:     &v1.ClusterOperator{},
+			failed: FailedComponents{Optional: []string{"Grafana"}},
+			check: []checkFunc{
+				hasUpdatedStatusConditions(
+					"Available", "True",
+					"Degraded", "True",
+					"Progressing", "False",
+					"Upgradeable", "True",
+				),
+				hasUpdatedStatusConditionMessage("Degraded", "Failed to rollout Grafana. Error: foo"),
+			},
+		},
+		{
+			name:   "core component skipped",
+			co:     available,
+			failed: FailedComponents{Optional: []string{"Grafana"}, CoreSkipped: true},
+			check: []checkFunc{
+				hasUpdatedStatusConditions(
+					"Available", "True",
+					"Degraded", "True",
+					"Progressing", "False",
+					"Upgradeable", "True",
+				),
+			},
+		},
+		{
+			name:   "core component skipped on first rollout",
+			co:     &v1.ClusterOperator{},
+			failed: FailedComponents{Optional: []string{"Grafana"}, CoreSkipped: true},
+			check: []checkFunc{
+				hasUpdatedStatusConditions(
+					"Available", "Unknown",
+					"Degraded", "True",
+					"Progressing", "False",
+					"Upgradeable", "True",
+				),
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			mock := &clusterOperatorMock{}
+			sr := NewStatusReporter(mock, "foo", "bar", "1.0")
+
+			getReturnsClusterOperator(tc.co.DeepCopy())(mock)
+			updateStatusReturnsError(nil)(mock)
+
+			got := sr.SetComponentsFailed(context.Background(), failedErr, "", tc.failed)
+
+			for _, check := range tc.check {
+				if err := check(mock, got); err != nil {
+					t.Error(err)
+				}
+			}
+		})
+	}
+}
+
+func TestStatusReporterUpgradeBlockers(t *testing.T) {
+	storage := manifests.UpgradeBlocker{Reason: "PrometheusDataPersistenceNotConfigured", Message: "Configure storage."}
+	deprecated := manifests.UpgradeBlocker{Reason: "DeprecatedConfigurationFields", Message: "Remove deprecated fields."}
+
+	for _, tc := range []struct {
+		name       string
+		blockers   []manifests.UpgradeBlocker
+		advisories []manifests.UpgradeBlocker
+		update     func(*StatusReporter) error
+		check      []checkFunc
+	}{
+		{
+			name:     "done with one blocker",
+			blockers: []manifests.UpgradeBlocker{storage},
+			update: func(sr *StatusReporter) error {
+				return sr.SetDone(context.Background())
+			},
+			check: []checkFunc{
+				hasUpdatedStatusConditions(
+					"Available", "True",
+					"Degraded", "False",
+					"Progressing", "False",
+					"Upgradeable", "False",
+				),
+				hasUpdatedStatusConditionMessage("Upgradeable", "Configure storage."),
+			},
+		},
+		{
+			name:     "in progress with several blockers",
+			blockers: []manifests.UpgradeBlocker{storage, deprecated},
+			update: func(sr *StatusReporter) error {
+				return sr.SetInProgress(context.Background())
+			},
+			check: []checkFunc{
+				hasUpdatedStatusConditions(
+					"Available", "Unknown",
+					"Degraded", "Unknown",
+					"Progressing", "True",
+					"Upgradeable", "False",
+				),
+				hasUpdatedStatusConditionMessage("Upgradeable", "Configure storage. Remove deprecated fields."),
+			},
+		},
+		{
+			name:     "failed with one blocker",
+			blockers: []manifests.UpgradeBlocker{deprecated},
+			update: func(sr *StatusReporter) error {
+				return sr.SetFailed(context.Background(), errors.New("foo"), "")
+			},
+			check: []checkFunc{
+				hasUpdatedStatusConditions(
+					"Available", "False",
+					"Degraded", "True",
+					"Progressing", "False",
+					"Upgradeable", "False",
+				),
+				hasUpdatedStatusConditionMessage("Upgradeable", "Remove deprecated fields."),
+			},
+		},
+		{
+			name:       "done with one advisory",
+			advisories: []manifests.UpgradeBlocker{storage},
+			update: func(sr *StatusReporter) error {
+				return sr.SetDone(context.Background())
+			},
+			check: []checkFunc{
+				hasUpdatedStatusConditions(
+					"Available", "True",
+					"Degraded", "False",
+					"Progressing", "False",
+					"Upgradeable", "True",
+				),
+				hasUpdatedStatusConditionMessage("Upgradeable", "Configure storage."),
+			},
+		},
+		{
+			name:       "in progress with a blocker and an advisory",
+			blockers:   []manifests.UpgradeBlocker{deprecated},
+			advisories: []manifests.UpgradeBlocker{storage},
+			update: func(sr *StatusReporter) error {
+				return sr.SetInProgress(context.Background())
+			},
+			check: []checkFunc{
+				hasUpdatedStatusConditions(
+					"Available", "Unknown",
+					"Degraded", "Unknown",
+					"Progressing", "True",
+					"Upgradeable", "False",
+				),
+				hasUpdatedStatusConditionMessage("Upgradeable", "Remove deprecated fields."),
+			},
+		},
+		{
+			name: "lifted blockers",
+			update: func(sr *StatusReporter) error {
+				return sr.SetDone(context.Background())
+			},
+			check: []checkFunc{
+				hasUpdatedStatusConditions(
+					"Available", "True",
+					"Degraded", "False",
+					"Progressing", "False",
+					"Upgradeable", "True",
+				),
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			mock := &clusterOperatorMock{}
+			sr := NewStatusReporter(mock, "foo", "bar", "1.0")
+			sr.SetUpgradeBlockers(tc.blockers)
+			sr.SetUpgradeAdvisories(tc.advisories)
+
+			getReturnsClusterOperator(&v1.ClusterOperator{})(mock)
+			updateStatusReturnsError(nil)(mock)
+
+			got := tc.update(sr)
+
+			for _, check := range tc.check {
+				if err := check(mock, got); err != nil {
+					t.Error(err)
+				}
+			}
+		})
+	}
+}
+
+func TestStatusReporterFailureDampening(t *testing.T) {
+	failedErr := errors.New("foo")
+
+	for _, tc := range []struct {
+		name      string
+		dampening FailureDampening
+		reason    string
+		// degraded is the expected Degraded status after each failure.
+		degraded []string
+	}{
+		{
+			name:     "no dampening",
+			degraded: []string{"True", "True"},
+		},
+		{
+			name:      "threshold",
+			dampening: FailureDampening{Threshold: 3},
+			degraded:  []string{"False", "False", "True"},
+		},
+		{
+			name:      "grace period",
+			dampening: FailureDampening{Threshold: 2, GracePeriod: time.Hour},
+			degraded:  []string{"False", "False", "False"},
+		},
+		{
+			name:      "invalid configuration",
+			dampening: FailureDampening{Threshold: 2, GracePeriod: time.Hour},
+			reason:    InvalidConfigurationReason,
+			degraded:  []string{"True", "True"},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			mock := &clusterOperatorMock{}
+			sr := NewStatusReporter(mock, "foo", "bar", "1.0")
+			sr.dampening = tc.dampening
+			updateStatusReturnsError(nil)(mock)
+
+			co := &v1.ClusterOperator{}
+			getReturnsClusterOperator(co)(mock)
+			if err := sr.SetDone(context.Background()); err != nil {
+				t.Fatal(err)
+			}
+
+			var transitionTime metav1.Time
+			for i, want := range tc.degraded {
+				getReturnsClusterOperator(mock.statusUpdated.DeepCopy())(mock)
+				if err := sr.SetFailed(context.Background(), failedErr, tc.reason); err != nil {
+					t.Fatal(err)
+				}
+
+				available, degraded := "True", want
+				if want == "True" {
+					available = "False"
+				}
+				if err := hasUpdatedStatusConditions(
+					"Available", available,
+					"Degraded", degraded,
+					"Progressing", "False",
+					"Upgradeable", "True",
+				)(mock, nil); err != nil {
+					t.Fatalf("failure %d: %v", i+1, err)
+				}
+
+				for _, c := range mock.statusUpdated.Status.Conditions {
+					if c.Type != v1.OperatorDegraded {
+						continue
+					}
+					if !strings.Contains(c.Message, "Error: foo") {
+						t.Errorf("failure %d: expected the Degraded message to contain the error, got %q", i+1, c.Message)
+					}
+					if i > 0 && tc.degraded[i-1] == want && !c.LastTransitionTime.Equal(&transitionTime) {
+						t.Errorf("failure %d: expected the Degraded transition time to be kept", i+1)
+					}
+					transitionTime = c.LastTransitionTime
+				}
+			}
+
+			// A successful sync resets the consecutive failures.
+			getReturnsClusterOperator(mock.statusUpdated.DeepCopy())(mock)
+			if err := sr.SetDone(context.Background()); err != nil {
+				t.Fatal(err)
+			}
+			if sr.failures.count != 0 {
+				t.Fatalf("expected no consecutive failures, got %d", sr.failures.count)
+			}
+		})
+	}
+}
+
 type givenStatusReporter struct {
 	operatorName, namespace, version string
 	err                              error
+	notManaged                       map[string]string
+	operandVersions                  map[string]string
 }
 
 type checkFunc func(*clusterOperatorMock, error) error
@@ -351,6 +777,20 @@ func hasUpdatedStatusConditions(want ...string) checkFunc {
 	}
 }
 
+func hasUpdatedStatusConditionMessage(conditionType, want string) checkFunc {
+	return func(mock *clusterOperatorMock, _ error) error {
+		for _, c := range mock.statusUpdated.Status.Conditions {
+			if string(c.Type) == conditionType {
+				if c.Message != want {
+					return fmt.Errorf("want %s message %q, got %q", conditionType, want, c.Message)
+				}
+				return nil
+			}
+		}
+		return fmt.Errorf("condition %s not found", conditionType)
+	}
+}
+
 type whenFunc func(*clusterOperatorMock)
 
 func getReturnsClusterOperator(co *v1.ClusterOperator) whenFunc {
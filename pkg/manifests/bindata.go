@@ -1767,7 +1767,7 @@ func assetsPrometheusK8sRouteYaml() (*asset, error) {
 	return a, nil
 }
 
-var _assetsPrometheusK8sRulesYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\xbd\xfd\x73\xdb\x36\xd2\x38\xfe\x7b\xfe\x0a\x54\xcf\x73\x53\x2b\x95\x65\xd9\x89\xf3\xbd\x7a\xea\xce\xa4\x4e\x72\xed\x5d\x5e\xfc\x24\xce\xd3\xef\x33\xed\x0d\x0f\x22\x21\x11\x35\x09\xf0\x00\xd0\x8e\x2e\x76\xfe\xf6\xcf\xe0\x85\x24\xc0\x37\x51\xaf\x96\x72\x97\xce\xb8\x96\x4c\x2e\x16\xbb\x8b\xc5\xee\x62\xb1\x0b\x13\xfc\xbf\x88\x71\x4c\xc9\x19\x88\x29\xc1\x82\x32\x4c\xa6\x43\x9f\x32\x44\xf9\xd0\xa7\xf1\xd1\xcd\xf1\xa3\x6b\x4c\x82\x33\x70\xc9\x68\x8c\x44\x88\x52\xfe\x3e\x8d\xd0\xa3\x18\x09\x18\x40\x01\xcf\x1e\x01\x10\xc1\x31\x8a\xb8\xfc\x0d\x80\x24\x7f\xec\x0c\x5c\xff\x99\xab\xef\x18\x8d\xd0\x19\x80\x11\x62\xe2\x90\xa5\x11\x92\xdf\x12\x18\xa3\x33\xeb\xe9\xc3\xeb\x3f\x73\xe7\x8f\x3c\x81\x3e\x3a\x03\x34\x41\x84\x87\x78\x22\x0e\x0b\xfc\x1e\xf1\x04\xf9\x72\xb8\x29\xa3\x69\xa2\x06\x3e\x34\x00\x09\x0d\xd0\x21\xfa\x94\x50\x26\x10\x1b\x66\xf0\x00\x50\xbf\x69\x0c\x0f\x01\xfa\x94\xb0\x33\x70\xa7\x3e\xc9\x7f\x3e\x4d\x89\x00\xb7\x58\x84\x34\x15\xe0\xc0\x4f\xd2\x3e\x38\xc8\xff\x5a\xf9\x7b\x4c\x03\xe4\x3e\x00\xd4\xb8\x9e\x9f\xa4\x1e\x47\x3e\x25\x01\xf7\x04\x15\x30\xfa\xfc\x07\x1d\x9f\xf7\x1c\x9c\x7a\xf7\xd6\x7b\xfd\x47\xe5\xdf\x18\xf2\x29\x0b\xce\x00\x26\x5c\x40\xe2\xa3\x33\x05\x98\xa4\xb1\x04\x7e\xc6\xd3\xb8\x61\x0a\xc7\xe0\x10\xc0\x9b\xa9\x33\x89\x01\xa8\x62\xca\xa0\x40\x07\xdd\x91\xd5\x20\xce\x7b\x38\x88\x50\xef\xfe\xb7\xe3\xf8\xef\x5d\x51\x96\xe0\x53\x81\x23\xcc\xa1\x90\xe2\x25\x07\x3e\x6e\xc2\xde\xc6\x50\xbd\x1d\x51\x18\x1c\xb7\x53\xef\xc8\x7a\xa7\x91\x5a\xed\x20\xda\x67\xa0\x70\xf0\x12\xc4\x14\x30\x26\xa7\xd1\x42\xfc\xca\x14\x62\x14\x53\x36\xf3\xde\xa0\xf8\xf9\x0d\xc4\x11\x1c\x47\xc8\x1b\xcf\x04\xe2\xdd\xa7\x55\x82\x73\x25\xb9\xd4\x05\x46\xfb\xbc\x0c\xc0\x12\x73\x1a\x27\x57\x48\xcc\x4d\xcc\x05\x14\x5e\x32\x8d\xe1\x1f\x13\x98\x46\xa2\x16\x0b\x5b\x48\xea\x11\xa8\xc0\x69\x17\x8e\x02\x81\x00\xf3\x6b\x0f\x53\x4f\xe0\x18\x75\x91\xdd\x00\xdd\x60\x1f\x9d\x7f\xe9\x91\x9b\x18\x0d\xbf\xbb\x63\xe3\x60\xf8\xdd\x1d\x97\x3f\x6e\xe4\x8f\x4f\xea\x67\x10\x1f\xca\x9f\x50\x7e\xdf\x8e\xbe\xa7\x01\x9e\x35\x22\xb3\xd4\x44\x6e\x11\x9e\x86\x02\x05\xbb\x35\xa3\x32\x56\xed\x53\xe3\x69\x5c\xa8\x1e\x0d\xb3\x49\xef\x10\x24\x6e\x29\xbb\xf6\x18\xf2\x11\xbe\x31\x6b\x62\xfe\x9c\xbf\x39\xef\x45\x74\x31\x15\x54\x3f\x12\xfa\xe4\x47\x69\x80\xc9\xd4\x8b\xe8\xda\x27\x25\x18\x24\x3c\xc6\x62\x0b\xb3\x2a\x0d\xb5\xd1\x69\x65\x14\x0c\x18\x4d\xb6\xc2\x2a\x35\xd0\x76\x38\xb5\xf1\x39\xb9\x23\xd5\x4f\x2a\x33\x5f\xae\xd3\x31\x3a\x84\x09\xe6\x88\xdd\x74\xb7\x5f\xec\x99\xba\x66\xc9\x7f\x01\x41\x29\xe0\x11\xbd\x75\xbe\xe6\x69\x7c\xa0\x48\x92\x0f\xe5\x31\xf4\xcf\x14\x71\xe1\x05\xa9\xda\x0c\x48\xae\x8d\x94\xf1\xa3\x49\x93\x3f\xdd\x1b\xdc\x20\x36\x3e\xff\xd2\x7b\xfd\xcb\x87\xab\xbb\xbf\xbc\xbc\x92\x94\x09\xfe\xde\xef\x3b\xa3\x1c\x3a\x9f\x5c\xc4\xaa\x9f\x17\xc3\x6a\x9c\xfa\xd7\x68\x3e\x5a\x03\xee\xd3\x44\xea\x4b\x86\x38\x4d\x99\x8f\xee\x7a\x83\x08\x9d\xf7\x46\xc3\xe3\x5a\x94\xe5\x3f\xca\x2a\x5f\xdd\x20\x5f\x50\x76\x30\x2a\x3f\x5c\xfe\xfc\xdd\xa3\x6d\xcd\xa8\x97\x9b\xc8\xd9\x84\x4e\x1b\x26\xb4\x45\x9c\xfc\x28\xe5\x6a\xd1\x48\x8c\xea\xf1\xe9\xd7\x5a\xbf\x2e\x96\xff\x05\x10\x63\x94\xf1\x47\x9d\x90\xb6\x96\x6e\x1b\x8a\xbe\xb4\x63\xbf\xf4\x4e\x87\xc3\x0a\x5e\xfd\x1a\xe3\x6b\xe5\x01\xdd\x41\x6c\x0f\x49\x0b\x14\x1b\x9f\x01\x86\x60\x50\x52\x21\x95\xe1\xce\xc6\x29\x23\x4a\x4f\x04\x3b\xbd\xfa\xc3\xfd\x5b\xfd\x15\x94\xc1\x9e\xaf\xfe\x9a\x09\x3d\xe8\xea\xaf\xe0\xb3\x23\xab\x3f\xdc\xc6\xea\x0f\xd7\xbb\xfa\xc3\x5d\x5e\xfd\x27\xfb\xb7\xfa\xab\x28\x83\xfd\x5e\xfd\x75\x13\x7a\xc8\xd5\x5f\xc5\x67\x37\x56\xff\xc9\x36\x56\xff\xc9\x7a\x57\xff\xc9\x4e\xaf\xfe\x27\xa3\x78\xef\x96\x7f\x0d\xce\x60\xbf\xd7\x7f\xed\x8c\x1e\x52\x01\xd4\x20\xb4\x1b\x1a\xa0\x84\xd8\x86\x54\x80\x33\xca\x1a\x74\xc0\x93\x51\x97\xe8\xf9\xc3\x29\x81\xfd\x73\xff\xab\x28\x83\x3d\x57\x01\x3b\xe6\xfe\x57\xf1\xd9\x11\x05\xb0\x0d\xf7\xff\xc9\x7a\xdd\xff\x27\x3b\xed\xfe\x9f\xee\x9f\x05\x50\x45\x19\xec\xf7\xea\xaf\x9b\xd0\x43\xae\xfe\x2a\x3e\xbb\xb1\xfa\x4f\xb7\xb1\xfb\x9f\xae\x77\xf3\x3f\xdd\xe9\xbd\xff\xd9\xfe\xb9\xff\x55\x94\xc1\x7e\xaf\xfe\xba\x09\x3d\xe4\xea\xaf\xe2\xb3\x1b\xab\xff\xd9\x36\xdc\xff\x67\xeb\x75\xff\x9f\xed\x9e\xfb\x7f\xf9\xee\xc3\xd5\xdd\xe5\xc7\xab\xbb\xcb\xe7\x57\x17\x3f\xdf\xbd\x78\xf9\xfa\xe5\xd5\xcb\x0e\xa7\x80\xeb\x13\xc1\x7a\x0c\x94\xfc\xd5\x9d\xed\x35\x49\xdc\xb2\xdc\x6e\x18\x7e\x4b\xc7\x4c\x5d\xc8\x5f\x2f\x78\xb7\x0c\x0b\xd4\x59\xf2\x76\xf0\xd0\xa9\x71\xea\x73\x36\xa1\xed\x49\x5e\xb8\x03\x92\xb7\x31\x2d\xd7\x85\xfc\x6b\x91\xbc\xfd\xd1\x79\xf3\x4e\x3f\xb6\x26\x79\x65\x44\x1e\x42\xf2\x36\x17\x5e\xef\x42\xfe\x75\x48\xde\x0e\x06\xdb\x9b\xa6\x3e\x37\xf2\xbe\x35\xd1\xab\x60\xf2\x10\xb2\xb7\xc1\xc0\x6e\x27\x0e\xac\x43\xfa\x76\x31\xcc\xdb\x38\xf9\x5d\x31\xf6\xca\x88\x3c\x88\xf0\x6d\xdd\xd8\x9b\x1f\x61\x5c\x50\xf4\xf6\xc7\xd8\x9b\x17\x6f\xdc\x9a\xe4\x95\x11\x79\x08\xc9\xdb\x5c\x40\xab\x0b\xf9\xd7\x21\x79\x3b\x18\xde\x6a\x9a\xfa\xbc\x58\xd7\xd6\x24\xaf\x8c\xc8\x43\x48\xde\xe6\x82\x29\x5d\xc8\xbf\x0e\xc9\x6b\x0c\xad\xf0\x34\x06\xe3\x19\x38\x90\xf3\x1d\x64\x21\xc4\x3e\x78\x90\xb8\xb0\xc4\xc1\xcb\x70\x38\x6b\x18\xfc\xac\x75\x21\xad\x73\x3a\xeb\xd5\x0a\x6b\x98\x5c\x88\xb9\xa0\x53\x06\x63\xef\x9f\x29\x24\x02\x47\xe8\x60\x34\xfc\xfe\xfb\x41\x3e\xeb\x08\x0d\xc0\xfc\x49\x2f\x1b\x03\x35\x33\xef\x83\x1f\xc1\xa8\x61\xfa\x19\x5e\x67\xa0\x27\x31\xeb\x75\xe0\xb9\x0e\xa8\xe6\x33\xaa\xa1\x4c\x19\xdf\xb3\x2a\x1d\x76\x8a\x60\xad\x72\xb3\x02\xf5\x6a\xa5\x6a\x93\xe4\x5b\x44\xc7\xf3\x34\xfe\xcc\xd3\x71\x46\x4c\x75\x8f\x64\xaa\x49\xf2\x8d\x91\xa1\x5f\x15\x39\xd4\x4f\xf5\x59\x13\xe6\xe2\xdd\xeb\xd7\x2f\x2f\xae\x7e\x79\xf7\xf6\xee\xf2\xfd\xbb\xff\xff\xff\xee\x2e\xde\xbd\x7d\xfb\xf2\x22\x17\xb7\xec\xc2\xcb\x41\x76\xeb\x64\x00\x12\x1a\x2c\xa8\x83\x1b\xf6\xc4\x07\x40\xb8\xc4\xb8\x2e\xfc\x8a\x11\x24\x4b\xe9\x84\x55\xe5\x79\x9b\xe4\x69\x52\xa8\x0d\x6b\xe2\x01\xd5\xc7\x57\x4a\xda\x87\xa7\xec\xe9\xd7\x49\xd9\xd3\x8d\x51\xf6\x10\x60\x22\x10\xbb\x81\xd1\x19\x78\xa2\x15\x44\xdd\xf5\xba\x43\xa8\x2f\x66\xe3\x08\x8b\x59\xd7\xbb\x76\xe5\xbb\xde\x65\xaf\x44\x6d\x46\xcd\xbe\x09\x26\x3e\x43\x90\x2f\xa0\x8b\xe7\x06\xa0\x3a\x04\x61\x16\x19\xd5\x88\x4f\xd7\xb0\x5f\x39\xf2\xe2\xf8\x1e\x65\xe2\x48\x3b\x67\x03\xb4\x71\xb2\x2e\xd7\x92\x87\xb8\x3c\xc1\x3a\xe7\x1f\x6f\x23\xf9\x70\x7d\xd3\x68\xca\x39\xee\x98\x71\xb8\x46\x44\x6a\xf2\x8c\xdb\xd3\x0c\xbb\x24\x18\x48\x27\xa4\xd1\xf7\xc8\x90\x7f\x32\x0a\x3e\xdb\xee\x2f\xa0\xac\xe0\xce\x7c\x37\xb8\xeb\x18\xed\x2e\x14\x8c\xa2\xb9\xce\xad\xad\xd7\x9e\x8c\x9a\xa2\x7a\x65\x4d\xb6\xdc\x12\xec\x92\x08\x5d\xe2\xcf\xe1\xa2\x41\x9d\xf2\x1a\x5d\x52\xa2\x56\xbd\x31\x50\x23\xeb\x95\xf5\x5a\xbf\x5a\xdd\x4f\xdf\xad\x43\x3f\xaf\x78\x57\xa0\x32\x97\xad\x22\x35\x7f\x0d\x2f\x98\x20\xd4\x79\xfd\x2a\x8c\x7a\x72\x1f\x72\x43\x59\x1b\x5a\xcb\xf6\x78\xf7\x6b\xc8\x08\x5a\x6e\x65\x77\x8e\x9c\x7e\xf5\xd6\xc9\x46\xa5\x4a\x99\x7e\xdb\x14\x2b\x3d\xe0\x1c\xb9\xea\x16\x0f\xed\x26\x58\x4e\x14\x51\xc1\xef\x83\x36\xf6\xb5\x84\x11\x95\x52\xc8\x89\x75\x62\xce\x6f\x2d\x7e\x39\xf1\x41\x35\x95\x0e\x84\xd9\x02\xde\x76\x4a\xe1\x1e\xa1\x2d\x97\xca\x5e\xe2\xfd\x71\x3f\xd1\x96\x0a\x69\x1f\x11\x2f\x9d\xf3\xec\x11\xe6\x8e\x42\x79\xb2\x3f\x78\xdb\x0a\x65\x8f\xd0\x76\x14\xca\x3e\xe1\xfd\x71\x3f\xd1\x76\x14\xca\x1e\x21\x5e\x52\x28\x7b\x84\xb9\xa3\x50\x9e\xee\x0f\xde\xb6\x42\xd9\x23\xb4\x1d\x85\xb2\x4f\x78\x7f\xdc\x4f\xb4\x1d\x85\xb2\x47\x88\x97\x14\xca\x1e\x61\xee\x28\x94\xd3\xfd\xc1\xbb\xae\x84\xc2\x1e\xa0\xed\x28\x94\x7d\xc2\xfb\xe3\x7e\xa2\xed\x28\x94\x3d\x42\xbc\x21\x97\x7c\x0b\x98\xf7\xf5\xff\x3a\x83\xac\x1e\x78\x2d\x95\x4b\xf6\xc0\xd8\x37\x04\x0b\x97\x4b\x1d\xeb\x38\x97\xbc\xc0\xea\x9f\x79\xd7\x93\xde\xfc\xcc\xdd\xa7\x44\x40\x4c\x74\x21\x70\x2f\xe5\x70\x5a\x5b\x7d\xfa\x3a\x1d\xa3\x08\x89\xde\x00\xc4\x48\x30\xec\x73\x2f\x81\x22\x3c\xef\x1d\x99\x8f\x47\x3e\x0c\x6e\x30\xa7\xac\x37\x00\x38\x86\x53\xf4\xcd\x79\xaf\x37\x00\x39\xf4\x6f\xa4\xc6\x7a\x91\x9f\xac\x4b\x2a\xe7\xc1\xfb\xb2\x20\x16\x05\xf2\xe7\x22\x77\xc6\xd3\xd8\x93\xf3\x98\xc7\x51\x1d\x93\x1f\x14\xb0\xd5\x59\xbe\x85\x60\x4d\x49\xdd\x87\x20\x4d\x11\x45\x05\x8f\x01\x25\x8d\x98\xf7\x75\x7b\x00\x2f\x42\x13\xa1\x6a\xff\xf6\x81\xa0\xc9\x75\xdb\x64\xdd\x19\x1e\x0f\x40\x0c\x3f\x81\xf1\xac\x99\x36\x1a\xec\x81\x9c\x9f\x97\xd0\xc0\xc3\x64\x42\x3f\xcb\x2f\xe5\x04\xee\xab\x01\xdf\x9c\x7f\xaa\x40\x70\x06\x4c\xbd\x9a\x4f\x76\x75\x96\x16\x00\x4c\xad\xf7\x5b\xca\xae\x31\x99\x7a\x1c\x09\xbb\x7e\xfc\x52\x6c\x29\xca\xcc\x6b\xea\x77\x25\x7a\xe5\xc1\x83\xe3\x81\x45\x6c\x43\xe9\x2d\x13\xb8\x89\x3e\x5d\x09\xcb\xf8\x7f\x48\x59\xa1\x48\x57\xe2\xf9\xd0\x0f\xd1\x7f\xc8\x57\xa5\x49\x57\x02\xf2\x5b\x98\xfc\x87\x7e\x15\x92\xb4\xec\xe7\x95\xe7\xb5\x76\x5d\x55\x27\xd6\x6c\x55\x4b\x6c\xe0\x55\x94\x5a\x1a\xbe\x64\xdb\x76\x31\x42\xe9\x98\xb7\xf2\x40\xcd\x0e\x67\x33\xac\xfc\x64\xe3\xc6\x5f\xfc\xcb\x79\x59\xcc\x21\x4b\x9d\xc8\x8c\x31\x9e\xcd\xaa\x44\xe2\x43\x2e\xa0\x40\x87\x86\xa8\x4e\x53\x1c\x23\x06\x4a\x28\xdb\x64\xb2\xdf\x80\xf8\x5c\x6c\xe5\xd0\x29\xf7\x92\x10\x72\xf4\x59\xfd\x94\x46\x29\x22\x01\x26\xd3\xbb\xf7\x29\x21\x98\x4c\x7b\xf7\xe0\xfc\x1c\x1c\x57\xd1\x7a\x54\xff\xa9\x99\xbf\x0b\x13\xe9\x6b\x60\xba\x34\x5c\x7c\xca\x1e\x94\xe3\xbb\xcc\xef\x9c\x3e\x2d\xcc\xce\xe6\x5a\x67\x7c\x4a\x83\x25\xa2\x30\xa8\x99\xbe\x72\x9f\x3c\x86\x92\x08\xfa\xc8\xa5\x4b\xcb\x9f\x2c\x72\xd1\x5b\x82\x58\x23\xdf\x06\x40\xfd\xdd\xbb\xc6\x24\x38\xef\xbd\x47\x49\x84\x7d\xf8\x01\x89\xde\xfd\xa0\x04\xb0\xc7\xf4\x1f\xb9\xd2\xa6\xbd\xff\x3e\x96\x3f\xf5\xcb\x72\x26\xf2\xd3\xc1\xf0\x71\xbf\xf7\xa8\x46\x08\x8a\x57\xad\x69\x3b\xb2\x50\x00\x2a\xf6\xa5\x86\xb7\xca\x33\xcd\xed\x7a\x50\xff\x46\x36\x45\x0d\xbc\x2a\x5a\x8a\x52\xc5\x9b\x73\x08\x56\x16\xf4\x92\x44\xb9\x54\xeb\x65\x8c\xed\x4a\xb1\xaa\x44\x96\xdd\xe7\x0c\xa2\x27\x66\x09\x3a\x03\x01\x4a\x22\x3a\x8b\x11\x11\x4d\x12\xec\xe5\x6f\x24\x34\x38\x73\xc5\xe2\x8c\x21\x05\x7f\x6b\x22\xbb\x94\x54\xbe\x80\x28\xa6\xa4\x46\x28\xb7\x40\x5e\x35\x34\x47\x5f\x31\x75\x3f\xc8\xbf\x4d\xd2\xe8\x41\xe8\xcb\xcd\xe0\xab\x53\xd8\x69\xb9\xc3\xfd\x10\x05\x69\xd4\xbd\xe5\xce\xfc\xeb\x45\x39\x48\x0f\x9d\x20\xcf\x7c\x92\x4e\x66\x6b\xf2\x64\xfe\xd6\x96\xae\x05\x75\xc6\x72\x3d\x97\x0b\xcb\xa4\xb1\x06\x84\xd1\x94\x32\x2c\xc2\x78\x57\x09\xd4\x09\xd7\xcd\x90\x69\x8c\x95\xb1\xb2\xab\x94\x69\x42\x6f\x8d\x37\xca\x76\x61\x35\xed\xce\x62\xda\xc9\xb5\xb4\x6b\x4b\x69\x57\x56\xd2\xc3\x2f\xa4\xd3\xdd\x5a\x48\xf3\x2f\xfe\x6d\x6b\x21\xd5\x13\xe6\x81\x17\xd2\x22\xe4\xd9\xc6\x42\xaa\x27\xd2\xf6\x17\xd2\x22\x74\x59\x6c\x21\xd9\xcd\xa3\x17\x39\x1d\x8c\x31\x69\x8c\x67\x96\x2c\x75\x1a\xa0\xca\x69\xf2\xb7\x67\xce\xcb\x9e\xee\x9b\x4c\x53\x22\xce\xbe\x6d\x18\x75\x91\x08\x2c\x50\x51\xdb\xf6\x23\xae\xb2\x77\xe0\xce\xa6\xd1\x1f\xc8\xa7\x39\x00\xbd\x84\x5a\xd6\xbe\xf9\xa0\xcc\x7c\xcb\xb6\xaf\xcc\xbc\x1a\xd4\x75\x69\xd1\x48\x00\xdd\x8a\xbb\x4a\x5b\x70\x90\x87\xbe\xd4\xa4\x2b\x3d\xbc\x97\x69\xd0\xdd\x2d\x2c\x5e\x1e\xa4\x6d\x5a\xcd\x24\x91\xaf\x76\x6d\xf6\x2d\x65\xaf\x3c\xea\x62\xed\xa6\xdd\x8b\x6b\x35\xad\xcc\x0d\xb8\x9f\xd2\xc9\x04\x31\xde\x06\xe9\xbb\xc6\x97\x2f\xa0\x5c\x8f\xcb\xbd\xfb\x06\xc5\xaf\x18\x6a\x9d\x42\xf3\xcb\x1f\x22\x38\xee\xd2\x27\xdb\xf1\x3f\x6d\xa1\x2a\x33\xe7\xac\x9d\xc4\x86\x55\xb6\x47\x19\x21\xb1\x3e\x57\xd2\x00\xf4\x92\x08\x4d\x3d\x86\x22\xdc\x7c\x33\xca\x4a\x1e\x28\x54\x6c\x84\x4c\x58\x2d\xfb\xaa\xe6\x6c\x27\x1b\x23\x17\xe1\x6e\xc7\x21\xcd\x69\x1b\x73\xdc\x09\x35\x4e\xfe\x4c\x97\x19\xae\xd1\x1e\xfc\x0a\x08\xfa\xe0\xf4\x3c\xfd\xaa\xe8\x59\x31\x2e\xd6\x45\x4f\x27\xcc\x94\x30\x1a\x23\x11\xa2\x94\x1f\x2a\x9d\xa4\x07\xc3\x64\xda\xae\x2b\x72\x3a\x37\x6c\x62\xb1\xde\x8e\x71\x10\xa1\xde\x20\xfb\x40\x6f\x21\x16\xbd\xfb\xdf\x9e\x28\x7a\xff\xf4\x7f\xf9\xbc\x0b\x22\x97\xa6\xec\xb6\xaf\x96\x5b\x90\x1c\xb4\xb2\x0f\xb9\xd8\xb4\xb4\x73\xcf\x87\xee\x3a\x64\x2d\xac\x45\x90\xa8\x6b\xbf\xbe\x34\x16\x2e\xb0\x2e\x68\x2c\xc8\x19\xbd\x12\x7e\xfd\xe5\xea\xe7\x77\x1f\xaf\x0a\xf6\xf8\x49\x3a\x00\xb1\x92\xf9\x23\xf0\xee\xad\xb5\x26\xfe\xf2\xfe\xdd\xc7\x4b\xef\xf5\xcb\x57\x57\x07\x7d\x6d\x09\x49\xab\xa7\x61\xec\x62\x6b\xb3\xa7\xae\x2d\xa3\x45\xc8\x61\xe4\x00\xd3\x35\x4f\xbc\xde\x98\x2f\xc6\xcc\x72\x9c\x4a\x15\x9e\xca\xcf\x95\x32\xa3\xf4\x1b\xe0\x68\x35\xf2\xcc\xc3\x2d\xa3\x47\xd3\xe2\x9e\x22\x82\x18\x8c\xda\x57\xb5\x36\x65\x6b\x1d\xa2\xdc\xa8\x4d\x13\x75\x2c\x59\xcd\x43\x95\x4e\x42\x9a\x1c\x2f\x09\x70\xd4\x04\x70\x54\x9a\x14\x23\x48\xa0\x39\x19\x93\x73\xf3\x2a\xf2\x3f\x9e\xf7\x7a\x83\x84\x06\xc6\x41\x92\x74\xd7\xa8\x35\x25\x49\x48\xfb\x79\xa1\xf4\x08\x17\x15\x9e\x20\x5f\x33\x3e\x84\x6c\x7d\x78\x94\xc0\x36\xeb\x84\xf9\xc9\x91\xb5\x18\x95\xea\xb9\x2e\x8a\x5e\x3e\xd6\x1c\xea\x4c\xb8\xc3\xa4\xe5\xe9\xe1\x02\x9a\x33\x6a\x9b\x78\x58\xe3\xaf\x3b\x6f\xa6\xa3\x60\x2c\x8b\xc1\x26\x44\xc2\x24\x12\x0d\x5c\xe4\x6a\x84\x63\xb9\x24\xe1\x15\xf8\x54\x5d\x3e\x99\xdb\x54\x2a\x67\x10\x43\x3f\xc4\x04\x39\xd9\x2d\xee\x0b\x0d\x8a\xd6\x1e\xbd\x61\xf3\x59\x78\x99\x1f\x81\xe3\xd1\x68\x04\x8e\x1c\xcc\xf2\x3c\x8c\x26\x4c\x9a\x79\xdc\xb2\x27\xae\x67\xe1\x2f\x8d\x69\xc1\xe3\x2a\x8e\x31\xfc\x94\xef\x10\x88\x04\x09\xc5\x44\x0c\x40\xb1\x57\xfc\x41\xc7\x66\xc3\xe0\x88\xdd\x60\x3f\x4f\xd9\x53\x7b\x9f\x36\xa1\x01\x24\x41\x8e\x25\x25\x96\x7d\xae\x9f\x62\x34\x42\x9f\xe5\x8f\xf3\x5e\x0c\x55\x9d\x97\x46\x1b\x5c\x47\x9f\xf2\xb7\xbc\x62\xcf\xf1\x30\x95\xd8\xca\xd7\xbb\x3d\xed\xe9\x87\xcf\x40\x4f\xb0\x14\x35\xc4\x0a\xcf\xf4\x43\x0a\x06\x7f\x48\xc2\x60\x32\x61\x70\x49\xba\x78\xea\xe5\x39\x13\x55\xcf\xac\x69\x9e\x8d\xc4\x93\xff\x20\x09\x8a\xb9\xd6\x8c\xbe\xbe\x29\xae\x53\x00\x9a\xc8\xd3\xfc\x18\xa0\x4c\x87\x03\x9d\x89\xda\x24\x71\x9e\x28\x22\x96\x55\x92\xb8\xa0\x3a\x73\xa4\xd0\xad\x8d\x12\xd8\x66\xb9\x72\x45\x33\x77\xba\x75\x12\x5c\x70\x53\x99\xcf\x6e\x80\x38\x37\xa6\x31\x99\xd0\x01\x50\xa1\xb5\x5e\x81\x99\x09\x03\x67\xc8\x17\xb1\xe0\xbe\x15\x9b\x4d\xa0\x7f\x0d\xa7\xd2\xd6\xa6\x0c\xf5\xa5\x3d\x7a\xd2\x41\x48\xc2\x59\x82\x98\x08\x19\x82\x81\x87\x08\x1c\x47\x28\x98\xc3\xea\x9a\x37\xea\x38\x9e\x3b\x0a\x37\x98\x49\x0f\x1f\x8a\x09\x65\x71\x39\x44\x21\x66\x09\x1a\x00\x3e\xe3\x02\xc5\x5e\x0c\x49\x3a\x81\xbe\x48\x19\x62\xf9\x97\x09\xa3\x41\xea\x0b\x15\x9b\x28\x28\x32\x86\x1c\x8d\x29\x64\x41\xe9\xa5\xe2\x7b\xfb\x3d\x43\xa7\xe6\xc1\x0a\x19\xa8\x19\x74\xd1\xc1\x1a\xe8\xe6\xd0\x41\x53\x6c\x6e\x4e\xaa\x11\x93\x31\x12\xb0\xb2\x92\x35\x09\x3d\x3d\xa9\xd6\x25\xdc\x6f\x29\x5b\xd5\xaa\x84\x80\x89\xd6\x1b\xc1\xad\xc9\x19\xad\xc4\xea\xab\x65\xe5\x8a\xc5\x60\x32\x46\x7d\x98\x40\x1f\x8b\x59\xb1\xf9\x3a\x6f\x34\x55\x96\x52\xab\x3b\x5b\x42\xce\x1b\x8b\x2b\xdf\x8e\x08\xeb\x75\xe3\x62\xb7\x2b\x04\x69\x34\x58\x2a\xc0\x5a\xa4\xcc\x8f\x60\x9c\x78\x31\xfc\xd4\x2c\x20\xae\xa2\x02\xf2\x3f\x29\x9b\xae\xca\x2a\x2f\xeb\x92\x2e\xea\x03\xf0\x23\x38\x06\xfd\x01\xe8\xb5\xab\x1e\xa9\xd9\x94\xee\xa9\xd3\x76\xa5\x1c\x4f\xa3\xed\x2b\x83\xf6\x37\x80\xfe\x0f\xe7\x9d\xd1\x9f\xc0\x88\x77\xc0\xbf\x3f\xb0\xd2\xa2\x1b\x79\x69\x58\x68\x8f\x85\xc9\xb4\x81\x99\xf9\x29\xa6\x5e\x22\xc7\x83\xba\x7d\x2a\xbb\xdd\x57\x16\x53\x2d\xca\x0c\x4f\x43\x71\x00\xd6\xa2\x75\x9c\x3f\xd3\x04\x11\x1e\xe2\x89\x90\x50\x28\xf7\x70\x90\xfd\xd9\x1d\x00\x32\x3f\x2c\x67\x3c\x97\x78\xd9\x6e\xa7\xcc\x41\x4a\x9b\x1e\xfd\x47\x0d\xe2\x31\x87\xf0\xf9\xce\xdc\xb8\x23\xcf\x67\x65\x59\xb1\xb8\x1b\x66\xdb\xe3\x5a\x4a\x3b\xf2\xa6\xe3\x5e\xdf\x5d\x8b\xd4\x5a\x0a\x5b\xda\xbf\x1e\xd5\xa9\xa6\xdd\xd9\xc7\x6c\x47\x7c\xd1\xad\xac\x71\x42\x5b\xdd\xd4\x76\x94\x52\x73\xa5\xb3\xf5\x82\x8f\x34\x3e\x8f\xc1\x21\x98\x17\x50\x37\xf1\xf4\xfb\xdf\x4e\xe2\xbf\x57\x6e\xcb\xe4\xb8\xd4\xdf\xdd\x9b\x9b\x9a\xf0\x39\xa1\xc1\xf9\x17\xf7\xac\x7c\xf8\x5d\xe1\xa2\xd6\xe9\x09\x1d\xda\xa8\xdf\xbb\xf3\xd0\x7b\x71\x6c\x7e\x25\x67\xd2\x76\xa4\x7f\xb8\x4c\x2e\x43\x97\x20\x52\x0b\xe1\x3b\x46\x6c\x72\xd2\x7d\xf3\xa5\x97\xef\x11\x87\xc3\xef\xf2\xf0\xcd\xc0\x8e\xe9\xd4\x9f\x71\x64\x89\xe9\x73\x69\xd7\x42\x63\x70\x38\x1f\x4c\x31\x62\x8e\x69\x27\x76\x75\xb8\x37\xbe\x20\x19\x1a\x29\xd0\x81\x39\xed\x7c\xb4\xe9\xd0\x02\xac\x8e\x14\x5d\xe3\xc5\x8d\x2e\x7e\x25\xb0\x63\x9c\x67\x1d\xdb\x5c\x61\xdf\x6b\xde\x51\x6a\x8f\xa2\x1c\x80\x26\x73\xac\x66\x26\x40\x6d\xc7\x09\xa3\x37\x98\x63\xaa\xaf\xf6\xe9\xb5\x8f\x18\xc7\x5c\x20\x22\x6e\x68\x94\xc6\xc8\x8f\x20\x8e\x6b\x6e\xab\x71\x41\x59\x4e\xab\x47\xb6\xf6\xb5\xb3\xa3\x6a\xa1\xf5\x1d\x7b\xad\x7d\x60\xa9\x86\x4a\xd0\xcd\xc8\x7e\x04\x39\xef\x03\x5b\xab\x39\xd3\x51\x40\xed\x67\xb5\xb1\xdc\x44\xbc\xe5\x27\x7f\x66\x0d\xbb\x08\xa9\x23\x24\x3c\x3d\x8c\xda\x69\xb8\x97\xf2\x2c\x33\xaa\x23\x1d\x73\xc2\x2c\x46\xcf\x85\xe8\xf8\xc8\xd9\x17\x17\xa3\x67\xcb\x0c\xe7\x11\xed\x00\x09\x3f\xf0\xe8\xf8\x0f\xe4\x0b\x2d\xc5\xbc\xe3\x01\x75\xf5\xc5\x0a\x7c\xe9\x32\x56\x1f\xfb\x9c\xf1\xf9\xfc\x4b\x6f\x9c\xe2\x28\xe0\xbf\xff\x3e\x54\xbf\xfc\xfe\xfb\x30\xd7\x14\xbf\xff\x3e\xc4\xf4\xae\xb8\x31\xe7\x53\x32\xc1\x53\xf9\x28\x4c\x12\x5e\x7d\x52\xdd\x07\x97\xdf\xab\x5f\xaa\x7f\xb7\x2e\x2f\x65\x40\x2c\xe8\xf9\x57\x3e\xa3\xe4\x0f\x3a\x56\x28\x41\xe1\x87\x77\xce\x87\x84\x06\xfc\xae\x96\xe1\xd5\xaf\xf9\x9d\x31\xb9\xf8\x5d\x2e\x5f\xfc\x8e\xd1\x54\x28\x34\xd5\x2f\x35\xd3\x20\x53\x86\x38\x57\x8f\x98\x64\x08\x4c\xa6\xbf\xff\x3e\xbc\xfe\x33\xd7\x4f\x84\x94\xe1\x7f\x49\x3d\x1f\x25\x34\x80\xa9\xa0\xdc\x87\x11\x62\x6a\x06\xe6\x13\x26\xd3\xbb\x94\xeb\xef\xe4\xff\xab\xc3\x98\x93\x0d\x43\x0c\xf3\xa9\xfa\x58\xc6\xa9\x7f\xa6\x54\x40\x6e\x57\x0a\xd0\x17\x38\x4d\x93\xb2\x06\xd1\x34\xe7\x20\xe6\xa9\x9a\x4d\x46\x3b\x33\xea\xe4\xbc\xc8\x7e\x65\x88\x0b\xc8\x84\x97\xd0\x08\xfb\xb3\xcf\x52\xbd\x7e\x73\xde\x7b\x1e\xdd\xc2\x19\xef\x0d\x1a\xf7\xc0\x12\x6e\xd6\x92\xb6\x72\x9c\xeb\x31\x94\x5c\x3d\x13\x88\xc5\x98\xc0\xe8\x2c\xdf\xd9\xea\x56\x8b\x94\xe8\x6c\xc5\xe5\x9b\x2d\x97\x3a\xc0\x5c\x3b\x4e\x89\xf0\x78\x5a\x8a\x60\xce\x19\xbf\x00\xd4\x44\xa2\x8e\xfe\x5e\x8d\x97\x3c\xc7\xb3\xab\xdd\x09\x6b\xdc\xf0\x4e\x4e\xf5\x02\x3e\xb5\x93\xe9\xa5\x1e\xa6\xdc\xbb\x91\x2b\x88\x12\xcf\x88\xa3\x9e\x6e\xee\x62\xd6\x1b\x6b\x8a\x3e\x92\x2b\xf3\x69\x54\x0a\x80\xaf\xd7\x45\x9e\x1b\x5c\xd5\x38\x6c\x79\xd4\x2e\x7e\x3b\xa7\xfe\x35\x12\xdd\x3c\x77\xb3\x56\x57\xa0\x77\x5b\x20\x67\x81\xb9\x6e\x41\x44\xb3\xb4\xe4\xa5\x97\xd6\xce\x2f\x29\xc3\xf9\x1a\xe3\x3f\x8b\xf7\x4a\x95\x27\x37\x17\x11\x43\x02\xa7\xca\x10\x17\x78\x82\x7d\x95\x7b\x99\x25\x73\x0d\xaa\x29\x52\x59\x1f\x41\xfb\x55\xb9\xe1\x49\x7f\x26\x93\xad\x18\x7e\x32\xa3\xaa\xc7\xce\xc0\x85\x7e\xeb\x0d\x25\x58\x50\x86\xc9\xf4\x5d\x82\x18\x14\x94\xbd\x97\xce\xa0\x8f\x23\xac\x86\x7d\x69\x77\x8b\x80\x84\x50\xa1\xb1\x29\x0e\xae\x62\xc4\x95\x5e\xcd\x20\x82\x02\x24\xc8\x60\x02\xcc\xe5\x6c\x11\xc3\x88\xf8\xf2\x0f\xcc\x19\x44\xf7\xa4\xb0\xc2\x0d\xd2\x53\x05\x74\x02\x3e\x7f\x06\x09\xc3\x44\x4c\x40\xef\x4f\xa3\xe1\x68\xd2\x03\xff\x7d\x03\xa3\x14\x81\xfb\xfb\x3f\x0d\xcd\xf3\x9a\x8a\xda\xb7\x35\xd7\x64\xe2\x1c\x03\x29\x0a\x0a\x03\x2f\x1b\x11\x79\xba\x01\x86\x49\x99\x3c\x3e\xd5\x01\x86\xe3\xd1\xc8\x4a\xe9\x58\x00\x1a\x14\x02\xc5\x89\x70\xe1\xfd\x08\x8e\xb3\x0e\x9f\x13\xca\xce\x40\xd6\xea\xbe\x7a\xec\xc7\xd1\x0d\x62\x58\xcc\xce\xc0\x2d\x64\xa4\x08\x24\x1b\x2e\x3d\xb7\x78\xfa\x5e\xe7\x8a\x32\xfe\x96\x8a\x0b\x65\x9f\xa5\x0c\x05\x9d\x58\xa3\xc0\x70\x00\x19\x02\x84\x0a\xe0\xe7\x6f\x03\x41\xc1\x18\x01\x8e\x88\x90\xbf\x42\x60\x8b\x9c\x39\x79\x1b\x80\x18\xc1\x1c\x35\xfd\x4f\x84\x50\x80\x19\x4d\x41\x0c\x67\x0a\xe4\x18\x99\x57\x51\x00\x30\x01\x10\x08\x1c\xa3\x68\x06\x26\x90\x87\x12\xd4\x6d\x88\x08\xc0\x71\x42\x99\x80\x44\x80\x09\xc4\x51\xea\x1e\xaa\x50\xdf\x4f\xd9\x10\x5c\x84\xc8\xbf\x06\x22\x44\x52\x78\xc8\x07\xb9\x8a\x41\x40\xfd\x54\xda\x8d\x1a\x2b\x41\x41\x84\x20\x23\x20\xa4\xb7\xf2\x43\x3e\x1b\x07\x79\x1b\xf4\x2d\x16\xa1\x43\x4a\x57\x76\xba\x2e\x20\x95\x4f\x68\xb3\xf5\x78\x59\xb6\xbe\x49\x23\x81\x93\x08\x5d\xe4\xb6\xc8\xbb\x77\x6f\xfe\x86\xa3\xa8\x23\x3f\xb3\xf7\x8b\x1a\x36\x1c\xdc\x22\x86\x00\x4d\x85\x5c\x37\xda\xef\x07\xd7\x0a\xa2\x9a\x3e\x26\x8a\xa6\x09\xe4\x02\x1c\x9f\x5a\xb4\x89\x31\x91\x36\xb2\x4b\x11\x63\x7c\x95\xee\x5f\xe5\x83\x65\xd7\xab\xf2\x2a\xa4\x35\x95\x60\x4c\x98\xd1\xd8\x97\xf9\xf2\x38\x71\x12\x7f\x20\x09\x6a\x8a\x68\x55\xc6\x69\x06\x1f\x41\x2e\x3c\x63\x4c\x0a\x24\xcd\x59\xc8\x29\xf9\xac\xff\x77\xde\xcb\xa9\x6a\x1b\xac\x2a\xcd\x14\xfc\x08\x4e\x1d\x56\x9e\xce\x67\xa5\xf1\xda\xb3\xac\xd1\xc2\x20\x36\x6e\xc4\xdc\xe4\x51\xbb\x9e\xa8\x52\x31\x21\x4c\x18\xfd\x34\xf3\x4c\x59\xcf\x50\x88\x44\x52\x2c\xa1\x84\xe7\x49\xdd\x46\xa1\xd4\x24\xb2\x06\xe8\xac\x48\xb9\x50\x18\x64\x10\x74\x75\x50\x1d\x26\xd1\x79\xc2\xb5\x2e\xbc\x44\x67\xc2\x28\x11\x88\x04\x65\x94\xb2\xef\x4d\x82\x39\x26\x16\x3a\x65\x54\xb2\x67\x2b\xe8\x54\x80\xac\x09\x1b\x9a\x8a\x35\xa0\x43\xd3\x45\xa8\x53\x41\xc5\x4f\x19\x43\xd2\x01\x41\x5c\xee\xfa\x95\x8c\x94\xf9\x68\xf8\x94\x10\xe4\xeb\x45\xee\x5e\xac\x2a\x64\x4b\xb9\xea\xf3\xd3\x92\x0b\x93\x47\xbd\x50\x2e\x49\xaa\xad\x98\xa2\x21\x28\xef\x0d\x74\x79\xa8\x9e\xda\xe0\x7b\xf7\xfd\xa3\x83\x55\xc0\x7c\xe9\xbd\x82\x38\x42\xc1\xdd\x05\x8d\x93\x08\x09\x74\x97\xc1\x2d\x53\x45\xc3\x55\x7b\x70\x56\xd8\xb3\x3a\xe9\x62\xcb\xed\xb6\xa6\xfe\xa0\xe3\x81\x5d\x06\xa9\x48\x50\xf7\x04\x0f\xc6\x5e\x28\x2d\x45\x2e\xcd\x0f\x2b\xae\x7b\x6e\xfb\xb4\xc5\x88\x77\xc5\x97\xd2\x9b\x3f\xcc\x3c\x54\xeb\x91\x6a\xb4\xb7\x08\xb9\xb6\x0c\xdd\x20\x65\x15\xec\x95\xe4\xd7\xc3\x81\x92\xba\xdc\x83\x49\x82\x48\x80\x82\x72\xc4\x7e\x95\x29\xfd\x76\x52\xb3\x94\xe6\xcd\xab\x16\x9f\xc6\xd5\x64\x4f\x72\x91\x08\xfc\x0a\xd3\x2a\xfc\x9d\xd6\x68\x7d\xf1\x46\x87\x92\xa9\x4d\x7c\x34\x07\x35\x8a\xdd\x26\x6b\xad\xab\x96\xff\x9c\xbf\x5b\x4c\xba\x76\xce\xd6\x84\xca\xc3\x9d\x7f\xe9\xd9\x16\xcc\x61\x0c\x31\xb9\x9b\x32\x38\x81\x04\xde\x59\x57\x36\xae\xff\xcc\x1b\x4e\x6d\x2c\x2a\x74\xc0\xb9\x51\x65\x71\x36\xe7\x6e\x79\x4b\x8f\xe7\xda\xea\xe5\xf7\xbf\x1d\x8f\xf2\xab\x4d\xbe\x75\xd6\xd9\xa9\x3c\x76\xfb\x75\xa6\xae\x05\xd4\xad\x30\xaa\x8a\x79\x0e\x1f\x9b\xae\xd2\xe7\x3d\xd5\x4a\x3a\x47\x32\xe7\x4f\x2b\xb2\x0c\x4d\x31\x17\x6c\xe6\xc1\x04\xd7\x6c\xd8\xb5\xf8\x96\xcb\xfc\x49\xff\x77\xf6\xd9\xa7\x24\xc0\x72\x0f\x39\x37\x69\x3a\xb5\x12\x84\x84\x1f\xa8\x93\xab\xf3\x2f\x3d\xf9\xfb\xf0\xb1\x1b\xc3\x3b\xc8\xc1\x94\xd1\xad\x1d\x55\xc5\xa3\xd7\x8e\xa4\x22\xec\x61\x46\x9a\x0c\x5d\xf7\xdb\x55\x11\x57\xd0\xbc\x0c\x5a\xcd\x7d\x66\xb7\x02\x40\x55\x84\x8d\x09\xff\xb7\x74\x8c\x54\x7d\xb0\x37\xfa\xc1\xd7\x98\x8b\x05\x9c\xe5\xea\x48\x15\x1f\x59\xfb\xa9\x00\x0a\x00\x09\x40\x11\xba\x91\x16\xae\x76\x8d\x31\xb1\x2c\xf8\x08\x73\x01\xb4\x67\x2a\x07\x1b\x82\xab\x10\x2b\x68\x11\xbe\x96\x2e\x98\x0f\x53\x2e\xe1\x61\xe5\xe2\x19\x67\x4d\x3a\x35\xf2\xa3\x54\x22\x1c\x01\x7b\xb6\x06\xfd\xb1\xf4\x25\xfe\x96\x6f\xfa\x40\x9f\x2a\x70\xe0\x53\xc6\x90\x2f\xa2\x19\xa0\x4c\x21\x17\x45\xae\xf3\x50\x24\x9c\x1c\x38\xd7\x66\x15\x1f\x90\x97\x5d\x5e\x55\x37\x4b\x4b\xf6\x45\xb9\xfa\x02\x43\x3c\x8d\xc4\x79\x0f\x69\x63\xa2\x74\x6d\xa0\xdc\x53\x72\x85\x91\x0c\xe8\x02\xf6\x8f\x60\x34\x1c\x1d\x2f\xea\x21\xf8\x0c\x0b\xec\xc3\xa8\x5d\x54\x7e\x85\xc2\x0f\x1f\x46\x56\x6e\xe5\xd0\x7b\x28\x2c\x0a\xef\xed\x48\x4b\x97\xa1\xd6\x2f\x2e\x76\x61\x96\x3c\xe3\xa3\x51\xf9\xbc\xa5\x01\x7a\x85\x23\xa4\x03\x34\x1f\xa4\x1e\x7d\x85\xa3\x08\x93\xe9\xc7\xa4\x4d\xa4\x02\xc4\x7d\x86\x13\xf9\xed\x19\x28\x00\x00\x4a\xc0\xe7\xcf\xe0\xbf\x35\xa6\xc3\x00\x49\x73\x02\xdc\xdf\x4b\x8e\x59\xdf\x67\x41\x73\x70\x6f\x17\x96\x08\x21\x07\x94\x44\x33\x27\x58\x37\x3c\x71\x62\x75\x00\x66\xd9\x2d\x40\x29\x7d\x10\xa1\x89\x50\xde\x3f\xe6\x60\xa2\x31\xb7\x40\xa6\xc9\xd0\x66\x55\x0c\xd9\xcc\x41\x17\x73\x90\x30\x14\x60\x5f\xe8\x08\x16\x4b\x49\x16\xf9\xd0\xe0\xad\x88\x07\x41\x9f\x04\x38\x79\x0a\x42\x9a\x32\xde\x28\x7b\xd6\xe0\x2a\xa2\x3b\xc9\x07\xf3\x14\xea\xcd\x49\x39\x83\x09\x37\x87\x66\xbd\x7b\x70\x54\x79\x9b\xe3\x7f\xb5\x64\xf4\x38\x2f\xeb\x20\xe4\x0f\xe0\xe9\xc8\x8e\x8f\x58\x98\x99\x39\x7b\x11\x26\x08\xb2\x83\x15\x10\xfd\xed\x59\xf8\xf7\x01\x38\x79\xfa\xf8\xd9\xe8\xf1\xb3\x51\x1f\xfc\x00\x9a\xc6\x2c\x0f\x22\x37\x51\xc9\xed\xb9\xb3\xb1\x62\x65\x45\xd2\x98\x5e\x19\xe1\x72\x41\xb3\xaf\x5e\xe8\xc1\x04\x72\xb1\x66\xc9\xdf\x27\xc1\xb7\xe2\x92\x9b\x15\xfc\x9d\x95\xfb\x7a\xfb\xc1\x15\xfc\xe7\x51\x4c\xb9\x78\x97\x8a\x77\x13\xb5\x06\xf6\x46\xf4\xdb\x25\x5b\x82\x8d\x10\xe7\x40\x84\x90\x80\xd3\x3f\xd5\xbc\xb8\xd3\xc2\xdb\x24\xbb\x3b\xab\x40\xff\x2d\xe4\xe8\xc9\xde\xc9\xd1\x93\x9d\x92\xa3\x2e\x0a\x49\xfd\xb6\x2f\x3b\x31\xd6\xc9\xad\x1b\xb3\x3f\x0d\xfc\x75\x1a\xa0\xea\x57\x6f\xc2\x10\x5a\x42\x02\xd5\xaf\x1b\x37\x3d\xbb\xa3\xf8\x15\x58\x9e\x5f\xa3\xbc\xaf\x66\x7a\x36\x08\xfd\x7e\xc8\xfc\xc9\x96\x64\x7e\x67\x45\x7e\x41\xa3\x53\x7d\xb9\x3f\x52\xbf\xa0\xd5\x59\xf3\xe6\x8e\xca\xed\x3e\x1b\x9c\x5f\xb1\x0c\x3d\xd9\x27\x19\xda\x17\x63\xf3\xad\xce\xcd\x37\x19\x70\x2f\x59\x7b\xe0\xdc\x11\x9e\x6f\xeb\xe5\x02\x60\x22\x10\x9b\x48\xd7\xa0\x56\xa0\x24\x53\x11\x51\x07\x72\xa8\xc8\xb2\x93\xff\x1c\xe9\x71\x52\x12\x81\xa9\xe6\x98\x45\xe3\xcd\x5e\x18\x41\x2e\x80\xb8\xa5\x79\xaa\xd5\xb7\x55\x71\x32\x13\xb4\xb0\xc2\x1c\x30\x24\x49\x8c\xc9\x14\xc4\x90\xcc\x4a\xd0\x9b\x24\x2b\xcf\xc9\x22\x75\x75\x26\x11\xcb\x33\x1f\x4f\xea\x12\x15\x57\x59\xe9\x66\x0e\x57\xa6\x98\xe4\xce\x72\x29\xab\x76\xb9\x21\x36\x95\xc0\x2f\xc6\xa7\xbc\x12\xe7\x26\x19\xf5\x33\x9e\x86\x6f\xd3\x78\x8c\xd8\x05\x25\x44\x30\xe8\x5f\xbf\x24\x82\x61\xc4\x3f\xf2\xf6\x04\xc4\x2a\xcf\x34\x55\xef\x40\x98\xc6\x90\xe0\x7f\xa1\x4b\xc4\x7c\x44\x04\x9c\x2a\x52\xd3\x09\xf0\xb3\x21\x00\xd2\x63\xa8\x2c\xd4\x94\xa3\xa0\x96\xc0\x0a\x2d\xf7\x3d\xf9\xfc\x14\x09\x45\x61\x3f\xa2\x5c\x1d\x45\x29\x8e\xe1\x18\x37\x6b\x58\x4d\xd7\x89\x97\x03\xf2\x32\x04\x8c\xd6\xac\xfb\x9b\xa7\x60\xaa\x7c\xbb\xe1\xff\x77\xba\x3c\x89\xaf\xd0\x27\x21\xb7\x88\x0b\x1a\x45\xc8\x17\x94\x7d\xf0\x19\x4c\xd0\x4b\x2b\xcf\x79\x3e\x81\x25\x20\xf0\xd2\xe8\x59\x20\xa4\x49\x2d\x75\x32\xf0\x33\xa0\x2a\x8d\x56\x5b\xe3\x5c\xc1\xaf\xd9\xa8\x96\x07\x52\xa6\xa8\x22\x9a\x04\x20\xdf\xf7\xf4\xb3\x3a\x91\xab\xfe\x8a\xb3\xd5\x65\x70\x09\x0a\x5e\x44\xd4\xbf\xfe\x70\x8d\x6e\x5f\x20\x81\xa4\xcf\xd1\x46\x36\x2b\x07\x9d\xfa\xd7\x25\x2b\xc1\xd1\x27\x3c\x0f\x97\xcf\x88\x0f\xc6\x33\x10\x53\x86\xcc\xce\x3d\x1a\xf1\xa1\xa5\x42\x5e\x12\x9e\x32\x04\xde\x5e\x5d\xca\xd7\xac\x9c\x69\xeb\x50\x53\xaa\x0e\xcc\x41\x48\x6b\xbd\x27\x8d\x0d\xbf\x46\xb7\x20\x30\xb3\xe8\x6c\x10\x08\x1c\xa3\x4f\x1e\x9d\x4c\x38\x12\xd9\x05\x6d\x7d\xcc\xd8\x64\xf8\x05\x88\xe1\x9b\x83\xc6\x97\x4d\x22\x69\xdd\x06\x0d\xec\x22\xf9\xdd\x30\xf9\x01\x1c\xae\x8a\xca\x0f\xcd\xb6\xc2\xb2\x79\xd5\xb9\xe0\xbc\xa5\xe2\xc3\x8c\xf8\x21\xa3\x04\xf3\xc2\xd9\x5d\x59\x78\x08\x15\x4a\x72\x72\xc0\x43\x4b\x4c\x2c\x02\xb8\x02\xd3\x49\x4c\xaa\xa0\x1b\x44\x25\xc6\xc4\xa3\x37\x88\x29\xd2\xda\x44\x96\x6f\x9b\x64\x17\x4d\xe1\xd5\xf2\xd5\xab\xd5\x71\x0f\x61\x92\xb4\x67\xc4\x5c\xd2\xe0\x82\x41\x1e\xbe\xa6\x34\xe9\x4a\xf6\x4b\x1a\xd8\x14\xcf\x33\x83\xc0\xfd\xfd\x91\xf5\x7d\x42\x03\xc9\x84\x03\xeb\xab\x3c\x55\xcf\xa2\xfc\xfd\x7d\x5f\x6f\xca\x2a\xef\x5c\xee\x19\xcd\xe6\xbe\xba\xa4\x20\xf7\x83\xd3\xfa\x34\xf8\x82\xe6\x45\xb2\x40\x87\x2c\x77\x27\x71\xf1\xc0\xba\x37\xf9\xf8\x4e\xe5\x12\x0c\x1f\xdf\x05\x68\x02\xd3\x48\xdc\x45\x74\x3a\xc5\x64\xda\xef\x0d\xda\x53\x0d\xc0\x63\xf0\x6c\x04\x1e\x83\x53\xa9\x02\x1c\x96\x76\x48\x33\xa8\x5d\x2a\x86\x5b\x6f\xa9\x78\x8f\x60\x30\xdb\x00\xa7\xa4\x71\x36\x46\x88\xe8\xfb\x20\x84\x92\x43\x66\x8d\xa4\x50\x94\x33\x95\xf3\x00\x11\x25\x53\xb9\x3d\x49\x3d\x7c\x3c\x97\x1b\x5d\x7a\xff\xd6\xf6\xe6\x2e\x57\x29\xab\x6f\x9c\xbb\x4e\xf6\x0d\x40\xb9\x0d\xef\x47\x72\x4d\xe8\x2d\x71\x9b\x73\xcc\x6d\x08\x5c\xb4\xa5\x6c\x69\x4e\xee\xcc\x2d\xef\xfd\x5a\x69\x7c\x6c\xc3\x3a\x28\x35\xc6\x2c\xfe\xf6\xcd\x79\xef\xaf\x74\x6c\x27\xdb\xb9\x4d\x44\xd6\x29\x88\x2f\xf2\xab\xe1\x7f\x51\xf5\xc4\xa5\x00\xbe\xc1\x3c\x86\xc2\x0f\x3b\x49\x66\x01\x00\x4c\x73\x08\x4a\xb2\x3a\xc8\x6b\xa5\x51\xac\xd6\x23\x20\xa0\x48\x6b\x7c\x85\xc7\x40\xeb\x70\x4c\x02\xec\x43\x81\xb8\xbe\x03\x25\xed\x50\x6b\x70\x29\xf3\xc6\x9e\x1a\xa7\xea\xa3\xb3\x9b\x0a\xbd\x20\x18\x55\xf7\x72\xc6\xd0\xbf\x6e\x12\x71\xc5\x96\x02\xb1\x4c\x40\xe9\x58\xe5\xa3\x06\x5e\x31\xcb\xf5\x6a\x1b\x0b\xdd\x6f\xce\x1b\xb1\x89\x91\x80\x01\x14\x70\xd3\x68\xac\x53\xb2\x4c\x03\x67\xbe\xac\x5c\x2d\x24\x48\x99\xfe\x23\x54\x38\x3a\x49\xf8\xa1\x34\xb5\x43\xa4\xd2\xf7\x54\x28\x9d\xe4\x2e\x4f\xd6\x68\x79\x09\x8d\x68\x2f\xfd\x8a\xe8\x24\xc8\xcf\x9b\x38\x6f\x4c\x5a\x1c\x79\x69\x94\xdf\x0c\x0d\x2f\x8f\xb3\x6d\x08\xa1\xbe\x3a\xed\xb0\xc9\xe2\x87\x90\x4c\x11\x3f\x98\x87\x59\x9a\x04\x50\xa0\x60\x03\x9b\xb8\x43\xad\x73\x9b\x5a\x4d\x76\xf0\x2a\x92\x6f\x35\x2f\x5e\x4a\xf4\xad\xf7\xbb\xc8\xbe\x55\xf1\xc3\x08\x7f\x49\xf3\x6d\x51\xf8\x2d\x5c\x2a\xec\xd5\xf9\xe8\xdb\x5c\x05\x2d\xd8\x3c\x8c\xf0\xb7\x91\xe7\x2b\x94\xfe\x25\x4d\x0a\x5b\xfe\x17\xb7\x29\xaa\xdd\xbb\xc1\x62\x46\x85\x3d\xfc\x7a\xad\x8a\x1a\xf6\x3f\xa4\x59\x61\xa3\xb3\x3f\x76\x85\xc5\x9e\x8f\x6a\xc9\x48\x47\x4a\x31\xe0\x5d\x2a\xb6\xa0\x5f\xf5\x3a\xb5\xc8\x6a\xac\x0d\x47\x14\x68\xda\xe9\x00\xca\x2a\x6b\x09\x0e\x18\xd2\x05\xac\x6a\x5d\xa5\x1a\xd1\xc9\x6e\xc1\x66\xef\x6d\x50\xb5\x02\x90\x92\x08\x71\xde\x09\x2f\x4d\xa0\xcd\xa3\x55\xaa\xd3\x69\x7d\x9a\x43\xbf\x2d\x58\x64\xa5\xdd\xa8\xc3\xee\xb8\x11\xf5\xdf\xe0\x40\xfe\x67\x93\x7a\x01\x51\x4c\x89\x34\xd0\x68\x14\xd1\x54\x7c\x10\xa9\x7f\xdd\xcd\x2f\xc9\xde\xec\xe4\x96\xa8\x87\x0b\xc3\xac\xe4\x95\x4c\x30\xc1\x5c\x5a\x66\x94\x81\x84\x51\x5d\x11\x2d\x50\x1b\x1d\x14\x20\x42\xba\x64\xc4\x22\x46\x58\x8d\xe4\xe7\x38\x94\xf5\x86\xb6\x00\xb3\xf6\xce\x1b\x14\xbd\x86\xd5\x50\x41\x2c\x40\x1c\x33\x14\x6c\x0f\xb1\xbe\x24\x7c\x17\x92\x19\x8c\x62\xcc\x1f\x82\x5a\xa3\x85\x50\x36\x4b\xf4\x3f\xec\x5d\x98\xbd\x9b\xf6\x8c\x77\x99\x54\x9d\xdd\xf7\x2d\xcb\xd9\x03\xec\x0d\x79\x69\xa0\x5f\x21\x16\x9b\x39\x4a\xc9\x8f\x31\x40\xdd\x99\x4a\x25\x77\x2a\x8b\xe5\xdf\x6a\x84\x9a\xa2\xf7\x2a\xe9\x73\xb1\xc8\xbd\x55\xf2\xa7\xb5\xda\x8f\x19\x39\xab\xf2\xb3\x56\x16\x57\x03\xda\x4b\x66\x58\x38\x1b\xfb\x5b\x2a\x3e\x64\x22\xd9\x89\x83\x56\x52\xc5\xfd\xbd\xe4\x27\x07\x74\xb2\xe4\x76\xef\x3a\x9e\x59\xf1\xaf\x7c\x89\xe4\xf9\x17\xf5\xb1\xe7\x07\xd7\x06\x87\x73\x10\xda\x8e\x05\x51\x11\x8b\x65\xcf\xa6\x1d\xb9\x78\x83\xf9\x2e\xc9\x05\x4b\x89\x44\x18\xdc\x86\x48\x65\x43\xa0\x59\x21\x2e\x69\x92\x50\x9e\xa7\x7c\x2f\x28\x34\x1b\x37\x59\xd6\x7a\x0c\xf5\x57\x3a\x36\xf5\x8b\x30\x25\x9d\xd8\xf2\x57\x3a\xee\x42\xfa\x3f\xe8\x58\x15\x76\x31\x59\x04\x02\x5e\xab\x74\x31\xca\x6c\x0f\x5e\xab\xcf\x13\x9d\x34\xaf\x0b\xdb\xe9\x5a\x4a\xad\xd1\x1c\x09\x5a\x37\x6c\xcc\x31\x5f\xb3\x4b\x09\x0e\xad\x91\x34\x5b\x79\xea\xfb\x08\x05\x6b\x67\x66\x85\x9b\x27\x2b\x28\xe1\xbf\xd2\xb1\x2e\x4a\xb5\x29\x4e\x16\x89\x53\x9d\x39\xa5\x5f\xd9\x34\xd5\x56\x59\x03\x3f\x27\x70\xa9\xe3\x82\x9f\x2f\x9f\x77\xa1\x5f\x98\x40\xcb\x0b\xb5\x0f\x05\xac\x95\x60\xb6\x9a\x35\x9d\x0e\x28\xe2\x87\x09\x2c\x6f\x64\x1b\x0f\xc0\x94\x23\x9d\x16\x0e\x45\xd4\x6c\x13\x38\xd8\xa6\xaa\x9d\x98\xe5\xd8\xd0\x2d\xd8\x98\x2a\xa6\x95\xf4\xa1\x15\xe5\xea\x0d\xfc\xd4\x3d\x3c\xba\xb8\x3c\xe9\xc8\xa7\xd9\xc9\xa0\x28\x05\x38\x57\x90\xa0\xed\x32\xcf\xc2\xfb\xbc\x4e\x80\xa4\xae\x8f\xe1\xa7\xcd\x8e\xbe\x04\xbf\x6b\xd2\xc5\xf2\x62\xf4\xea\xc5\xa6\x9c\xb1\x8b\xcb\x8f\xef\x6e\x10\xf3\x69\x1c\xe3\x6e\xb2\x91\x55\x1a\x56\x37\x3a\xf2\x57\x05\x0a\xc0\xc5\xe5\x47\x90\x8d\x0a\xb2\xc6\x16\x8a\xe5\xca\x64\x92\x4e\xa5\xaf\x60\xdb\xfb\x2e\x8d\x90\xaa\x94\x43\x68\x80\xb2\xca\xb4\x2d\x0e\x4c\xe1\xbc\x9c\xd5\xb8\x2a\xd5\xb6\x1a\x4e\xd3\xbf\xcf\xf7\xcd\x15\x69\xae\xcb\x3d\x9a\x60\x14\x51\x1f\x0a\xd5\x15\xa8\xd2\x15\x59\xfe\xfb\xb1\xd0\x72\xba\x36\x7b\x47\x10\x87\xc7\x7d\x70\x04\x16\x7a\xc5\x16\x8c\x55\xf4\xc0\x1b\x55\x54\x6f\x6d\x0c\x37\x95\x6e\x77\x8d\xe7\xe5\x26\x58\x4b\xb3\xdd\x69\x28\xbe\x34\xe7\x1d\x28\x87\xc7\xf5\xb8\x2c\x0a\x67\x5d\x12\x71\x71\xf9\xf1\x7f\x52\x2a\xe0\x16\x94\xc0\xdb\xbc\x35\x48\x1b\xaf\x15\x09\x9c\x66\x1c\x6b\x4e\x09\x14\xb3\x04\x9d\xf7\x42\xc8\x82\xde\x20\xc7\xf4\xbc\xe7\x27\x69\x6f\xbd\xea\x01\x1c\x0f\x4f\xd7\xbb\x70\xd7\xcb\xa9\xd6\xd5\xbb\xdb\xcc\xd2\xa8\x2f\xcd\x2f\x7b\x25\xb5\xf6\x7a\x5b\x3b\x1f\x15\x07\x5f\xa5\x51\x34\x9b\x77\xdb\x27\x67\x60\xce\x89\x26\x8b\x4c\x3a\xb5\xba\x6e\x5c\xfb\x9d\x20\x37\xf2\x40\x27\x00\x0b\x6e\xc3\xcc\x05\xe1\xfe\x1e\x28\x5e\xb6\x9a\x64\x9b\xe7\x7a\xca\x51\xe0\x58\x64\x47\x00\x4f\x89\xaa\x90\x7a\x50\x6a\xfb\x28\x5f\xe8\x97\x9c\x8e\x2d\x89\xe5\xbd\x5d\x1e\x5c\xc9\x4b\x71\xf3\x66\xd9\xe2\xe6\x96\x15\x67\xba\x63\xb5\xda\x70\x97\x79\x3b\xa6\xff\x55\xed\x98\x3a\x95\x47\xc8\xe5\xeb\x2a\x44\xa0\x0c\x01\xa8\x46\x4f\x28\x00\xe3\x99\x2d\x21\xb5\xed\xa0\x5c\xb1\xc2\xa4\x9b\xc0\x66\x77\x81\x3b\xcb\xeb\x84\xa1\x56\x0f\xbf\xd2\x18\x0c\x96\x7a\x3a\xae\x24\x01\x11\x12\xbd\x41\x56\x7b\xd1\x4b\xa0\x08\xcf\x7b\x47\x75\x5e\xc3\x51\x3b\x52\x79\x43\x92\x6d\xe2\xf4\x03\x18\x0d\x47\x4f\x1c\x99\x5c\xa5\x9a\xe6\x6a\xe2\xf6\x13\xe4\xfa\x52\x0e\x43\x92\xcf\x40\x55\xb1\x56\xad\x84\xc5\x83\x08\x62\x9e\x0d\x29\xa8\x2a\xce\x01\xd2\x24\xab\xa5\x31\xa1\xa9\x7d\xb9\x25\x80\x33\x3e\x04\x17\xda\xff\x9c\x2b\xbd\x06\x7c\x2e\x85\x5d\xf3\x27\x77\x44\x8e\x6d\x49\xde\x1d\x59\x56\xf5\x3b\x86\x0d\xa5\xea\x4a\x25\x43\x1e\x9a\x98\xa6\xf0\x08\x78\x0c\x4e\xe4\x8f\x27\xcf\x46\x4e\xf9\x91\x95\x8f\xb9\xca\x4b\x65\x81\x9a\xb6\x52\xe5\x17\x4b\x08\x68\x0a\xb5\xad\xaf\x2c\xca\xa3\x6d\x29\xf0\xf9\xb3\x25\x1b\xf9\x3b\x21\xe4\xf2\xc1\x56\xab\xa1\x0c\xd8\xbd\xfb\x53\x6a\x67\x60\x2e\xed\xd8\xf5\xaa\x37\x70\x68\xb1\x5c\xa9\x58\x7b\x8b\x56\x65\x1a\xda\xa3\x2c\x11\x46\x64\x91\x0a\xd5\x56\x35\xdf\xe7\x97\xbf\x00\x5d\x07\x1d\xf8\x0a\x8c\x73\x5f\xff\x0f\x3a\x2e\x05\xe5\x32\xd3\xc8\x51\x87\xdf\x56\x8a\x16\xcf\xd7\x5e\xe6\x0a\x7d\xd3\x99\x53\x51\x32\x98\xa9\xf2\xe9\x0a\xb7\xc2\x09\xd7\x97\xf1\x7c\x1a\x48\xa6\x9d\x0e\x87\x59\xd9\x5e\xa7\x5b\xa0\x32\xdf\xe6\x54\x09\x6e\x86\xde\x08\x70\xd5\xd2\xc0\xf5\x71\xb5\xa2\xf3\xc6\x21\x8f\x68\x7b\x58\xed\xf9\xe5\x2f\x8a\xdb\x3f\xa5\xc1\x14\x89\x9f\x52\xd6\xed\x44\x4b\x2e\x4c\x8b\xdf\x98\x83\x71\xaa\x50\x01\x82\x52\x10\xa7\x7e\xa8\xb9\x02\xc6\x0a\x6e\x8b\x67\x56\xa9\xac\x7f\x26\x21\x49\x82\x1e\x87\x7d\xf0\x23\x38\x38\x7e\x3a\x7c\x3a\x02\x8f\x15\x7d\x46\xa3\x51\xbf\x56\xa5\xb6\x43\x3a\x8d\xdb\x20\x29\x6a\x9f\x34\x11\x3b\xa2\x64\x6a\xe9\xbf\x46\xcb\x43\xfd\x29\xa4\x92\xae\xa7\xf1\x5e\xd1\xf9\x99\xa6\xf3\xb3\xe1\x68\x45\x32\x3f\x19\xc5\x2d\x90\xe6\x08\xb5\xa6\xf3\xb3\x45\xe8\x9c\xf5\x4b\xdb\x17\x42\x1f\x07\x8a\x3c\x4f\x56\x26\xf4\x49\xd8\x02\xa8\x7d\xbf\x36\xe2\x1c\xcc\xd9\xc0\x2d\x2a\x9b\xc3\xd5\x7d\x21\xf2\x13\x4d\xe4\xe3\x95\x89\x6c\x96\x45\x3d\x20\xdd\xaf\xaf\x9d\xc8\x4f\x16\x20\xb2\x92\xfb\xc6\x2d\xbb\x50\xe8\x1d\x36\xef\x0b\xc4\x4c\x7f\x3b\xf4\xf2\x53\x82\xf5\xfd\x88\x4e\xec\x79\x9e\xed\xdb\x7e\x01\x42\xd5\x60\x51\x4d\xff\x52\x11\x22\x22\xf4\x97\xa6\xb4\x4a\x8e\x96\xd9\xb4\x31\x73\x2b\x06\x62\x62\x95\xbc\x3a\x1e\x9e\xb6\x57\xfa\x2b\x58\x61\x36\x51\x0b\x0d\x0f\xe5\x53\xc9\xca\x43\xe8\x3e\x28\x95\x26\x30\x72\x27\x55\xd1\x7c\x4a\x0e\xe4\x16\x0b\x42\xcc\x05\x9d\x32\x18\x7b\xff\x4c\x21\x11\x38\x42\x07\x92\x99\x03\xa7\x2d\x14\x88\xf2\x3e\x3c\x8b\xa2\x31\x4e\xfd\x6b\x54\xc5\xc3\xd4\xfe\x07\x3f\x80\xd3\xa7\xa3\xd1\xf2\xd6\xf3\xae\x33\x75\xf4\xef\xc9\x54\xe9\x25\x2d\x19\x9a\x78\x3e\x9d\x32\x34\x85\x02\x05\x99\x1a\xed\x66\x6a\x3f\x27\x00\xe6\xaf\x2a\x3d\x5a\x8a\x16\x94\xec\x6b\x27\x80\xe0\xde\x06\xd3\xc5\xa8\x50\x90\x99\xce\x4a\x33\x17\x79\x14\xa6\xe4\x55\x08\x6f\x50\x5e\x81\x4a\xa7\xfa\x63\x01\xac\x1e\x91\x16\xc4\x09\xbe\x41\xf9\x09\x39\xf8\x19\x4f\x43\xa0\x8c\xf6\xe2\xd6\x5a\x71\x69\xcd\xf8\xb6\x38\xc2\x62\x26\x87\x93\xdf\x99\xee\x54\x16\x44\x93\x86\xa0\xb6\x06\x3a\x11\x88\xb4\x67\x8b\xaa\xb3\xb7\x01\x28\x9a\x87\x15\x7d\x27\x33\xaa\x51\xe6\xa5\xa4\x70\xac\x0d\x5f\xb1\x8f\xb4\xd4\x19\x2b\xfd\x47\x70\xb2\xdc\x6a\x75\xf8\xfa\x82\xde\x76\x5c\x9f\xeb\xe3\xaa\x4a\x6d\x68\x8c\x57\x96\x0a\x17\xd2\x1b\x95\xda\x60\x2a\x9a\x9d\xc6\x8d\x61\x9f\x63\x70\x68\x97\x88\x70\x48\x0c\x6f\xa6\x56\x91\x99\xb9\x74\xce\x96\x4f\x56\x58\xf0\xfb\x45\xfd\xdb\x46\x2d\xb9\x08\xc5\xcd\xf3\x2a\x4e\x10\x60\x0e\x93\x04\x41\x26\xe5\x9b\xd1\x18\x5c\xe6\x1d\xd0\x80\x80\x6c\x8a\x84\x7c\xc4\x97\x73\x9c\x35\xaa\xb8\x31\x47\x44\x1c\xa4\x49\x55\x6d\xa9\x26\xa2\x8b\x3a\x75\x5d\x9c\xf8\x43\x13\xd6\x69\xb5\x07\x54\xb9\xbd\x45\x2a\xb7\xd8\xfe\xba\x3a\xf2\xb6\x73\x66\x52\xa2\x2e\x45\x2b\x35\x50\x94\xc3\xb2\xf2\x62\x5a\x73\x3e\xed\x13\xae\xbc\x17\x57\x73\x93\x1e\xab\x13\x98\x42\xbf\x37\xd0\xef\x9a\xc6\x60\xf7\xeb\xcd\x3a\x92\x84\xfa\x28\xa7\xe7\x87\x52\x62\x97\xa6\x15\xe6\x9a\x4a\x1a\x8c\xda\xb0\x38\x8d\x11\xc8\x5a\x0e\x72\xd5\x08\x79\x8c\x00\x43\xf3\xf3\xab\xed\x93\xc1\x04\xf9\x9e\x80\x38\xdb\x19\xeb\x28\x76\x8d\x66\xfa\x74\x70\x58\x08\xcb\x10\xd3\x23\x0b\xa1\xde\x00\x4d\x26\xc8\x17\xe7\xbd\xb7\x34\xcb\x2b\xee\xdd\x9b\xeb\x92\xc5\x91\xd5\x35\x9a\x0d\x94\xf2\xe8\x83\xc5\x51\xf8\xd2\xbb\xa2\x3f\xa1\x17\x52\x36\x51\xf0\xd3\xcc\x1c\xea\x3e\x4f\x05\xe5\xbe\xa4\xf8\x9d\x1f\xd1\x34\x18\x4e\x29\x9d\x46\x68\xe8\xd3\xf8\x08\xc7\x89\x0e\x9f\x1d\xaa\xb3\xcd\xac\x4d\x2f\xa6\xe4\x0e\xde\xf2\xca\x97\x87\x21\x24\x41\x84\xd8\x11\x4f\xa8\x38\xc4\x82\xf4\xee\xfb\x2b\x14\x91\xfb\x9b\x5e\x47\x57\x94\xbe\x81\x64\x76\x49\x83\xee\x61\xaf\x08\x89\x3a\x29\x50\x11\xac\x22\xc1\x6c\x99\xb3\xce\x4b\x1a\x80\x2c\x68\xdd\xa4\x75\x74\xdb\x7f\xa9\x94\x75\xa7\x60\x0b\x50\xa5\xbb\x9e\x0e\x5a\x36\xf2\xcd\xf4\x78\x7d\xaf\x71\xce\xd4\x96\x2e\x36\x94\xc7\xab\x12\x1a\x14\xe1\xcd\x81\x69\x56\xeb\x14\x1f\xd2\x88\x64\x65\x87\xe6\xbd\x78\x70\x3c\x28\x0a\x2b\x61\x32\xa1\xcd\x6d\xb4\xac\x0b\x3f\xf9\x6f\x45\xfc\x2d\xdb\x9b\x2a\x64\xa8\xea\x9d\xec\x1c\x20\xa1\x01\x6f\x1e\x0e\x7c\x53\x48\x93\x29\x61\x34\xfc\x7e\xe1\x36\xd0\xad\xca\x46\xea\x34\x4c\x10\xe7\xaf\x22\x98\x74\x2e\x81\x26\x8d\x34\x96\xbd\x99\x85\xb8\xe9\x44\x67\x27\x35\xa8\x6e\x6d\x45\x05\x85\x14\xba\x02\xa7\x4b\x9b\xd9\xb5\x4d\x3b\xd5\xd5\x3a\x70\x72\x44\xeb\x75\xbb\xa3\xb0\xab\x0a\xfd\x5e\xa7\x8f\xea\xa8\xa8\x66\x5e\x61\x79\xad\x4e\xe5\x08\x89\xcb\x08\x4d\x5f\xa4\xda\xb0\x97\x06\x69\x67\x1a\x67\x8b\x5b\x2e\xc3\xd7\x78\x82\xfc\x99\x1f\x21\xf0\xf2\x46\x7a\x51\xa6\x4c\x04\xd5\x79\x2a\x10\x7c\xff\xbd\x08\x41\xa2\x97\x33\x8e\x10\x08\x52\xc7\x37\x93\xff\xe8\x04\x38\xd7\x32\xb2\xe2\x88\x94\x34\x71\xae\xb5\xb6\x66\xe6\xf0\x9c\x65\xe7\x46\x49\x84\xa6\x1e\x43\xaa\x7f\x61\x36\x7c\xe6\xc8\x9c\x55\x1d\xa5\xcf\xd9\x2f\xe7\xbd\xd1\xf0\xfb\xef\xa5\x73\x75\x5e\x2a\x1b\xbb\x2a\xe1\x69\xf0\x41\x40\x26\x3e\x26\xaf\xa1\x40\xc4\x9f\x75\xa6\xbe\x4d\x79\x55\x2b\x2f\x4d\x2a\x14\x8e\x34\x4c\xa9\x65\xeb\xc8\x6a\x13\x7e\x61\x02\xd7\xba\x95\xdf\x7f\x3f\x70\x5b\x01\x2a\xa2\xd3\x40\xb5\xfc\x45\xac\x42\x73\xc7\x79\xec\x70\xfc\x56\x77\x38\x10\xa1\x7e\x49\x01\xd7\xa8\xda\x0c\x17\x5d\xa7\x16\xc6\xa8\xeb\x90\xe0\x47\xf0\x6c\x7d\xd6\x53\x84\xc4\x42\xb6\x77\x64\x6a\x95\x6c\xc0\xf6\xee\x30\xf5\xcd\x99\xe4\x99\x49\xd7\x1e\xa4\xcb\x0c\x2f\xb6\x10\xcd\xf2\xb7\x36\x45\xb9\x1c\xf9\x8d\x92\xc8\xa7\x44\xa8\xaa\x23\xec\xd0\xf4\x9f\x6e\x0f\x68\xe6\x8f\xbf\xd1\x4f\x2f\x44\xb3\xca\xdb\x9b\x94\xba\x9a\xa9\xad\x91\x92\x45\x5f\xee\x46\x7a\x15\xd3\xf8\x09\x06\x17\xaa\xaa\x6c\x1b\xa5\x9c\x12\xd2\x16\x09\x3e\x7f\xae\x04\x1a\x54\xfc\xc1\xba\x14\x6c\x2c\x8b\xfc\x5e\x93\x13\x57\x92\x6e\x8e\xb2\x61\xb3\xc2\xb6\x6a\xe0\xba\xd6\x08\xfa\x75\x6b\x68\xe7\x0d\x03\xaa\x89\x03\xff\x05\x7e\x35\xa5\x68\x62\xf8\xa9\x08\x41\x0c\x32\xac\x74\xdd\x69\x09\x33\x8d\x02\xe0\x33\xa4\x2e\x21\xc3\x88\x23\x40\xd0\x14\x0a\x7c\x83\xf8\x00\x70\x84\x2c\x88\xa1\x10\x09\x3f\x3b\x3a\xba\xbd\xbd\x1d\x32\x3a\x4e\xb9\x50\xbb\x8e\xa2\x91\xf4\xa4\x14\xa1\xa5\x9f\x42\xc9\xe1\x14\xa6\x53\xc4\x0f\x31\x39\xb4\x5a\xa6\x9f\x1c\x8e\x94\x77\x1c\x20\x01\x71\x64\x55\x89\x76\x70\xb4\x7b\xf6\xeb\x29\x7b\xd2\xf0\xf2\xf4\x84\xf5\xa5\x39\xce\x27\xa9\xee\x2b\xfb\xa5\xe7\xf6\x64\xb7\x5b\xb4\x3b\xed\xec\xb3\x12\xb0\xcb\x54\xf3\xad\x8f\x53\x16\xac\x79\x4b\x4d\xac\x14\x53\xf2\x3f\x29\x4a\x91\x71\x15\x5e\xa5\x51\xd4\x59\xc8\x9e\x4b\xa8\x80\x58\xa0\xc0\x3f\x25\x2c\x69\x1f\x2d\x2a\x80\x96\xc8\x59\xce\xd6\x24\x2d\xfa\x06\x5b\x92\x66\x01\x87\x4d\x38\x54\x7a\x16\x49\x58\x59\xa8\xdb\x1a\xcd\x94\x00\x6f\x8c\x96\x59\x72\x69\xd7\x5f\xde\x51\xb9\xb4\xbd\xa5\x52\x52\x92\x25\xa4\x36\xb9\xb8\xa7\xe8\xe5\x45\x88\x4c\x45\xb8\x8c\x84\x0e\x74\x89\xe2\x27\xd6\x99\xdc\x8f\x16\x1a\x6e\xd9\xea\x39\x58\x64\x0e\xdd\x52\x2b\xa5\xe2\x54\xae\x66\x09\x15\x52\xa6\x42\xfa\x1f\x74\x4c\x43\x09\x3d\xbf\xa2\x1f\x68\x8c\xd4\xef\x66\x6f\x58\xac\xe7\x45\x51\x98\xfa\xd8\xed\x43\x63\x02\xf5\xb7\xa1\x34\x8c\xb9\x1e\x52\x23\xc4\xd5\xe6\x66\x11\x76\x61\x1d\x2f\x28\xb0\x31\xb6\xde\x82\xd6\xd7\xf7\xf7\x75\x0d\x1a\xac\xc1\x4a\xcd\x37\xec\xd0\x61\x8e\x7f\x09\x73\x67\x4b\x81\x80\x27\xc8\x97\xac\x77\xd0\xe9\x92\xa5\xa8\x0c\xf6\x46\x11\xd2\x63\x5b\x2d\xbc\x97\x16\xa0\xa3\xce\x63\x4a\xb3\x61\x1d\x23\x16\xbf\xa9\x50\x7a\xb1\x92\x16\xcf\xe8\x5e\x42\x98\x9f\x93\x99\xcd\x8a\xf5\x88\x72\x8c\x09\x8e\xd3\xb8\x4d\xa4\x2d\x32\x97\x2d\xb7\xce\x22\x0d\xc9\xcc\x95\xa3\x76\xe9\xcd\x25\x97\xdb\x2d\x20\x9a\x05\xb7\x0a\xbf\x41\x4e\x63\x4c\xb2\x6a\x7a\x07\xf6\x7a\xea\xff\xdb\x4b\xf0\x93\x15\xec\xe5\x26\xeb\xe5\x82\x12\xa2\x92\xa7\xaf\xe8\x72\x5a\x78\x61\x51\x33\x5d\x20\xfc\x6c\x60\xdb\x86\xa8\x4a\x09\x6f\x37\x5b\xca\xc0\x5a\x41\x7c\xa5\xa6\xb2\x2b\x86\xf6\x92\xe1\x5e\xe6\xb0\xa1\x60\x39\x9b\xf9\x87\x92\xda\x5c\xb6\x78\x4b\xc1\xb2\xab\x0f\x2f\x7e\x7a\xaf\x0c\x7a\x2e\x1d\x9d\x39\x11\xde\xd5\x9d\xb1\xc0\x6d\x7f\x03\x54\x6f\xab\xca\xb1\x83\x6a\x3e\xa6\x3c\x34\x73\x97\x95\x97\x8e\x81\x9f\x84\xed\x82\x28\x87\xc2\x9c\xa7\x88\x1b\x40\x52\xf9\x8d\x23\xea\x5f\x6b\x7b\x43\xba\xce\x8d\x65\x65\xf3\xf3\x78\x8b\xab\x82\x07\x63\xe3\xf9\x70\x2f\x43\x6a\x49\xfd\xf2\x24\xfc\x7b\xa5\x3c\xd3\xd3\x25\xf3\xd6\x5d\x4e\x5e\xd0\x38\x81\xbe\x62\xda\x6e\x71\xd3\xcf\x11\x5b\x03\x47\x33\x60\x39\x4b\x17\x66\x64\x81\x0e\x37\x35\x4c\x76\x8a\x95\x6f\xa9\xf8\x85\x4c\x11\x97\x53\xfc\x00\xe3\x64\x91\xee\x92\xcb\x6e\x00\x38\x1b\xd0\xe2\x25\xd7\x63\x77\xd2\xf9\xf9\xfb\xe5\xb7\x6a\x7b\xcf\x94\x19\x12\x22\x18\x78\xe6\x45\x0f\x26\x09\x22\xc1\xd2\x3c\x29\x77\x63\x5a\x97\xaa\x7c\x91\xaa\x0a\x15\x02\x5d\xe1\x18\x71\x01\xe3\x64\xb3\x5c\x09\x18\xb5\x8f\xdc\x40\xb9\x0d\xe0\xd3\x69\x6e\x97\xaa\x63\x05\x4d\xbd\x23\xdd\xb8\x18\x04\x78\x32\x41\x4c\x5d\x46\xd1\xe9\x4d\xe3\x54\x80\x20\x9b\x82\xb3\xcd\x67\xd3\x99\xcb\xe7\x0c\xa3\x6c\x2c\x33\x52\x06\xb4\x80\xd4\x9d\xf9\x2a\x90\x69\xba\xaf\x71\x23\x01\x5e\x0e\xd1\xcb\x21\xae\x22\x0b\x6b\xaa\x84\x57\xd0\x42\x35\x7d\x7d\xc7\x02\xc4\xf6\x42\x14\x0a\xb6\x00\xc8\x18\xbe\x91\x1c\x34\x6d\xe3\xa8\x9c\x44\x3b\xdf\xe5\xd8\xdc\xe5\x38\x4d\xc5\x21\x9d\x1c\xaa\x97\xd7\xc6\x74\x9a\x0a\x8f\x4e\x3c\x05\x74\xa7\xb8\xfd\x1e\xc5\x54\xa0\x0f\xfa\xda\xf0\x2b\xb3\x7b\x6d\x8e\xdd\x56\xff\x42\x44\x1a\x7b\x80\x96\x9c\xd2\x2c\x39\xd1\x70\x49\x50\xf7\x1a\xba\x9c\x80\x3a\x78\xbb\xbf\x3f\xab\xbd\x6a\x96\xb2\xc8\xee\x2e\x5c\x27\x07\x12\x2f\x9e\xa1\x65\x8f\xa4\xc1\x03\x73\xaf\x7a\x99\x78\x87\x41\xd0\x40\xc8\x76\xe4\x6c\x3b\x58\xb3\xdb\xe8\xd6\xae\xdd\x3a\x2a\x00\x7c\xb7\x08\x02\x79\x69\xba\xf5\xe2\xb0\x91\x08\xcd\x3c\xff\x56\x2f\xa4\x5f\x19\x16\xe8\x27\x14\x62\xd2\xbd\x37\xeb\xc2\x8b\xc8\xc8\xe4\xad\x1c\xcb\x9a\xb6\x3e\x8a\x6f\x58\x45\x1c\x8c\x15\x56\xe5\x9e\x18\xe5\xe5\x63\x2f\x1a\x0b\x76\x71\x58\x5f\xbf\x7e\x6c\x94\xd4\x8d\x12\x35\xd8\xd7\xe1\x0c\x97\xda\x2f\xd4\x3b\xc6\x25\xc9\x0e\xf1\x34\x44\x5c\x58\x5b\x3c\xce\x33\x13\x56\x13\xef\x43\x93\x7d\x3f\x00\xe5\x9c\x04\x86\xa7\xa1\x58\x02\x57\x1d\xc5\xcf\x30\xd6\xa1\xa5\x1c\xed\xb5\xe0\x6c\x5f\xb0\x3c\x3e\x59\x38\xe7\x61\x81\xa5\xf7\x42\xd7\x0b\xfc\x10\x42\xd6\x9e\x43\xb8\x91\x15\x98\xd5\x42\xe4\x6a\x78\xe0\xc3\xc8\x4f\x23\x7d\xc0\x75\x0b\x89\x0e\x4d\xb2\x94\x94\x32\x66\xf4\xc3\x52\xf4\xf4\x29\x58\xed\x1e\xd6\xb6\x4e\xc1\xfd\xfd\x00\xdc\x86\xd8\x0f\xe5\xd2\x2b\x22\xa4\x72\xd3\x8c\xe1\x27\x9d\xfa\x64\xc1\x34\x2a\xe2\x1f\x2d\x8a\x59\xe1\xe4\xc5\xf0\xd3\xe7\x4c\xca\xce\x7b\x7f\xe2\xfa\x9a\xf4\x02\x62\xf0\x8f\x9a\x99\xe4\x5d\x5a\xef\xe4\x7c\xd9\x0c\xdc\x81\x09\x66\x5c\x80\x3b\x90\xd1\x64\x01\x5d\xd3\x95\xe4\x75\xf5\x5b\xad\xae\xaf\x92\x4c\x1a\xc4\xbf\xaf\xce\x32\x4c\x37\x14\x5d\x6d\xc5\xff\xb8\xfc\xf8\x52\xe8\x76\xea\x24\xf1\x7d\x1a\x6d\xc1\x32\x6e\x4a\xe6\x40\x72\x51\x48\x59\x6a\xe9\x92\xaf\x32\x51\x9c\xfc\xd1\xe2\x26\x49\xa3\xbf\x3b\xd1\x31\x34\xf5\x72\x36\x8a\x9c\xd0\x22\xf1\x26\xf9\xae\x57\xbc\xbb\x6a\xec\xb0\xd6\xc5\x59\xc3\x06\xf1\x06\x73\x8e\xc9\x54\x32\xf2\x65\x31\xd1\xcd\xf2\x32\xc6\xaa\x33\x4b\x9d\xe2\xad\xe5\x9f\xde\xc4\x6d\x46\x2c\xca\xd0\x58\xcf\xb2\xc2\x50\x10\xa4\xea\x5a\x21\x8f\xe8\x6d\xfd\x50\x0b\xb3\x5c\x1b\x1c\x58\x98\xbe\x5f\xdc\xd3\xb3\xdd\x32\xdb\xab\x75\x17\xec\x03\x89\xa1\x5a\x15\xea\xb5\xba\x4c\x2d\xfb\xd4\x46\x27\x6a\xfd\x42\x7c\x4a\x4c\xb9\x91\x36\xd9\xc8\xd3\xdb\xee\x2c\xd6\x5e\x85\xa8\x94\x3a\x65\xbc\xd6\x6c\xbb\xe3\xd9\x17\xce\x71\xbe\x49\xc4\x07\xff\xe8\xd2\x55\x4c\x5f\xdf\x02\xf7\xf7\xff\x50\x15\xdf\xad\xe6\xf8\x43\xd7\x93\x66\x90\x4c\x51\x2e\x6d\x36\x51\xb2\x5c\xa7\x10\xf2\xd0\xaa\x66\xf3\x7b\xef\x4f\xfc\xf7\xde\xc0\x8c\x60\x3e\xde\xf7\x6a\x50\x2a\x23\x93\x6d\xe2\x4e\x36\xd0\x85\x43\x08\x39\x96\xda\xa5\x12\xdd\xf1\x62\xf8\xda\xe9\x6c\x81\x39\xe8\x39\xba\x6d\xd2\x03\xc3\xff\x35\x4b\xa3\xe7\x4e\x4c\x3a\xe7\xf9\x48\xcd\x77\x31\xf2\x7b\x0e\x06\xcb\xbe\x29\xbc\xe9\x65\xd1\xc2\xfa\x67\x7a\x16\x71\x7a\x03\xd0\x48\x37\x7d\xc1\xcc\xfa\xeb\x61\x0c\x31\xb1\xeb\xce\xf4\x8a\xaa\x33\x31\x25\x58\xa8\x2b\x3d\xbd\xfb\x7e\xdf\xbe\xd5\xb0\x44\x39\x99\x06\xf9\xd5\x59\x7c\xfa\xa4\xab\x93\xe4\xbe\xcf\x8f\x8d\x6c\x30\xdf\x96\xb3\xff\xac\x3d\xa9\xa9\x95\xa2\xe3\x23\x96\xfa\x96\x34\x67\x78\xd7\x91\xb6\x25\xfd\x6e\x29\x62\xaf\xd8\x51\xbf\x81\xd4\x6f\x50\x3c\x46\x8c\x2f\xac\x2b\x9c\x55\x9f\x55\x54\x9f\xd0\x94\x04\x00\x46\x11\xa0\x22\x44\x0c\xc4\x1a\x78\xa6\x29\x8c\x72\xe8\x46\x44\xfd\xac\x67\x40\x2c\x4f\x36\x8b\xa1\xdf\x9c\x03\x4a\xc0\x41\xbe\x40\xfe\xf2\xfe\xdd\xc7\x4b\xef\xf5\xcb\x57\x57\x07\xfd\xca\xaa\xb3\x9e\x3b\xd8\x0c\x66\x8b\x16\xb1\xad\xc9\xe0\xd5\xad\x2a\xa3\x39\x5b\xc3\x95\x0a\xe1\x76\xce\x72\xfe\xb6\x29\x5a\x6d\xc5\x2e\x5b\x4b\x30\x55\x6f\x5d\xdf\xdf\x9b\x2c\x68\x65\x04\x34\xdd\x3b\x2e\x3e\xc8\xed\x20\xa0\xb7\xe5\x8e\x1f\xc7\xaa\x68\x86\x29\x3a\x9c\x26\x6a\x45\xf4\xc1\x4f\xff\x67\x2e\xe6\x5b\x8d\x7d\x72\xde\x65\x95\xa6\xd3\xa4\x78\x30\x47\xac\xe6\x05\xb9\x71\x1f\xaf\x67\x99\xfd\x0a\x85\x1f\x06\xb4\xdb\x2d\x2c\x77\xef\xc5\xca\x00\x82\xc4\xa4\x96\xc6\x08\x12\x21\x4d\x1e\x44\x78\xca\xac\x3b\xef\x88\x08\xcc\x10\xc8\xfc\x2a\x90\xe0\x04\x45\x98\xa8\x90\xd5\x24\x25\xea\xd0\x14\x46\xc3\x32\x6c\x0d\x55\xfd\x72\x0b\x67\x5c\xfa\xab\x59\x09\x42\x86\x26\xd2\xdf\xc6\x02\xf0\x50\x79\x7e\xe6\x91\x31\x32\x4f\x49\x06\xd6\xe4\x69\xe9\x19\x06\x16\x44\x04\xe0\x14\x4a\x6b\x01\x40\x55\xec\x10\xdf\x20\xa6\x4a\x02\x30\xcd\x5f\x4c\x04\x9a\x1a\x7b\x4b\x9f\x54\xdc\x40\x86\x69\xca\x9d\x4c\x5a\xdb\xef\x42\x7e\x08\x09\xe6\xb1\xe9\x54\xab\x42\xdb\xd0\xcd\xbb\xbd\x0d\x11\xd1\x6d\x6d\xf3\x39\x2a\xc5\xa4\x50\x1f\x82\x57\x94\x01\xf4\x49\xc5\x66\x4b\x6d\x1f\x7a\x2f\x10\x0c\xde\x40\xc2\x3f\x10\x2c\xfc\xb0\x67\xa3\x27\xa7\x7c\xa9\x2e\x0a\xa4\xe5\x9b\x93\x37\xc8\x17\x94\x1d\xe4\xe9\xf8\xcd\x22\x42\x28\x41\xd6\xca\x55\x17\x50\x09\x12\xd2\x6c\x6c\x5c\xb8\xea\xaa\xb5\x7e\xe6\x17\x22\x10\x9b\x40\x1f\x2d\x74\xb7\xcf\xbc\xac\x26\xa3\xde\x56\xa6\x49\xd1\x32\xde\xd8\x5f\x3d\x7d\xa1\x4f\x71\x57\x7c\xcb\x41\x9a\x98\x7b\x80\x16\x81\x54\xb1\x84\xec\xf2\x53\x5e\x2b\xb8\x7b\xc7\xaf\x5e\x93\x95\x63\xee\xfc\xe9\xeb\x46\x1a\x5f\x2f\xbb\x03\xe1\xd6\x25\x1e\x68\x84\xbf\xf9\xd2\xbb\x41\x22\x1c\x7e\xd7\xbb\xff\xed\x44\x9b\xda\xce\x0d\xbf\xc6\xa2\x5b\x6d\x96\xb6\x65\xce\xd3\x44\xdf\xc5\x6b\x64\x8b\x75\xb6\x68\x1e\x7d\x8d\xf9\x22\xe5\xed\x5e\xda\xd9\x88\x09\x62\x13\xca\x62\x49\x7c\x09\x06\xe8\xe1\x33\x87\xa9\xb8\x00\x62\xf9\x6a\xc5\x97\x6e\xd2\x3a\xa9\xf7\xe7\x0a\x3d\xd7\x78\xde\x93\x15\x38\x29\x20\x17\xfb\x57\x56\xed\xc4\x72\x9a\x32\x12\x79\xea\x7a\x60\x81\x71\x35\x3f\xa3\x57\x43\xd8\x0e\x7b\xe3\x6f\xc7\x23\x75\x7f\xed\x08\xac\x0b\xb3\x75\xa1\xa4\x6f\xee\x3e\x5d\x73\x4c\x26\x13\x24\xb5\x67\xac\x41\x92\x14\x9c\xfd\x12\xa5\x5b\x89\xf2\x6e\xca\x52\x05\xb5\xfd\x10\xa6\xf7\xc8\xa7\xc4\xc7\xd1\x22\xb5\x51\x1d\x81\x62\x06\x40\x56\x30\xb3\x22\x33\xa6\xd2\xf0\x5c\x47\xaa\xa8\x45\xdc\x35\xed\x20\xa7\x7d\x86\x03\xaa\x26\x26\x2f\x4b\xfb\x2c\x3e\x33\x5c\x77\x7e\x66\x46\x78\xb9\x6f\xbf\xa6\xf4\x3a\x4d\xd6\x41\x79\x3b\x2a\xd6\x6c\x3c\x2f\x4f\x61\xb5\xf3\xc2\x20\x60\x88\x73\x2f\x52\x68\xef\x16\xad\xb3\x3d\x1a\x09\x3f\x68\xdc\x94\xe5\x1f\x8d\x33\xdb\xdd\xd3\x91\x2f\xe5\x31\xab\x5e\xc5\xa9\xe9\x9d\xe5\x2e\x6c\xe6\x91\x80\x03\xfb\xb0\xab\xdf\x58\x67\xc5\xe9\x6b\x8f\x48\x90\x50\x4c\x84\x9b\x86\x2f\xb5\x51\xfe\x48\x71\xf8\x99\x5d\x00\xfd\xd2\x1b\x3e\x96\xf8\x0d\x1f\x6b\xdf\x7f\x4c\x69\x64\x97\xda\xa7\xb6\xe9\xad\xbd\xd6\x1c\xda\x15\x2d\xb7\xd0\x6f\x1a\x4c\x49\x85\x1c\x26\xb7\xbd\x12\x84\x98\x3e\x35\xad\x8d\x7e\x17\x48\xfd\x76\x7c\x32\xe2\x7f\x37\x3a\x6c\x74\x6c\x0d\x57\x7f\x52\xba\xa6\x0b\x84\x72\xf8\x5f\x08\x4f\x27\x13\xec\x63\x44\x84\x61\xfa\xba\x18\x8e\x2d\xd0\x39\xf7\x0f\xea\x0b\x5d\x34\xb3\x9f\xa7\x71\x2b\x1f\x8f\xfb\x75\xdc\xf8\x01\x1c\xe4\xce\x6d\xe5\xdd\xda\x37\xbe\x03\xaa\x9b\xd2\x89\x5b\x41\x72\x15\xd2\xbe\xa5\xaf\x11\x0c\xda\xef\xdf\x2c\xbe\x80\x40\x4d\x85\x66\x50\xe9\xf2\x4b\x28\x88\xd4\xe0\x8d\x74\x55\x92\x6a\x6a\xee\x85\x90\x7b\xfa\xf1\x5a\x3a\xbb\xe2\xb6\x0a\x49\x7e\xc6\xd3\xf0\xad\x2a\x63\xf7\x6e\xa2\x89\x73\xa1\x9d\x96\x75\xd1\xc8\x39\x3f\xd7\x53\xca\x0b\xd5\xe9\x62\xfc\x16\xa1\xea\x6a\xaa\x80\x57\xaa\xce\x28\x11\x00\x45\x48\xa7\x4a\x67\x45\xa1\x20\xe0\x78\xaa\xa2\xf7\x8e\x64\xe7\xed\xd1\x06\x36\x0f\xf0\x34\x04\x46\x0f\x64\x15\x31\x06\x80\x32\x10\x60\xce\xd2\x44\xc3\x1d\xcf\x4c\x74\xcf\xa7\x71\x42\x09\x22\x42\xf7\xb9\x32\xf1\x82\xb1\xf4\xec\x6f\x10\x17\x58\xd5\x9e\x6b\xe4\x64\x7e\x16\x73\xe0\xa8\x4a\x4b\x35\xd9\xbc\xd6\x44\xf1\x0c\x51\x3c\x8e\x10\x69\xd0\x4a\xaa\xe5\xf9\xe8\xb1\xb9\x4c\xbf\x24\x8c\xfe\x6f\xc7\xa7\xf1\xd9\xb1\xda\xbb\xce\xc1\xd3\x05\x03\x75\xb5\x56\x82\x84\xfd\x97\xf7\x97\x17\xef\x4d\x0d\xef\x0f\x11\xbd\x5d\x97\xfc\x4c\xdf\x5f\x5e\x14\xfd\x8b\xdc\x7c\xc2\x29\x4b\x7c\x4f\xee\xdc\x34\x70\x4d\x32\xb9\xa3\x99\x66\xb0\xb6\xfc\xa9\x0a\x32\x6a\xc8\x7c\x9d\xd6\xaf\xdd\x46\xce\xce\x2f\x77\xa2\x70\xca\x57\x31\x09\xa4\x99\x53\x57\xe7\xc4\xe2\xc9\x00\xa8\x97\x4c\x6b\x1c\x02\xd9\x2c\xaf\x71\x92\x5d\x78\xcb\x1f\x28\x15\x40\x3f\x3e\x5d\xdf\xc6\xa3\x37\x9b\x0b\x1a\xc7\x29\x31\x31\xa7\x75\x32\xd2\x28\x4b\xdf\x86\xaf\x63\x63\xd6\xd3\x57\xd4\x65\x64\xd1\xd4\x77\x4b\x7c\x6c\xb0\x19\x18\x4d\x49\xe0\x09\x86\x13\x95\x19\x31\x87\xa1\x86\x7b\x2b\x72\xaa\x71\xa9\xd9\x3a\x5b\x1f\x27\x5d\x32\x9a\x50\x0e\xa3\xcd\x68\xed\xc4\x40\xcf\x6f\xcc\x58\x3c\x32\xfd\x54\x8a\xdb\x33\xa3\x4c\x71\xaf\xcc\xa3\x82\x15\x66\x39\x65\x78\xd4\x5e\x95\xb1\x6d\x37\x63\x98\xaf\xa7\x02\x59\x46\xf1\x57\x7c\x46\xfc\xac\x3a\xd6\xda\x08\x5d\xae\xd5\x34\x99\x11\x3f\x2f\x89\x65\x13\x5a\xaa\xb4\xad\xaf\x81\x00\xf3\x6b\xef\x16\x46\xde\x44\x4e\xbe\xb5\x6e\xd3\x1c\xf1\x5f\xaf\xf4\x5f\xa8\x4e\x77\x1b\x67\x86\x6e\xa8\x57\xcb\x8e\x87\x61\xc5\x18\xfa\xd7\x88\x04\x9e\x46\x6c\x15\x7e\x9c\x6c\x52\x1d\xfd\x7c\x75\x75\x99\xd9\x02\xdd\x78\x63\x93\xf3\x4f\xba\x31\xb5\xd5\xb1\xd0\xa2\xa6\xde\xed\xad\x2e\xe1\x86\xf4\x4e\x7c\xaf\x95\x09\x6d\x6e\x4d\x41\xee\x50\x88\xa4\x55\xcd\x0c\x80\x4f\x03\xf4\xcd\x79\xef\xe9\xe8\x69\x79\xbb\x06\x07\xbe\x2a\x37\x76\x54\x07\xd4\x9c\x17\x35\x6a\x2f\x97\x57\x25\x88\x35\x4d\x4f\xfe\x8d\x58\xd7\xea\x92\xee\x07\xef\xd6\x68\xaf\xd9\xac\xea\x6c\xa8\x75\x51\x53\x40\x42\x6e\xb2\xb9\x9b\xcc\x6d\x1e\xd1\xdb\x85\x8b\x04\x96\xc8\x5b\xa4\x92\x34\xa9\xb6\xb5\x1a\x56\xff\x2f\x00\x00\xff\xff\xe7\xea\x17\x8f\xbb\x80\x01\x00")
+var _assetsPrometheusK8sRulesYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x7d\xfb\x73\xdb\x36\xf6\xef\xef\xfa\x2b\x50\x7d\xb7\x53\x29\x95\x14\xd9\x8e\xb3\x5d\x4d\xdd\x99\xae\xeb\x76\xf7\x6e\x13\x7b\x12\xe7\xf6\xee\x24\x1d\x0e\x44\x42\x12\x6a\x92\xe0\x02\xa0\x1d\xaf\xed\xfc\xed\x77\x0e\x48\x82\x2f\x90\xa2\xde\x92\xbf\xdd\x74\xbc\x12\x85\xc7\xe7\x3c\x01\x1c\x1c\x80\x38\xa0\xff\x97\x70\x41\x99\x3f\x42\x1e\xf3\xa9\x64\x9c\xfa\xd3\x81\xcd\x38\x61\x62\x60\x33\xef\xe5\xed\x51\xeb\x86\xfa\xce\x08\x5d\x71\xe6\x11\x39\x23\xa1\x78\x17\xba\xa4\xe5\x11\x89\x1d\x2c\xf1\xa8\x85\x90\x8b\xc7\xc4\x15\xf0\x09\xa1\x40\x17\x1b\xa1\x9b\xef\x84\x7a\xc6\x99\x4b\x46\x08\xbb\x84\xcb\x3e\x0f\x5d\x02\x4f\x7d\xec\x91\x51\xa6\x74\xff\xe6\x3b\x91\xfb\x51\x04\xd8\x26\x23\xc4\x02\xe2\x8b\x19\x9d\xc8\x7e\x8a\xaf\x25\x02\x62\x43\x77\x53\xce\xc2\x40\x75\xdc\x8f\x1b\xf4\x99\x43\xfa\xe4\x73\xc0\xb8\x24\x7c\x90\xb4\x87\x90\xfa\x04\x05\x11\xea\x23\xf2\x39\xe0\x23\xf4\xa8\xbe\xc1\x7f\x36\x0b\x7d\x89\xee\xa8\x9c\xb1\x50\xa2\x8e\x1d\x84\x5d\xd4\xd1\xbf\x96\x7e\xf7\x98\x43\xf2\x05\x90\xea\xd7\xb2\x83\xd0\x12\xc4\x66\xbe\x23\x2c\xc9\x24\x76\x1f\xfe\x60\xe3\xb3\x76\x0e\x53\xfb\x29\x53\xaf\xdb\x2a\x7e\xe2\xc4\x66\xdc\x19\x21\xea\x0b\x89\x7d\x9b\x8c\xa0\xb2\xe5\x87\x1e\x34\x3e\x12\xa1\x57\x41\xc2\x11\xea\x23\x7c\x3b\xcd\x11\xd1\x43\x65\xa4\x1c\x4b\xd2\x69\x0e\x36\x6a\xe2\xac\x4d\x1d\x97\xb4\x9f\x3e\x1e\x79\xbf\x37\x85\x0c\xbc\x08\x25\x75\xa9\xc0\x12\xd4\x0b\x3a\x3e\xaa\x42\x9f\x45\x08\x00\x2c\x97\x61\xe7\xa8\x9e\x7b\x2f\x33\x75\x2a\xb9\x55\xdf\x44\x3d\x05\x0a\x83\x15\x10\x0e\xac\x1a\x71\x20\xa3\x86\xf9\x25\x12\x3c\xe2\x31\x7e\x6f\xbd\x21\xde\x8f\xb7\x98\xba\x78\xec\x12\x6b\x7c\x2f\x89\x68\x4e\x56\xa1\x9d\x6b\x90\x52\x93\x36\xea\xe9\x8a\x1b\x2c\x08\xa7\x92\xb8\x54\x63\x6e\x3d\x21\xb1\xb4\x82\xa9\x87\xff\x98\xe0\xd0\x95\x46\x14\x59\x25\x31\x03\x28\xb5\x53\xaf\x1c\x29\x00\x87\x8a\x1b\x8b\x32\x4b\x52\x8f\x34\xd1\x5d\x87\xdc\x52\x9b\x9c\x7d\x69\xfb\xb7\x1e\x19\x7c\xfb\xc8\xc7\xce\xe0\xdb\x47\x01\x7f\x6e\xe1\xcf\x67\xf5\xd7\xf1\xfa\xf0\x17\xc3\xf3\x7a\xf8\x56\xd4\xe0\xa8\x12\xcc\x52\x84\xdc\x11\x3a\x9d\x49\xe2\xec\x17\x45\x45\x54\xf5\xa4\x89\xd0\x4b\x5d\x4f\x04\xb2\xca\xef\xf8\x44\xde\x31\x7e\x63\x71\x62\x13\x7a\x1b\xdb\xc4\x7c\x9a\xbf\x3a\x6b\xbb\x2c\x4f\xcc\x3c\x45\x37\xf7\x44\x3e\xdb\x6e\xe8\x50\x7f\x6a\xb9\x6c\xed\x44\x49\x8e\x7d\xe1\x51\xb9\x05\xaa\x0a\x5d\x6d\x94\xac\x84\x83\x0e\x67\xc1\x56\x44\xa5\x3a\xda\x8e\xa4\x36\x4e\x53\xbe\x27\x33\x51\xc9\xf4\xe5\x26\x1c\x93\x3e\x0e\xa8\x20\xfc\xb6\xf9\xfc\x25\x4b\x69\xf6\x33\x42\xff\x83\x24\x63\x48\xb8\xec\x2e\xf7\x58\x84\x5e\x07\x54\xbf\xa3\xbb\xb2\x38\xf9\x4f\x48\x84\xb4\x9c\x50\x0d\x06\xbe\xf6\x46\x6a\xf2\x13\xb1\x46\x97\x6e\xf7\x6e\x09\x1f\x9f\x7d\x69\xff\xfa\xcf\xf7\xd7\x8f\xbf\x5c\x5c\x83\x61\x3a\xbf\x77\x53\xd6\xc0\xbf\x7e\xee\x5b\x1e\x58\xf9\xfb\x62\xa8\xc6\xa1\x7d\x43\xe6\xc3\xea\x09\x9b\x05\x30\x02\x70\x22\x58\xc8\x6d\xf2\xd8\xee\xb9\xe4\xac\x3d\x1c\x1c\x19\x21\xc3\x7f\x8c\x97\x1e\xdd\x12\x5b\x32\xde\x19\x16\x0b\x17\xbf\x7f\xdb\xda\x16\x45\x6d\x3d\x45\x4e\x08\x3a\xad\x20\x68\x8b\x98\x6c\x37\x14\xca\x68\x00\x91\x19\x4f\xb7\x65\xfe\x9c\x45\xf9\x3f\x88\x70\xce\xb8\x68\x35\x02\x9d\x31\xdd\x3a\x88\x36\x73\x40\x0f\x4e\x07\x83\x12\xae\xae\x61\xf2\xb5\x72\x87\xf9\x4e\xb2\x2b\x24\xf8\x07\x52\x1d\x21\x4e\xb0\x53\x70\x21\xa5\xee\x46\xe3\x90\xfb\x80\xe4\xc8\xd9\x6b\xeb\x9f\x1d\x9e\xf5\xcf\x9e\x9b\xf5\x1b\x08\xda\xa9\xf5\x97\xf0\x74\x5b\xe6\xcf\x5b\xb6\xfe\x1c\xae\x4d\x59\xff\x6c\xbd\xd6\x3f\xdb\x67\xeb\x3f\x3e\x3c\xeb\x3f\x7e\x6e\xd6\x6f\x22\x68\x97\xd6\x5f\xc6\xd3\x6d\x99\x3f\x6f\xd7\xfa\xf3\xb8\x36\x64\xfd\xc7\xeb\xb5\xfe\xe3\xbd\xb6\xfe\x93\xa1\x77\x70\xe6\x6f\xc0\x7c\xe0\xf6\x6f\xa4\x68\x97\x0e\xc0\x00\xa8\xdb\x32\x7f\xde\xae\x07\x28\x00\xdb\x90\x0b\xc8\xf5\xb2\x06\x1f\x70\x32\xac\x8a\x7e\x74\x5a\x55\xa6\xb5\x4d\x27\xe0\x1c\x9e\x0f\x70\x9e\x9b\x0b\x30\x10\xb4\x53\x0f\x50\xc2\xd3\x6d\x99\x3f\x6f\xd9\x01\xe4\x70\x6d\xca\xfe\x9d\xf5\x9a\xff\x5e\x2f\xff\x4f\xbd\x83\xb3\xfe\x53\xef\x99\x59\xbf\x89\xa0\x5d\x5a\x7f\x19\x4f\xb7\x65\xfe\xbc\x5d\xeb\xcf\xe3\xda\x90\xf5\x9f\x7a\x6b\xb5\xfe\xd3\xbd\x1e\xfb\x5f\xcf\x0e\xce\xfa\x5f\xcf\x9e\x99\xf5\x9b\x08\xda\xa5\xf5\x97\xf1\x74\x5b\xe6\xcf\xdb\xb5\xfe\x3c\xae\x0d\x59\xff\xeb\xd9\x5a\xad\xff\xf5\xfe\x2d\xff\xaf\x2e\xdf\x5f\x3f\x5e\x7d\xb8\x7e\xbc\xfa\xf1\xfa\xfc\x1f\x8f\x3f\x5d\xfc\x7a\x71\x7d\x61\xdc\xf1\xe9\x2f\x0d\xa0\x5e\x05\xcd\x08\x94\x45\x98\xf6\xf6\xaa\x34\x6e\x59\x69\x57\x74\x9f\xd3\xb5\x3c\x86\x75\xea\x9a\xb9\xf7\x7c\x8f\x66\xc5\xbb\xe3\x54\x92\xc6\x9a\xb7\x87\x9b\x4e\x95\xa4\xcf\xf6\x45\xf3\x0a\x40\x76\xa2\x79\x39\x0c\x5b\xd1\xbc\xd9\x9a\x35\xef\x70\x7c\xde\xf1\xbe\x68\x5e\x11\xc8\x2e\x34\x2f\x8f\x61\x1b\x9a\x77\xbc\x66\xcd\xdb\xc3\x60\x7b\x15\xe9\x85\x48\xe6\x0e\x55\xaf\x84\x64\x17\xba\x57\x00\xb1\x0d\xe5\xcb\x75\xb9\x0e\xed\xdb\xc7\x30\x6f\x25\xf1\xce\xbe\x68\x5f\x01\xc8\x4e\x94\x2f\x87\x61\x2b\xba\xe7\xac\x59\xf5\x0e\x67\xb2\x77\xea\xed\x89\xe6\x15\x81\xec\x42\xf3\xf2\x18\xb6\xa1\x79\xa7\xde\x7a\x35\x6f\x0f\xc3\x5b\x55\xa4\xbf\x9e\xed\x89\xe6\x15\x81\xec\x42\xf3\xf2\x18\xb6\xa1\x79\xaf\x67\xeb\xd5\xbc\xca\xd0\x0a\xa4\x94\x8f\xef\x51\x07\x16\xf4\xbd\x24\x84\xd8\x45\xab\x46\x86\x4e\xbd\x25\x22\x43\x80\xc1\x4a\x30\x8c\x2a\x3a\x1f\xd5\x1a\xd2\x3a\xc9\x59\xaf\x57\x58\x03\x71\x33\x2a\x24\x9b\x72\xec\x59\xff\x09\xb1\x2f\xa9\x4b\x3a\xc3\xc1\xdf\xfe\xd6\xd3\x54\xbb\xa4\x87\xe6\x13\xbd\xa0\x7d\x16\xa5\xda\x45\x3f\xa0\x61\x05\xf9\x09\xae\x11\x6a\x03\xb2\x76\x03\x99\x47\x01\x55\x4d\xd1\x68\x3e\xde\x51\x99\x0f\x7b\xc5\xb0\x5a\xbd\x59\x81\x7b\x46\xad\xda\x24\xfb\x6a\x9c\x5a\x89\x25\x70\x08\x51\x84\xe3\x84\x99\xea\x1c\xc9\x34\x62\xc9\x57\xb1\x0e\xfd\xa6\xd8\xa1\xfe\xaa\xef\xd1\xec\xf6\xfc\xf2\xd7\x5f\x2f\xce\xaf\xff\x79\xf9\xf6\xf1\xea\xdd\xe5\xff\xfb\xf7\xe3\xf9\xe5\xdb\xb7\x17\xe7\x5a\xdd\x92\x03\x2f\x9d\xe4\xd4\x49\x0f\x05\xcc\x59\xd0\x07\x97\xe0\x46\x63\xe2\x0e\x00\x17\x04\xd7\x44\x5e\x1e\xc1\xfe\x52\x3e\x61\x55\x7d\xde\x26\x7b\xba\x8b\xd9\xc4\x56\xf4\xdf\xcc\xdb\x67\xca\xda\xdd\x73\xf6\xf4\x79\x72\xf6\x74\x63\x9c\xed\x23\xea\x4b\xc2\x6f\xb1\x3b\x42\x27\x91\x83\x30\x1d\xaf\xeb\xe3\xe8\x60\x36\x75\xa9\xbc\x6f\x7a\xd6\xae\x78\xd6\xbb\xb8\x2a\x51\x83\x51\xf5\xda\x84\xfa\x36\x27\x58\x34\x12\x64\xe4\x8b\xe7\x0c\x9e\x27\x43\x67\xfe\x82\x64\x91\x5e\x63\xf5\x69\xb6\x08\x29\xf5\xde\x45\xdf\xd6\x30\x07\xe6\xb6\x1b\xe0\x4d\x66\x26\x36\x97\x1d\x79\x48\xe5\xef\xab\x32\xac\xb8\x33\x5e\x91\x7f\x60\xc0\xb9\x81\x04\x84\xf5\x91\x61\x4e\x3a\x30\x52\xb1\x61\x20\xa5\x4c\x03\x03\x88\x6a\x8d\xac\x48\x30\x80\x45\x88\xc1\xdd\x44\x6b\x8f\x04\xfc\xc9\xd0\x79\xc8\x2e\x7f\x11\xe3\xa9\x74\xba\xad\x72\xe7\x2f\x5b\xcb\xf4\x51\xe5\x3c\x41\xd9\xe1\x16\x15\xb7\xe0\x36\x4b\x4d\x8e\xb2\x7e\xed\x64\xe8\x34\xf4\x64\x8b\xca\xa9\x36\x7c\xa2\xc5\x66\x90\x4f\xbf\x55\x65\x7e\x15\x41\x9d\xce\x7a\x34\x6a\x1e\xd4\x25\x2c\xb6\x64\xaf\x66\x6b\xed\xb6\xaa\x0d\x64\xb3\xc4\x34\xb6\xdb\xad\x82\x9a\x6f\xc3\xdd\x96\x19\xda\xaa\xf6\xab\x64\xde\x86\x71\xa8\xdd\xdb\x82\x2d\x67\xfb\x7b\xaa\x37\x6c\x43\x0c\x60\x5d\x96\xdd\xc8\xc8\x56\x1a\x81\x0f\x65\x76\xb2\x51\xad\x52\x53\xbf\x6d\xaa\x55\xd4\xe1\xd3\xe2\x31\xb7\x65\x15\x2b\x09\x0f\x01\x89\x3d\xd5\x7e\x17\xd5\x89\xaf\x26\x8c\xa8\xdc\xae\x66\xd6\xf1\x60\x50\x94\x57\x82\x15\x4a\x58\x50\xa5\x09\x63\xb6\x80\xfb\x97\x8b\x83\x84\x7d\x75\x79\x98\xec\xbe\xfa\x70\x98\xb0\xc1\x0f\x1e\x22\xf0\xc4\x87\x1e\x1e\xf2\x9c\x43\x39\x39\x4c\x87\x72\x72\xa0\x0e\xe5\xe4\x30\x1d\xca\xc9\xa1\x3a\x94\x93\x83\x75\x28\x27\x07\xea\x50\x5e\x1d\xa6\x43\x79\x75\xa0\x0e\xe5\xd5\x61\x3a\x94\x57\x87\xea\x50\x5e\x1d\xac\x43\x79\x75\xa0\x0e\xe5\xf4\x70\x70\xff\x72\x71\x90\xb0\xaf\x2e\x0f\x93\xdd\x57\x1f\x0e\x13\x76\xce\xa1\x9c\x1e\xac\x43\xd9\x22\xf2\x2e\xea\x2c\xd4\xe4\x43\x31\xa0\xba\x78\x4c\xb1\x69\x88\x69\x83\xe8\x2b\x82\x85\x8b\x87\xb1\x16\xa0\x45\x5f\xb0\xfa\x9d\x68\xba\xd3\xab\xf7\xdc\x6d\xe6\x4b\x4c\xfd\xe8\x22\x70\x2b\x14\x78\x6a\xbc\x7d\x1a\x36\x97\x5d\x22\xdb\x3d\xe4\x11\xc9\xa9\x2d\xac\x00\xcb\xd9\x59\xfb\x65\xfc\xf5\xa5\x8d\x9d\x5b\x2a\x18\x5c\x2a\x4b\x3d\x3c\x85\xdc\x91\x76\x0f\xe9\xd6\xbf\x3a\x6b\x5f\x5d\xfe\xa4\x77\xd6\xc1\x9b\xe8\xe0\x7d\xb7\x40\xb9\xfe\x61\x34\x17\x1c\x5c\x1a\x6f\x01\x1d\xf3\x24\x1a\xc5\xe4\x7b\x69\xdb\x2a\x01\x25\x03\xd0\x70\xa5\xee\x2e\x58\xa3\x31\x74\xd1\x0b\xc4\xfc\x4a\xe4\xdd\xe8\xf5\x00\x96\x4b\x26\x52\x5d\x3d\xdd\x45\x92\x05\x37\x75\xc4\xe6\x29\x3c\xea\x21\x0f\x7f\x46\xe3\xfb\xaa\xe2\x3d\x75\xed\x7f\x17\x75\x40\xf4\x56\xc0\x1c\x8b\xfa\x13\xf6\x00\x0f\x81\x80\xa7\x72\xc0\x57\xcb\x0f\x1c\x89\x6e\x4c\x55\xd5\xc4\xae\x2e\xd2\xb4\x81\xf8\xae\x77\xb8\x87\x18\xae\x1c\x16\x24\xbe\x2e\x7a\x05\xb1\xa4\xd7\xcc\x47\xdc\x6f\xca\xf4\x52\xc1\xce\x51\x4f\x37\x85\x12\x4e\x6f\x99\xc1\x55\xfc\x69\xca\x58\x2e\xfe\x64\x65\x9e\x95\x5c\x34\x66\x9e\x8d\xed\x19\xf9\x93\x7d\x39\xf6\x29\x9e\x34\x65\xa0\xb8\xc3\xc1\x9f\xfc\xcb\xf1\x0f\x58\x52\xc1\x3e\x18\xcf\x4b\xe5\xa3\x21\x6b\x55\x9f\x68\x18\xaa\x96\x18\xc0\xcb\x90\x6a\x5e\xf8\x92\x0c\xdb\x69\x0f\xb9\xb1\xcb\x50\x20\x11\x56\xae\x54\x2a\xb0\x62\xc9\x0c\x4d\xa6\x4a\xf0\x4f\xcb\x32\xa5\x21\x49\x9d\x48\x26\x63\x22\x61\x74\x81\xc5\x7d\x78\x05\x08\xe9\xc7\x4c\xcd\xa8\x62\xf2\x2f\x1a\xdc\xeb\x74\xb2\x5b\x01\x7c\x2e\x5a\xe8\x3a\x14\x56\x30\xc3\x82\x3c\xa8\xbf\x30\x29\x25\x3e\xbc\x14\xe2\xf1\x5d\xe8\xfb\xd4\x9f\xb6\x9f\xd0\xd9\x19\x3a\x2a\xc3\x6a\x99\xbf\x55\xcb\x77\x61\x26\x3d\x07\xa1\xc3\x6c\x10\x5e\x22\xb5\x4b\x89\xef\xb3\xbc\x35\x7f\x6a\x84\x9d\xd0\x6a\x9a\x7c\xc2\x84\x0e\xde\x4f\x64\x20\x5f\x2d\x9f\x2c\x4e\x02\x17\xdb\x24\xcf\x97\x9a\x9f\x32\xec\x62\x77\x3e\xe1\x95\x72\xeb\x21\xf5\xbb\x05\x2f\x05\x3b\x6b\xbf\x23\x81\x4b\x6d\xfc\x9e\xc8\xf6\x53\x76\x0c\x80\x7f\x6d\xe8\x88\xda\x58\x28\x6f\xda\xfe\xcb\x11\xfc\x8d\x2a\x83\xf8\xe0\x5b\x67\xf0\xa2\xdb\xce\x73\x38\x32\xfb\xb4\x6a\x86\xec\x9c\x2e\xa4\x0d\xa5\xe3\x52\x45\xad\x22\xa5\x7a\x5e\x8f\xcc\x35\x12\x12\xa1\x89\x72\xed\x98\x53\x69\xcd\x39\x0c\x2b\x2a\x7a\xaa\x43\xf0\xaf\x9b\xe7\x5a\x3b\x11\x6c\x53\x8e\x75\x5b\xc5\x4f\xc5\xe5\x73\xd2\xa2\x25\xef\x03\x32\x42\x0e\x09\x5c\x76\xef\x11\x5f\x56\x69\xb0\xa5\x6b\x04\xcc\x49\xd5\x59\xe1\x18\x71\xa2\xda\xdf\x9a\xca\x2e\xa5\x95\x3f\x61\xe2\x31\xdf\xa0\x94\x5b\x60\xaf\xea\x5a\x90\x67\xcc\xdd\xf7\xf0\xdb\x24\x74\x77\xc2\x5f\x11\x77\xbe\x3a\x87\x73\xaf\xdc\x11\xf6\x8c\x38\xa1\xdb\xfc\x95\x3b\xf3\x8f\x17\xe9\x26\x2d\x72\x4c\xac\xf8\x1b\x2c\xc2\xab\x72\xda\x94\x03\xd1\xb5\xd6\x71\xc2\xa2\xc1\xb1\xa0\xc6\x28\x57\x3c\xc3\x52\xc1\x9a\x4c\x87\xd8\x9d\x32\x4e\xe5\xcc\xdb\x57\x06\x35\xc2\xba\x19\x36\x8d\xa9\x9a\xac\xec\x2b\x67\xaa\xe0\xad\xca\x8c\x3d\xb3\xa6\xfd\x31\x26\x23\x63\x32\xfd\xed\xc4\x96\x16\x60\x4f\x23\xa8\x1b\x61\xd2\x0e\x2c\x69\xf7\x86\x94\x3d\x40\xd8\x58\x47\x37\xc7\x91\xf9\x07\xff\x1a\x83\xdc\x08\x63\x32\xfd\xed\xc2\x90\x16\x61\x4f\x23\xa8\x1b\x61\xd2\xf6\x0d\xe9\x74\x63\x86\x94\x4c\x05\x21\x1a\xb9\xc8\xee\xa0\x47\xfd\xca\x78\x66\x61\xa6\x0e\xc1\xcf\x6e\x01\xff\x37\xe9\xe4\x14\x2a\x5b\xd0\x7d\x74\x1d\xd2\xe8\x9b\x8a\x5e\x17\x89\xc0\x22\xb5\x69\x90\x5b\x25\x94\xd6\x06\xc5\xd5\x41\x9e\x9a\xca\xf5\x80\x26\xb3\x87\xda\x01\xcb\xcc\xf6\xe3\x2f\x6a\x9a\x9f\x90\x8b\x50\x99\xf2\x72\x50\x37\xcf\x8b\x4a\x06\x28\xf6\x18\x78\x8b\x3a\x3a\xf4\xa5\x88\x2e\xbd\xc3\xbb\xf9\x3b\xaf\x17\x0d\x8b\x17\x3b\xa9\x23\xab\x9a\x25\x80\x21\x7e\x6f\xe8\xdc\x97\x7d\x83\xee\x15\x7b\x8d\x23\x88\x0d\x5f\x37\x9d\x3f\x68\x9a\x6d\x2c\xdf\xdc\xdf\xc3\xc9\x84\x70\x51\xd7\xd2\xb7\x95\x95\xcf\x61\x2f\xc3\x59\xae\xee\x1b\xe2\xfd\xcc\x09\x59\xae\xf2\x7b\x17\x8f\x6b\x6a\x66\xea\xa5\xc2\xeb\x66\x95\xaa\x28\x9c\x51\x3d\x8b\x63\x51\x65\x57\x94\x2e\x91\xeb\x5b\x4a\xc6\x0d\x5a\x81\x4b\xa6\x16\x27\x2e\xad\x3e\x19\x15\xbb\x59\xa0\x25\x75\xb1\x2e\x89\x63\xab\xc9\xa3\xb2\x12\x27\xa0\x2d\xad\xc2\xcd\xb6\x43\xda\x4f\x8d\x7c\x77\x79\x39\xa1\xfa\xd1\x65\x9a\x50\xb8\xc6\xf9\xe0\x33\x60\xe8\xce\xf9\x99\x9d\x16\x1c\x3e\x3f\x4f\x37\xc5\xcf\xac\x53\xe8\x07\x9c\x79\x44\xce\x48\x28\xfa\x40\x46\x3f\x12\x1e\xf5\xa7\xf5\xbe\x42\xf3\xb9\x62\x10\xf3\xa2\xe1\x98\x3a\x2e\x69\xf7\x92\x2f\xec\x0e\x53\x09\x29\x8f\xca\x21\xfc\xfd\xdf\x9a\xee\x94\xc9\x05\x92\x93\xe7\xa3\xa4\x1b\x75\xa5\x58\x69\x1c\xca\xa3\x49\xde\x72\x6d\x78\x9d\xbb\xee\xba\x69\x97\xc6\xb6\x16\x01\x61\x7a\xfd\xfa\xd2\x28\xf2\x8d\x35\x81\xb1\xa0\x64\x22\x4b\xf8\xed\x9f\xd7\xff\xb8\xfc\x70\x1d\x43\x42\xa8\x63\x07\x61\x0f\x41\xc5\x2e\x7a\x89\x2e\xdf\xa6\xa8\xd1\x2f\xef\x2e\x3f\x5c\x59\xbf\x5e\xfc\x7c\xdd\xe9\x46\x33\x21\x98\xf5\x54\xf4\x9d\x10\x89\x72\xa4\x47\x33\xa3\x45\xd8\x11\xeb\x01\x65\x6b\x26\xbc\xd0\x69\x3c\xf2\xa6\x7d\x26\x39\x4e\x85\x1b\x9e\x8a\xe5\xf2\xfd\x2a\x29\x9d\x7a\xe8\xe5\x6a\xec\x99\x87\x2d\xe1\x47\x95\x71\x4f\x89\x4f\x38\x76\xeb\xad\x5a\x21\x34\x2f\x88\xf4\xa4\x36\x0c\xd4\x36\x74\x09\x10\x54\x1d\x85\xc1\xd1\x92\x0d\x0e\xab\x1a\x1c\x16\x88\xe2\x3e\x91\x64\x4e\xc6\xe4\xdc\xbc\x0a\xfd\x23\xe4\x3b\x06\xcc\x89\x17\x48\xa0\x82\x11\xb4\x64\xc2\x5c\x44\x05\xf3\xe7\xba\x96\x8d\xe6\x98\x56\x10\x01\xb1\x23\x15\x99\x61\xbe\x3e\x1c\x85\x66\xab\x7d\xc2\xdc\x3c\x3e\x33\xa2\x9c\x79\xa0\x85\xe1\xe9\xbe\xe6\x70\x67\x22\xb2\xac\x7c\x58\x9e\x1f\xf9\x86\xe6\xf4\x5a\xa7\x1e\x99\xfe\x2b\xbb\xd6\x3f\x8c\xea\xda\x5c\x56\x31\x96\x45\xb0\x09\x95\x88\xd3\x81\x7b\xd9\x07\x46\xe5\x58\x08\x69\x53\xed\xa8\x93\x53\xd9\x7c\x62\x07\xd9\x2d\x5c\x67\xe0\x61\x7b\x46\x7d\xbd\x70\x52\xa2\xc9\x57\x28\x20\x8e\x1f\x8f\xb2\xbd\x57\x0c\x3e\x95\xcc\xaf\xc2\xf9\x12\x1d\x0d\x87\x43\xf4\x32\x87\x4c\xe7\x61\x54\x21\xa9\xec\xa6\x02\xd6\x1a\x0d\x7f\x69\xa4\xa9\x8c\xcb\x18\x21\x34\x94\x8c\x10\xc4\x77\x02\x46\x7d\xd9\xd3\x23\x7e\x0f\xfd\xc1\xc6\xf1\x80\x01\x49\xf5\xd4\xd6\x29\x7b\x30\x20\x59\xd1\x1a\x0f\x61\x3f\x39\x55\x80\x20\x59\x23\x9d\x9f\x47\xab\x47\xce\x5c\xf2\x00\x7f\xce\xda\x1e\x06\x6c\xd5\x73\x70\xf5\x3d\xad\x65\xa5\x63\x8e\x45\x19\xa0\x85\xea\xcd\x4a\x5b\x51\xe1\x11\x6a\x4b\x1e\x92\x76\x05\x97\xa2\x42\xaa\x0d\xb1\x4b\xc6\x50\x7f\xc2\xf1\x92\x7c\x81\x50\x12\xc7\x73\x08\x55\x65\xd6\x44\x67\x25\xf3\xe0\x3f\xec\x3b\x29\xad\x86\xde\xd7\x47\xe2\x3a\x15\xa0\x8a\x3d\xd5\xc5\xe0\x2a\x17\x15\x0e\xcc\x11\x1a\x97\x2b\x97\xd0\x98\x93\x92\x95\x4d\x35\x96\x88\x6e\xb2\x5a\x03\xbb\x15\x54\xab\x6e\x15\xcf\xf2\xe4\x16\xeb\xe7\xa5\xa9\xa6\xcf\xf9\x00\xb1\x9e\x4c\x43\x94\xb6\x87\x54\x50\xae\x9d\xc6\x9e\xe3\x30\x70\x02\x3e\x8d\x05\xc7\xe9\xb0\x2a\x36\x1b\x60\xfb\x06\x4f\x61\xae\xcd\x38\xe9\xc2\x7c\xf4\xb8\x81\x92\xcc\xee\x03\xc2\xe5\x0c\xae\x49\xb2\x88\x0f\xf1\x4d\x67\x8e\xa8\x0d\x35\x4c\x12\xd7\x0b\x85\x5b\xca\x21\x84\x81\xe5\x84\x71\xaf\x18\xa2\x80\xf4\x9e\x1e\x12\xf7\x42\x12\xcf\xf2\xb0\x1f\x4e\xb0\x2d\x43\x4e\xb8\x7e\x18\x70\xe6\x84\xb6\x54\xb1\x89\x94\x23\x63\x2c\xc8\x98\x61\xee\x14\x2a\xa5\xcf\xb3\xf5\x62\x3e\x55\x77\xa6\xdb\x35\x75\xba\x68\x67\x15\x7c\xcb\xf1\x21\xe2\x58\x69\xb6\x90\x06\x87\xa2\xb8\x7b\x27\x92\xd4\x98\x48\x5c\xb2\xe4\x88\x85\x2a\x81\xa7\x57\x6f\xc2\xf9\x90\x7d\x3e\x2c\x6d\xb2\xb8\x5c\x81\x17\xa9\xe2\x1a\x72\x46\x4b\xb1\xfa\x62\xfb\x71\x36\x14\x14\x4b\x32\x46\x6d\x1c\x60\x9b\xca\xfb\x74\xf0\xcd\xd5\xe8\xb6\xcc\x9f\x19\x4f\x91\xe4\xfb\x58\xdc\xf9\x36\x04\xac\xb8\x2a\x5a\xa6\x74\xda\x9d\x33\xa4\x4a\xcd\xca\x8d\xd5\x68\x99\xed\x62\x2f\xb0\x3c\xfc\xb9\xd3\xaa\xc2\x9b\x77\x54\xa8\x83\x3a\x30\x81\x42\x79\x97\x55\x34\xeb\x82\x2f\xea\x22\xf4\x03\x3a\x42\xdd\x1e\x6a\xd7\xbb\x1e\xf0\x6c\x6a\x6c\x32\x79\xbb\x1c\x23\x62\x7d\x30\x74\xda\xdd\x00\xfc\xef\xcf\x1a\xc3\x9f\x60\x57\x34\xc0\xdf\xed\x65\xd2\xa2\x2b\x65\x19\x8b\x30\xdb\x17\xf5\xa7\xf3\x76\x31\x41\x03\xe1\xf0\x92\x69\x9c\x4a\x4e\xf7\x15\xd5\x34\xb2\x6d\x4e\xa7\x33\xd9\x41\x6b\xf1\x3a\xb9\x9f\x59\x40\x7c\x31\xa3\x13\x09\xad\x30\x61\x51\x27\xf9\x39\xdf\x01\xe6\xf6\x2c\xf5\xc6\x85\xff\x35\x99\xa7\xf4\xe6\x94\x52\xf3\x85\x6e\xab\x42\x3d\xe6\x30\x5e\x8f\xcc\x95\x23\xf2\x7c\x51\x16\x1d\x4b\x22\x47\x15\x66\xea\xd4\x15\x8f\x8c\xac\xa1\x6c\x0a\x14\x56\x69\x6c\x73\x2f\x62\xaa\xbd\xad\xf1\xab\x65\x72\x4d\xfb\x33\x8e\x65\x17\xe2\xb9\x4a\xdd\x96\xf9\x73\x66\x28\x6b\x55\x75\xb8\xd5\x41\x6d\x4f\x39\x35\x57\x3b\xb3\xed\x95\x94\x11\xa6\xd9\x70\x67\xe7\xbc\x80\x7a\x1c\x4f\x7f\xfa\x78\xec\xfd\x9e\x0c\xef\x49\x8c\x27\xf5\x45\xc6\x24\x85\xf9\xa9\x09\x10\x01\x3c\xfb\x92\xdf\x2b\x1f\x7c\xdb\x7e\xaa\x24\x4d\x87\x36\xcc\x63\xb7\x0e\xbd\xc7\xa4\xbf\x21\xde\x35\xc4\x3e\x6a\x36\xe6\x51\x1f\xd5\x6f\xb4\x1b\x6b\x55\x01\x2c\x87\xb0\x56\x89\xcb\x69\xd6\x7d\xf5\xa5\xad\xc7\x88\xfe\xe0\x5b\x1d\xbe\x49\x03\x75\xa5\x58\x4e\x82\x2c\x49\x4c\x9f\xcb\xbb\x1a\x1e\xa3\xfe\xfc\x66\xd2\x1e\x35\xd2\xb9\x5d\x1a\xc3\x7f\xe5\x73\xe3\x0b\xb2\xa1\x92\x03\x0d\x84\x53\x2f\x47\xd4\x6f\xd4\x98\x89\x15\x0d\xfa\x56\xdc\x30\x38\xea\xdc\xdc\xbe\xe8\xa9\x50\x14\xdb\x5c\x61\xdc\xab\x1e\x51\x12\x2a\x12\xa6\xa8\xe2\xb9\x06\xe3\xcc\x31\x03\x25\x48\x0d\xc7\x01\x67\xb7\x54\x50\x16\x1d\xed\x53\xd8\x03\xc2\x05\x15\x92\xf8\xf2\x96\xb9\xa1\x47\x6c\x17\x53\xcf\x70\x5a\x4d\x48\xc6\x35\xaf\x2a\xb3\xa3\x8c\xad\x25\x9e\x28\x9a\xaf\xd5\x77\x0c\x21\x85\x42\xeb\x71\xcf\xb6\x8b\x85\xe8\x26\x73\x3f\xe5\xe5\x73\xe4\x28\x6a\xb2\x65\x95\x4b\xeb\x56\x31\x6f\x79\xe2\x47\x99\x6e\x17\x61\x35\x24\x2e\x44\xa4\xaa\xa5\x14\xec\x98\x24\x99\x51\x0d\xf9\xd8\x32\xcd\x7f\xe7\xf3\x73\x21\x3e\xea\x3e\x96\xe0\x67\x0d\x85\xf3\x98\xd6\x21\xd2\x76\x2c\x36\xfe\x83\xd8\x32\xd2\x62\xd1\x70\x83\xba\x5c\xb1\xd4\x3e\x2c\x19\xcb\xc5\x1e\x12\x39\x9f\x7d\x69\x8f\x43\xea\x3a\xe2\xd3\xa7\x81\xfa\xf0\xe9\xd3\x40\x7b\x8a\x4f\x9f\x06\x94\x3d\xa6\x27\xe6\x6c\xe6\x4f\xe8\x14\x8a\xe2\x20\x10\xe5\x92\xea\x64\x3d\x3c\x57\x1f\xca\xbf\x67\x0e\x2f\x25\x8d\x64\x5a\xd7\x8f\x6c\xce\xfc\x3f\xd8\x18\xbe\x8f\xb1\xb4\x67\x8f\xb9\x2f\x01\x73\xc4\xa3\x51\xe0\xe5\xc7\xe2\x31\x9e\x72\x89\x47\xad\x5f\xe2\x91\xb3\x50\x12\x68\x5d\x7d\x30\x90\xe1\x4f\x39\x11\x42\x15\x89\x93\x21\xa8\x3f\xfd\xf4\x69\x70\xf3\x1d\x3c\xa2\xec\x71\xc6\x38\xfd\x2f\x0c\x77\x6e\xc0\x1c\x1c\x4a\x26\x6c\xec\x12\x0e\xbf\x26\xdf\xe0\x6c\x6d\x28\xa2\x67\xf0\xff\xe5\x6e\xe2\x9d\x8d\x98\x19\xf1\xb7\x72\xb1\x44\x52\xff\x09\x99\xc4\x99\x74\x1e\x14\x1f\xe0\x8c\x5f\x52\x56\xa1\x9a\xf1\x3e\x48\x5c\xaa\xac\x1e\xf1\x62\x06\xfe\x26\xb6\xc4\x1c\xf0\x02\x12\x43\x10\x8c\xb9\xd4\xbe\x7f\x00\x7f\xfd\xd5\x59\xfb\x47\xf7\x0e\xdf\x43\xf6\x6b\xd5\x18\x58\xc0\xa6\xcb\xf5\xb2\x39\xce\x66\x84\x20\xd5\x91\x24\xdc\xa3\x3e\x76\x47\x7a\x64\x2b\xc2\x85\x71\x09\x34\x3a\xb1\x38\x3d\xd8\x0a\x2b\x20\x3c\x3e\x76\x1c\xfa\xd2\x12\x61\x21\x82\x39\xa7\xff\xb4\xa1\x2a\x16\x35\x5c\xef\xe5\x87\x3c\xb5\x4a\xce\x8e\x70\x86\xb5\x99\x71\x24\x34\x2c\xc3\x6b\xc7\xc9\x64\x51\xbd\xc0\x9a\x3a\x61\x44\x5a\x98\x09\xb8\xcb\x0a\x1c\x95\x15\xab\x63\x44\x6e\x32\x89\x37\xcf\x9c\x22\x15\x02\xa9\xcc\xe7\x11\xf4\x95\x89\xda\xac\x30\x55\x30\x31\x12\xd5\x52\xdf\x8d\x73\x5c\xb7\xdc\x6b\x81\xdb\xa5\x95\x11\x30\x4b\x30\xfb\x86\xc8\x66\x2b\x77\xc5\x6e\xb4\x0a\xbf\xeb\x02\x39\x0b\xd0\xba\x05\x15\x45\x68\x45\xd3\xda\x7b\x93\x8a\x25\x5f\x96\xb2\x8e\xf7\x82\xcb\x83\xc1\x45\x7a\xd8\xc7\x53\x15\x31\x91\x74\x42\x6d\xd8\xda\xf6\xe3\xd5\x59\xb7\x77\x54\xa5\x67\xb9\xaa\x30\xe0\xc1\x7a\x26\x66\xcf\xc8\xc3\x9f\xe3\x5e\x55\xb1\x11\x3a\x8f\x34\xea\x0d\xf3\xa9\x64\x9c\xfa\xd3\xcb\x80\x70\x2c\x19\x7f\x07\xdb\xf7\x36\x75\xa9\xea\xf6\x22\xfb\xb6\x08\xec\xfb\x4c\xaa\xc7\x99\x8d\x2b\x8f\x08\xe5\x57\x93\x16\x51\xda\x24\x4a\xda\x44\x54\x00\xb5\x84\x53\xe2\xdb\xd4\x9f\x22\x9e\xeb\x24\x7a\x27\x85\x6e\x31\xba\x71\x0d\xb1\x09\x7a\x78\x40\x01\xa7\xbe\x9c\xa0\xf6\xd7\xc3\xc1\x70\xd2\x46\x7f\xb9\xc5\x6e\x48\xd0\xd3\xd3\xd7\x83\xb8\x7c\x64\x2b\x50\x23\xb1\x12\xcb\xd3\x08\x2c\x16\x23\xb0\x92\x1e\x89\xa5\x3a\x8b\xd9\xf9\xf1\x08\x52\x90\xd0\x0b\xc8\x98\xc8\xa4\x74\x2c\xd0\x1a\x96\x92\x78\x81\xcc\xb7\xf7\x03\x3a\x4a\xde\xf0\x39\x61\x7c\x84\x92\x57\xdd\x97\xb7\xfd\x04\xb9\x25\x9c\xca\xfb\x11\xba\xc3\xdc\x4f\x03\xc9\xf3\xa4\x74\x4e\x78\xac\x1b\xe4\xe2\x73\x40\x55\xfa\x83\xdf\x4c\x4c\x69\x4d\xe0\xef\x5f\x22\x44\x03\x11\xaa\x59\x23\x7a\x7a\x42\xd4\x47\x37\xe4\x3e\xfb\x23\x7c\x7d\x7a\x8a\x25\xa2\x1f\xd2\x4c\x0e\x00\x82\x8a\x99\x1f\xf5\x8c\x00\x3d\x3d\xbd\x2c\x3c\x87\xa2\x04\x40\x13\x01\x7d\xc1\xaf\x91\x54\x1f\xd1\x2c\xf4\xb0\x4f\xff\x4b\x7e\x8a\x33\x8e\x73\xed\xe7\x25\x5e\x27\x1e\x3b\xa5\xd1\x22\x9a\x3d\x96\xa4\x1e\x4c\x77\xbc\x20\x89\x78\x3c\xd8\xf8\x2c\xde\x1d\x48\xcf\xc2\xf4\x11\x94\xeb\x74\xd1\xf7\xe8\xaf\xe8\x05\x3a\x7e\x85\x5e\xa0\x93\xd7\xc3\x9c\x40\x8f\x66\x7f\xca\xf3\x00\xe5\x69\x96\x65\x03\xe3\xb4\x39\x95\xd4\xc6\x6e\x5e\x9a\x3f\x66\x3c\xee\xbb\x28\x2b\x9c\x8b\xb7\x4c\x9e\xab\xd5\x53\xc8\x89\xd3\x48\x82\xaa\x19\x81\x30\x27\xc8\x67\x12\xd9\xba\x36\x92\x0c\x8d\x09\x12\xc4\x97\xf0\x11\xa3\xec\x80\x10\x6f\x9a\xc3\x71\x15\xac\x15\x2d\xde\x75\x9a\x61\x89\xee\x59\x88\x3c\x7c\x0f\x75\xa0\x91\xa8\x2a\x71\xc0\xe2\xb0\xe2\x88\x7b\x8f\x26\x58\xcc\xa0\xa9\xbb\x19\xf1\x11\xf5\x20\x14\x8a\x7d\x89\x26\x98\xba\x61\x7e\xcb\x93\xd9\x76\xc8\x07\xe8\x7c\x46\xec\x1b\x24\x67\x04\x5c\xbb\xff\x1e\x96\x03\xc8\x61\x76\x08\x6b\xc6\x08\x95\x64\xc8\x25\x98\xfb\x68\xc6\xee\x00\xb4\xa6\x26\x07\x3e\xdb\x34\x44\xd4\x73\xac\x34\xea\xc5\xdc\xe1\x0d\xb2\x2b\x16\x96\xab\xd1\x48\xdf\x84\xae\xa4\x81\x4b\xce\xf5\x4a\xe1\xf2\xf2\xcd\xbf\xa8\xeb\x36\x94\x67\x52\x3f\xbd\x61\x4a\xa0\x3b\xc2\x09\x62\xa1\x04\x9b\x8b\xa2\x72\xe8\x46\xb5\xa8\x52\x94\xa8\xaf\x78\x1a\x60\x21\xd1\xd1\xa9\x6e\x11\x21\x8f\xfa\xb0\x82\xcd\x73\x24\x5e\x1a\x15\x4e\x47\xea\xce\x92\x83\x92\xc9\xb5\xb0\xe9\x62\x4f\x17\x49\x36\x01\xe2\xd5\x9f\x1e\xbc\x8e\x73\x69\x79\x90\x21\xd3\xa0\x9f\xea\xe6\x5d\x0c\x77\xd4\x46\x4b\x3d\x49\x60\xb1\x89\x05\xf3\x1f\xa2\xff\x3b\x6b\x6b\xae\x66\x97\x93\x2a\x09\x1c\xfd\x80\x12\x2e\x44\xa2\x3c\x9d\x2f\xca\x38\xa6\x96\xe4\x74\xeb\x39\x60\x3f\x5e\xe4\xcf\x4d\xed\xce\xde\xf6\xab\x26\x00\x33\x1c\x70\xf6\xf9\xde\x8a\x2f\xdd\x9d\x49\x19\xc0\x7a\x39\x60\xbe\xd0\x47\x2e\xe2\xe1\xde\x90\x66\xee\x90\x51\xa2\xba\x31\x82\xa4\x05\x15\x6e\x8b\x83\x98\xd0\xd1\xa9\x57\x9a\x14\x26\x70\x26\x9c\xf9\x92\xf8\x4e\x11\x52\xf2\x3c\x3e\xfe\x41\xfd\x0c\x9c\x22\x94\xa4\x6c\x09\x4e\xa9\x91\x35\xa1\x61\xa1\x5c\x03\x1c\x16\x2e\xc2\x9d\x12\x14\x3b\xe4\x9c\x40\x78\x80\x08\x58\xe6\x96\xf2\xc5\xe6\xc3\xb0\x99\xef\x13\x1b\x9c\x5a\xb2\x12\x2e\xeb\x96\x0a\xa4\xcd\x3f\x34\xa0\x2b\x58\xaa\x42\xbc\xd1\xa2\x4f\x75\x45\x6b\x8c\xf4\x75\xbd\xa2\xdd\x8b\x2e\x6f\x6b\xab\xe9\x77\xfb\xa9\xfb\xb2\xb3\x4a\x33\x5f\xda\x3f\x63\xea\x12\xe7\xf1\x9c\x79\x81\x4b\x24\x79\x4c\xda\x2d\x72\x25\x82\xa7\x66\xc8\xc9\xb5\xbb\x65\xa2\xd3\x11\xba\x99\x4d\xfd\xc1\xc6\x69\x24\xa9\x8b\x3a\xe9\xf1\x11\x4b\x0a\x67\x6c\xcd\x60\x11\x2c\x60\x71\x90\xd9\x75\x39\xfb\xd2\x36\xf5\xf8\x98\x3e\x84\x58\x5b\x3f\x89\x1f\x65\x8a\xb4\x9f\x8a\x54\xe9\x3a\xa3\x9a\xae\x2b\xb4\xac\x84\x1e\xd8\x52\x41\x02\x06\xee\x0a\x0b\x07\x01\xf1\x1d\x92\xc8\x67\x2d\x24\x7d\xcc\xba\xe6\xa6\x74\x19\xf1\x54\xd0\x99\x09\xe2\x75\xd1\x42\xfb\x63\x2b\x90\x95\x46\x23\x6a\xf7\xd2\xd2\x1a\xa3\xf9\xc0\xaa\xe4\x18\x6f\xa3\x3a\x56\x9a\x53\xda\xd4\xcb\x3f\xe8\xba\x29\xd1\x46\x9a\x33\xf9\xa1\xc5\xee\xce\xbe\xb4\xb3\x33\x98\xbe\x87\xa9\xff\x38\xe5\x78\x82\x7d\xfc\x98\x8a\xaf\x7f\xf3\x9d\xa8\xd8\x53\xcd\x70\xa1\x01\xe6\x4a\x97\x25\xf8\x9c\x9b\x1f\xf4\x56\xb1\x76\x24\x7a\xcc\xca\x38\x1c\xfd\x63\xfb\xe9\xe3\xd1\x50\x1f\x3c\xb4\x33\x99\x08\x8d\x2e\xaf\xaf\x3f\x6c\xd8\x10\x42\x2f\x09\x8e\x9f\x7d\x69\xab\x1d\x89\xc1\x8b\xf8\x9d\xef\x67\x6d\xf5\xa2\x77\x0d\x32\x86\x86\xea\xc1\x72\x32\xa5\x42\xf2\x7b\x0b\x07\xd4\x30\x60\x1b\xf1\xea\x69\x90\x9e\x5b\x61\xe7\xfe\x01\x36\xd6\x29\x8c\x21\x67\x71\x12\x9d\x51\x83\x88\xb4\x9d\x36\xc4\xce\xcf\xbe\xb4\xe1\xf3\xe0\x45\x6a\x04\x10\xfd\xef\xe8\x66\x8a\x70\x8d\xbd\x8e\xa0\x8d\xb5\x83\x54\x8c\xed\x27\xac\x49\xe0\xe6\x9f\xae\x0a\x5c\xb5\x66\x25\xad\x15\x74\xb8\x7c\x3f\x47\x59\x85\xe3\x29\xfc\xbf\xc2\x31\x51\xb7\xf7\xbd\x89\x0a\xfe\x4a\x85\x5c\x20\x94\x55\xee\xa9\x14\xc1\x52\x63\xa4\x40\x58\x22\xec\x23\xe2\x92\x5b\x2c\x89\x83\x40\x9b\x11\xcd\x2e\x73\xe1\x00\x35\x58\x5f\xb4\x64\x15\x03\x74\x3d\xa3\xaa\x35\x97\xde\x10\xf7\x1e\xd9\x38\x14\x10\x11\xa3\x6a\x89\x17\x2f\xd6\x60\x51\x03\x5f\xc1\x89\x08\x92\x9c\xf7\xce\xb4\x8a\xc7\xb0\x96\xf8\x97\x1e\xf4\x51\xb4\xa7\x28\x20\xb9\x93\x13\x5b\xba\xf7\xb0\x7f\x0f\xe0\x5c\x37\xbf\x78\x48\xd3\xc1\x3a\xda\xca\xe2\x4d\x50\x58\x5d\xc7\x5d\x59\x2e\xcd\xdb\x5a\x99\x23\xca\xe8\x42\x57\x9e\xb5\x15\x2b\x0a\x4e\xab\xfc\xc6\xd7\x15\x7a\x8a\x9b\x4e\xdb\xfe\x01\x0d\x07\xc3\xa3\x45\x57\x08\xe6\x45\x7c\x51\x55\x7e\x83\xbd\xc7\xdd\xe8\xca\x1d\x74\x7d\x80\xca\xa2\x70\x6f\x47\x5b\x9a\x74\xb5\x7e\x75\x49\x3c\x50\x2e\x1f\xab\xd2\xf9\xbc\x65\x0e\xf9\x99\xba\x24\x0a\xd0\xbc\x87\x79\xe3\xcf\xd4\x85\xab\xab\x3e\x04\x75\x2a\xe5\x10\x61\x73\x1a\x80\xe8\x47\x28\x6d\x00\xd2\x1a\x32\x11\x37\x87\xc0\x74\x02\x62\x6e\x58\x66\x9f\x27\x5b\x5a\xe8\x29\x0d\x7d\x21\x34\xc3\x02\x31\xdf\xbd\xcf\x85\xd2\x07\xc7\xb9\x48\x3a\x8a\x5f\x89\xec\x12\x24\x00\x2c\x82\x74\x09\x75\x3e\x86\x0a\x34\x89\x90\x67\x9a\x0c\x83\x44\x49\x94\xa8\x3c\xcc\xef\x73\x70\xa9\x40\x01\x27\x0e\xb5\x41\xc9\x25\x43\x3c\xf4\x93\xc8\x47\xd4\x7c\x26\xe2\xe1\x93\xcf\x12\x22\xac\x33\x16\x72\x51\xa9\x7b\xad\xc2\xe5\x37\x13\xdd\x99\xa5\xa0\x57\xa7\xcc\xf5\x26\x22\xde\xd2\x6e\x3f\xa1\x97\xa5\xda\x82\xfe\x97\x34\xac\x1c\x6d\x11\x7c\x8f\x5e\x25\x31\x26\x94\x3b\x03\x87\x12\x9a\x2d\x97\xfa\x04\xf3\xce\x0a\x40\x3f\xbe\x9e\xfd\xde\x43\xc7\xaf\x5e\xbc\x1e\xbe\x78\x3d\xec\xa2\xef\x51\x55\x9f\xc5\x4e\x60\x10\x05\x69\xcf\xa5\x26\x13\x2b\x4b\x53\x3a\x57\x8a\x6c\x3f\x7b\xa5\x87\x60\xa9\x5c\xb3\xe6\x1f\x92\xe2\x1f\x9d\x56\x28\xe1\xba\x15\x7f\x6f\xf5\xde\x3c\x7f\xc8\x2b\xfe\x8f\xae\xc7\x84\xbc\x0c\xe5\xe5\x44\xd9\xc0\xc1\xa8\x7e\xbd\x66\x43\xb3\x2e\x11\x02\xc9\x19\xf6\xd1\xe9\xd7\x86\x8a\x7b\xad\xbc\x55\xba\xbb\x1b\x45\x6a\xe0\x40\xff\x57\xe8\xd1\xc9\xc1\xe9\xd1\xc9\x5e\xe9\x51\x13\x87\xa4\x3e\x1d\xca\x48\x4c\x41\x04\x62\x73\xf3\xcf\xb8\xfd\x75\x4e\x40\x95\xd0\xad\x09\x27\x64\xae\xac\xcb\x1a\xa8\x2a\x37\x56\xbe\x65\xa7\x9e\xcd\x21\x3e\x83\x99\xe7\x73\xd4\xf7\xd5\xa6\x9e\x15\x4a\x7f\x18\x3a\x7f\xbc\x25\x9d\x7f\x2e\x93\x4e\xa5\x12\x87\xa3\xf5\x8b\xcc\x16\x4e\xbf\x36\xd5\xdc\x53\xbd\x3d\xe4\x09\xe7\x33\xd6\xa1\x93\x43\xd2\xa1\x43\x99\x6c\xbe\x8d\x4e\xce\xc4\x19\x70\x17\x9c\x37\x57\x9e\x6f\xcc\x7a\x81\xa8\x2f\x09\x9f\xc0\xd2\xc0\xa8\x50\xe0\x18\x88\xaf\x32\x68\x48\x9a\x65\x07\xff\x72\xda\x93\x4b\x18\x46\xf1\x5d\xab\x49\x34\x3e\x1e\x0b\x21\x47\x09\xc9\x3b\xa6\x53\xad\xbe\x29\xab\x53\x4c\x60\x06\x15\x15\x88\x13\x90\x1d\x44\xe3\x3d\xec\xdf\x17\x5a\xaf\xd2\x2c\x9d\x93\x95\xbb\x7f\x35\xae\x0b\x99\xc9\xf1\xee\xed\xc7\x63\x53\x1a\xf1\x2a\x96\x1e\xd3\x70\x1d\x5f\xf5\xba\xb7\x52\x92\x31\xc0\x0d\x89\xa9\xd0\xfc\x62\x72\x4a\x2a\x6f\x54\x50\xff\xa0\xd3\xd9\xdb\xd0\x1b\x13\x7e\xce\x7c\x5f\x72\x6c\xdf\x5c\xf8\x12\x32\x54\x3e\x08\xe2\x2c\x26\xb3\x62\x1a\xee\x15\xe1\x36\x64\x6a\x4e\x49\x9c\xfe\x6b\x27\x5d\x20\x12\xf5\xa1\xb2\x50\xe1\xc8\xa6\x91\xc1\x0a\x56\xbe\x1e\x94\x9f\x12\xa9\x38\x6c\xbb\xb0\x01\x25\x59\x24\x31\xea\xd1\x6a\x0f\x1b\xe9\xff\xc4\xd2\x0d\x59\x09\x80\x97\xa8\xf2\x37\x4b\xb5\x09\x76\x31\x1c\xfc\xf5\x74\x79\x16\x5f\x93\xcf\x12\x86\x88\x73\xe6\xba\xc4\x96\x8c\xbf\xb7\x39\x0e\xc8\x45\xe6\x14\xc2\x7c\x06\x83\x51\xa1\x8b\xd8\xcf\x22\x09\xeb\x48\x70\xef\xc8\x4e\x1a\x55\x69\xb4\xd1\x12\x54\xa8\xf6\x07\x06\x8e\x2e\xdd\x48\x91\xa3\x8a\x69\x80\x02\xea\x5b\x51\x87\xa0\xa7\x8c\x9b\x86\x85\xdc\x3b\x40\x97\xe0\xe0\xb9\xcb\xec\x9b\xf7\x37\xe4\xee\x27\x22\x09\x2c\xb4\xeb\xd8\xa6\xb7\x4a\x55\xad\xc2\x2c\x21\xe7\x4f\x44\xb2\x66\x11\xf7\xbe\x0d\x49\x49\x1e\xe3\x24\x1e\xb9\x87\x43\xbd\x56\x81\xff\x2e\x7c\x01\x49\xc5\x6f\xaf\xaf\xc0\xca\x75\x96\xb1\x93\xdd\xd4\x04\x0f\x4f\x05\x9a\x31\xe3\xea\x29\x42\x23\x6e\xc8\x1d\x72\x62\x2a\xaa\x98\x5b\x9a\x10\x40\xfe\xf4\x67\x8b\x4d\x26\x90\x76\x14\x27\x9f\x2b\xb5\x1c\x56\x4d\xfc\x1c\xc2\xe9\x6d\xa7\xb2\xb2\xda\xb2\x44\x3f\x98\x06\x68\x94\x7d\x85\x45\x33\x24\xdf\xa3\xfe\xaa\x50\xbe\xaf\x9e\x2b\x0c\xbd\x15\x15\xe7\x2d\x93\xef\xef\x7d\x7b\xc6\x99\x4f\x45\x52\x6c\x0d\xca\x03\x1b\xe2\x22\xdb\xf0\x20\xa3\x26\xba\x45\x54\x50\x98\x46\x6a\x52\x6e\xba\x42\x55\x3c\xea\x5b\x0c\x12\x96\x80\xb5\x59\x26\x43\xed\x38\xd9\x25\xe2\xf0\x6a\xf9\xea\xd9\xa4\x18\x9d\xf8\x19\xd4\x67\xc4\x5c\x31\xe7\x9c\x63\x31\xfb\x95\xb1\xa0\x29\xdb\xaf\x98\x93\xe5\x78\xd5\x39\x90\x80\x39\x20\x84\x4e\xe6\x91\x4e\xd5\xd3\x8d\xc2\xa1\x8f\x2e\x70\x3f\xce\x3b\x87\x31\xa3\x7a\xba\xaf\x0e\x29\xc0\x78\x70\xaa\x07\xfd\x0a\x9e\xa7\xc9\x02\x0d\xb2\xdc\x73\x89\x8b\x69\x4a\x6d\x7f\xf0\xe2\x11\x78\x09\xff\xef\x90\x09\x0e\x5d\xf9\xe8\xb2\xe9\x94\xfa\xd3\x6e\xbb\x57\x9f\x6a\x80\x5e\xa0\xd7\x43\xf4\x02\x9d\x82\x0b\x58\x34\xcd\xc0\x68\x2a\xb1\xb4\xde\x32\xf9\x0e\x32\xb4\x36\x20\x29\x98\x9c\x8d\x09\x1c\xfb\xf0\x11\x46\x3e\xf3\xfb\x3c\xd3\x13\xfc\x53\x94\x02\x1d\xc8\x65\xfe\x14\x86\x27\xf0\xc3\x47\x73\xa5\x51\xca\x27\x4d\x0e\x0a\x94\xde\xdb\x54\x5b\x04\x95\x52\xc4\x54\x4e\xf4\x5a\xc5\xd7\x43\xc5\x97\x64\x7f\xf0\x6f\x7c\x76\xe7\x67\x8e\x0f\xa5\xf7\x0b\x16\xc0\x66\xae\x96\x48\x5f\x1a\x9b\xbe\xa2\xb9\x9e\x36\xfd\x66\xe6\x42\xb9\xec\x0b\x68\xb3\x67\x2b\xd4\xd3\x87\xf4\xb7\xaf\xce\xda\xff\x87\x8d\xb3\xc9\x76\xd9\x01\xa3\xbb\x56\x45\xfc\x49\x5f\xdc\xf0\x8b\xba\xed\x1f\x5c\xc5\x1b\x2a\x3c\x48\xc5\x69\xa4\x99\x69\x03\x68\xaa\x5b\x50\x9a\xd5\x40\x5f\xd3\x6b\x23\x74\xc3\xe0\x47\x90\xc3\x88\x80\x33\x45\x48\xe1\xe8\x45\x3e\x1c\x5e\xd5\x06\xa7\xfe\xd4\x72\x5f\xaa\x79\x68\xa6\x73\xd0\xf9\x78\x3e\x35\x0e\x25\x84\x06\x32\x4d\x42\x53\xca\x20\x38\xcc\xbd\x1c\x34\xc6\xf6\x4d\x95\x8a\x83\x2a\x59\x29\xb0\x44\x41\xd9\x58\x10\x7e\x4b\x1c\x2b\xa5\x72\xbd\xde\x46\x03\x40\xe8\xab\xb3\x4a\x34\x1e\x91\xd8\xc1\x12\x6f\x1a\xc6\x3a\x35\x2b\x7e\xbd\xba\x58\x56\xaf\x16\x52\xa4\xc4\xff\xf9\x2c\xab\x53\x4a\x8f\x60\xbe\x3e\x23\xe0\xd3\xd4\x84\x10\xf9\x7a\xc9\x93\xbc\x06\x7d\x09\x8f\x98\x35\xfd\x92\xea\xc0\x85\xfa\x49\xdb\x1b\xd3\x96\x9c\xbe\x18\x40\x24\x03\x65\x04\xc3\xd2\x71\xb6\x0d\x01\xea\xc2\x8c\x34\xe7\x11\xed\x19\xf6\xa7\x44\x74\xe6\x21\x0b\x03\x07\x12\x6d\xd7\x8b\x4b\xcd\xc7\x32\x60\xe0\x14\x5a\xe6\xeb\xb0\x55\xf4\xb0\xab\x6b\xbe\xca\x38\x8d\x5e\x2d\xbe\x94\xea\x67\xea\x37\xd1\xfd\xcc\x7d\x3c\xb1\xf2\xeb\x26\x51\xea\x44\xb7\xa3\xfc\x19\x2c\x25\xf1\xaa\x39\xc8\x56\xad\xa0\x06\xcd\x6e\x94\xbf\x8e\x3d\xcf\x50\xfb\x97\x9c\x52\x64\xf5\x7f\xf1\x39\x45\x86\xc7\xcb\x4d\x2a\xb2\xdd\xaf\x77\x56\x61\x10\xff\x2e\xa7\x15\x59\x38\x87\x33\xaf\xc8\x88\xe7\x83\x32\x19\x58\x48\xa9\x69\xdd\x65\x28\x17\xd6\xaf\xc5\x14\x0a\xd4\x28\xb2\x53\xdd\x2a\x4a\x66\x1b\x39\x55\x60\xa1\xac\xd2\x84\x4e\xcb\x78\xe9\x2c\x5c\x02\x16\x5d\x2f\x67\x5c\x2a\x19\x54\x27\x39\x05\x9b\xd4\xdb\x98\xde\xc0\x7f\xa1\x0f\x9b\xb5\x8d\x70\x45\x0c\xda\x3c\xac\xbc\x5f\x7b\x91\xf9\x36\x87\x7f\x1b\x1e\x03\x0c\xa3\x11\x42\xbb\x71\xff\x46\x6e\x75\xd1\x9f\x83\xd4\x4f\x98\x78\xcc\x7f\x4f\x94\xe7\x60\xa1\x7c\x2f\x43\xfb\xa6\x91\xf3\xd0\x35\x9b\xb8\x0e\x47\x15\x16\xa4\x62\x55\x32\xa1\x3e\x15\x33\x70\x17\x1c\x05\x9c\xa9\x8b\x04\x88\x03\xe4\xc1\xb6\xba\x4b\x60\x5f\x6c\xb1\x49\x58\xa7\xac\x74\x1a\x43\xd1\x6f\x44\x33\xc0\xe4\xe5\xeb\x1b\x54\xbd\x0a\x6b\x28\x01\x73\x88\xa0\x9c\x38\xdb\x03\xd6\x05\xc6\x37\x61\x59\x8c\xc8\xa3\x62\x17\xdc\x1a\x2e\x04\x39\x36\xd1\x3f\xc5\xbb\xb0\x78\x37\xbd\x32\xde\x67\x56\x35\x5d\xc1\x6c\x5b\xcf\x76\x30\x36\xe8\xab\x81\x7e\xc3\x54\x26\x25\xd6\x1b\xa0\xd7\xdb\x18\xd9\x3a\xfa\x61\x29\x77\x2a\x89\xe5\xc3\x1b\x77\x61\x5f\xa5\x22\x7a\xaf\x92\x3e\x17\x8b\xdc\x67\xae\xfc\xa9\xbd\xed\x27\xee\x39\xb9\xe5\x67\xad\x22\x2e\x07\xb4\x67\xcb\x0b\x4f\x0f\xcf\x6f\x99\x7c\x9f\xa8\x64\x23\x09\x66\x92\x2a\x9e\x9e\x60\xc3\x45\x40\x70\x64\xb9\xe1\x5e\xb7\xae\xa2\xd9\xc9\xe5\x5f\xda\x44\x06\xdf\x54\x08\x69\x4f\xbc\x41\xbf\xb5\x0f\x33\x08\xf4\x43\xc3\x3d\xd4\xff\x4f\xde\xf5\xff\xb6\x6d\x24\xfb\xdf\xf3\x57\xec\xf1\x50\x44\x6a\x25\x59\xb2\x93\xe0\x25\xa8\x03\xd8\x4e\x73\x4d\x91\x2f\x3e\xdb\xb9\xe2\xa1\x57\xb0\x14\xb9\x12\x79\xa1\xb8\x3a\xee\xd2\x8e\x91\x28\x7f\xfb\xc3\xec\x77\x52\x24\x45\x4a\x94\x6b\xbf\xa2\xfd\xc1\xb1\xc9\xd9\xe1\xcc\xec\xec\xec\xee\xcc\x67\x5a\xd9\xc5\xbb\x88\xde\x27\xbb\x48\xb3\x04\x18\x46\x37\x21\x80\x88\xb1\x10\xdf\x6a\xac\x38\x9a\x2d\x01\x21\x40\xa5\x7c\xb7\x34\x9a\xbd\x87\x2c\x6b\xea\xd9\xc5\xe7\xfe\x42\xa6\x12\xbf\xa8\x29\x34\xe2\x2f\x64\xda\x44\xf4\xff\x21\x53\x57\xe1\x18\x46\x14\x31\xef\x13\x48\x1b\x52\x4f\x34\x49\x24\xdd\xe7\x21\xf7\x9f\x14\xc4\xed\x4b\x2c\xa5\x2a\x67\x0a\xe2\x72\x81\x34\x3f\xe1\x97\x4f\x83\x5f\xe9\x58\xc4\x43\x6b\x24\xe1\x0b\x68\xe6\xfb\x18\x07\x9d\x2b\x73\x4d\x9b\x87\x3b\x38\xe1\x5f\xc8\x54\x80\x52\xed\x4b\x93\xf2\x48\xae\x8d\xa6\xc4\x2b\xfb\x96\xda\x2e\x73\xe0\xe7\xa5\xb7\xd5\x75\xc1\xcf\xe7\x27\x4d\xe4\x17\x2e\x3d\x6b\x17\x6a\x5f\x0a\x68\x92\x90\xc9\xc4\x97\x9a\x8e\x6e\x07\x40\x66\x6e\xb8\xf4\x8a\x0b\x99\xa2\xd9\xad\x36\x1e\x95\x06\xd9\x45\x1e\xd4\xda\xb5\x1f\x1e\xec\x50\xd5\x4e\xcc\xca\xc5\xd0\x35\xdc\xfc\x36\x29\x4f\x1f\xda\xd1\xae\xde\x79\x9f\x9b\x1f\x8f\xb6\xb7\x27\x1e\x9f\xaa\x95\xcc\xb3\x57\x39\x38\xe0\xdc\xc1\x82\xee\x56\x79\x8f\x4a\x37\x19\x86\x07\xf0\xf5\x0b\xef\xf3\x7e\x47\xdf\x42\xdf\x25\xe9\x62\x0a\x33\xab\x3e\x67\xec\xec\xfc\xe3\x87\x6b\x9c\xfa\x64\xb1\x88\x9a\xd9\x86\xc2\x01\x07\xad\x13\xfd\x2a\xdc\x27\x9e\x9d\x7f\x44\x6a\x54\x24\x61\xb5\x84\xd3\xe0\x21\x13\x9c\x38\xfa\x9c\xb6\x26\x8a\x10\x23\x31\xdc\x35\x40\x74\x1c\x60\x85\x4c\x5b\x65\x0b\x80\x1c\xa3\x05\xfe\xa2\x64\xab\xa2\x46\x57\xa0\x5e\x34\xdf\x92\xf3\xcb\xaa\x1a\x91\x66\xad\x83\x9a\x17\xc7\xc4\xf7\x18\x5c\x93\x1b\x2a\xf6\xfb\x2f\xf5\xcf\x3d\x9e\x04\xdf\x94\xc4\x70\x02\x3d\xdc\x5b\xbd\x22\x47\xe2\x86\xb1\x8b\x1f\x78\xc7\x41\xf5\x3a\x53\xb8\x44\xba\xbd\x6f\x3a\x97\xd0\x81\x1a\x2e\x70\x6b\xb5\xdb\x84\xb6\xd7\x7c\x8e\xca\x70\x52\xce\x4b\x5b\x3a\x5d\x59\xc4\xd9\xf9\xc7\x7f\x42\x3f\x9a\x3b\x70\x02\xef\x95\x16\x2b\x7d\xbd\x56\x88\x22\xc0\x5b\xe5\x74\xea\x63\x45\xff\xeb\x63\x27\xf4\x52\xe8\x1d\xab\x06\x3a\x76\xfc\x65\xe6\xac\xca\x75\xa3\xd9\x6a\x3c\x51\xe1\xff\x97\x68\x32\x7a\xda\x95\x9a\xc4\xc4\xed\x56\x53\xb5\xb3\xf7\x7e\x2b\x4b\xb0\xbe\xb5\xbe\xec\x99\x54\x5a\x3e\xb1\x37\x3d\x72\x0d\xbe\xce\xe2\xf8\x76\x53\xb5\x8f\x56\xa0\xd6\x44\x55\x44\x06\x19\xd7\x02\x37\xae\xbe\x26\x48\x93\x46\xb2\x3a\x28\x62\xd4\xa6\xa9\xc4\x0b\x7f\xe5\x13\xaf\x4a\xf3\x77\xa4\x75\x28\x53\xca\x45\x64\x07\x28\x9a\x27\x1c\x21\xb5\x57\x68\xca\x0a\x73\xda\xe8\xec\x4e\xcd\x72\x65\xc3\x83\xc3\x7f\x2f\x4d\xe5\xcd\xb6\xe0\xe6\x56\x14\x27\x7b\xd7\xd5\xc6\x70\xe7\xba\x59\xda\xbf\x78\x9f\xbd\x46\xf0\x08\xda\xbe\xae\x42\x8c\x8a\x14\xa0\x6b\x4d\xb4\x80\x7c\x96\x5c\x5f\x89\xd2\x66\x6d\x9a\x22\x92\xcd\x28\x1a\x19\xac\xaa\x05\x6e\x6c\xaf\x50\xd6\x5d\x67\x90\x6b\x6d\xfb\xf4\x55\x8e\x9c\xe7\x3b\x59\x40\x8c\x99\x33\x50\xd8\x8b\xee\xd2\x63\xe1\xb1\x73\x50\x8c\xdb\xf3\x5e\xa8\x94\x29\xdd\x2e\xe8\x2e\x79\xfa\x11\x8d\x47\x63\x55\x6d\x2c\x6c\x72\xb1\x65\x3d\xf0\xee\xe6\x76\xea\xc1\x79\x26\x49\x78\x45\x6d\xc2\x10\x47\xb1\xe6\x8d\xbe\xd9\x9f\x62\x88\x3a\x1b\x92\x11\x8e\x0b\x07\x50\x70\x12\x4b\x63\x46\x32\x53\x99\x85\x50\xe0\xdd\xd2\x11\x3a\x13\xdb\xf5\x8d\xd6\x2b\xc9\x6b\x2b\xac\xb2\x5d\xfb\x9a\xed\x1e\xd9\xb1\x6d\xc9\xf7\xc7\x96\x39\x7e\xc7\xa8\x02\xaa\xae\x00\x19\xf2\x67\x0b\x53\x02\x8f\xd8\x2d\x86\x6c\xf8\x91\x9d\xaf\xb9\x8a\x53\xa5\x05\xa6\x2d\xb8\x7c\x33\x85\x90\x90\x50\xdd\xfc\x52\xa7\x3c\xb0\x0e\x66\x10\x34\x68\x9a\xc8\xbc\x03\xa5\x36\x6b\x6d\x7f\xf2\x4e\x7a\xad\xbf\xab\x8a\xce\x78\x9d\xce\x97\x42\x3b\x03\x59\xb4\xe3\x0c\x76\xd2\x52\x61\xf1\x5e\x15\x0f\x6c\x9f\xb6\xf2\x85\x65\x4b\x34\x87\x69\xa8\x3f\x65\x89\x23\x9c\xb4\x41\xa8\xb6\xd0\x7c\x4f\xce\xdf\x20\x81\x83\x8e\x7c\x4e\x26\x57\xaf\xff\x1f\x32\x2d\x1c\xca\xa9\xd0\x48\x13\x85\xe5\xf3\xf1\x1a\x68\xf1\x66\xef\x25\x4b\xe8\x1f\x6f\x84\x0c\x86\x72\x3f\x57\xf0\x66\x36\xe1\xa2\x18\x0f\xe0\xd6\x8f\xbf\x39\x4f\x47\x23\x79\x8f\x9f\xef\xe5\xc9\xc3\xb7\xfe\xa3\x32\xa7\xd3\x80\x7a\x25\xc1\x5d\xa1\x81\xcd\x9c\xb3\xd5\x6d\x3a\x6f\x0c\x69\x4c\xea\x8f\xd5\x4e\xce\xdf\x70\x6d\x9f\x66\xc1\x1c\xb3\xd3\x2c\x6d\x76\xa3\x05\x13\xd3\xd2\x77\x44\xd1\x34\xe3\xac\x20\x46\x08\x5a\x64\x7e\x28\x80\x0d\xd0\x94\xd3\xad\xd0\x0c\x88\x4e\xf3\xaa\x64\xf6\x02\x28\x81\x40\x27\x21\xdc\xb6\xf7\x26\x4f\x46\x4f\xa0\xa8\x11\xe4\x33\x1e\x8f\xfb\xa5\x2e\xb5\x9e\xd2\xd3\x45\x1d\x25\x3e\xb9\x0e\xab\x84\x0d\xd7\x08\x96\xff\xab\x98\x6d\xf2\x4f\x21\x49\x99\x9e\xa8\x0f\x45\xce\xcf\x84\x9c\x9f\x8d\xc6\x3b\x8a\xf9\x68\xbc\xa8\xa1\xb4\xc1\xa8\x85\x9c\x9f\xb5\x91\xf3\xd1\xf8\x61\x09\x7a\x12\x70\xf1\x1c\xed\x2c\xe8\xc3\xb0\x86\x50\xfd\x7a\x2d\xcd\x39\x28\x11\xb3\xbd\x80\x5b\x52\x3e\x0c\x1f\x94\x90\x8f\x84\x90\x27\x3b\x0b\xf9\x59\x58\x43\x88\x0b\xf9\xa8\x5e\xc8\x47\x2d\x84\xfc\x2c\x2c\xf8\xf0\xdc\x92\x6d\x1c\x7a\x83\xc5\x7b\xfb\x0e\x8e\x27\x6a\xdd\xb6\xda\x1c\xa2\x4c\x66\x75\x78\x19\x0b\x71\x02\xd1\x05\xd3\xd0\x2a\x9a\x2d\xb9\x68\x47\xa9\xfd\x69\x80\x5e\x63\x41\x5e\x4d\x46\x4f\xeb\x91\xfe\x34\x35\xb5\x88\x56\x74\x5b\x94\xf0\x10\xa2\x0f\xca\x5a\x13\x18\x88\x9a\x40\xcb\x88\x24\xd0\x32\xa9\x8f\xc2\x88\x32\x32\x4f\xbd\x85\xfb\xdf\xcc\x4b\x58\x14\xe3\x1e\x28\x73\xa0\xb3\xcc\xf8\xc1\x4c\xac\xfb\xf0\xb4\x65\x63\x9a\x41\x3f\xdd\x35\x3e\x24\xf6\x3f\x40\xb0\x3d\x19\x8f\x2b\x0c\xa5\xdc\x2e\x1e\x94\x52\xc7\x7f\x4d\xa5\x1e\x3d\x6b\xa0\xd4\xdc\xc2\xa5\xb5\x7a\x32\x9f\xa7\x78\x0e\xa5\x09\xca\x8d\x36\x0b\xb5\x4f\x12\xe4\xe9\x57\xb9\x1f\xb5\x82\x69\x99\x6d\x62\xc7\xd7\x7a\x33\x22\x37\x45\x9a\x22\x92\x60\x54\x38\x50\xa1\x33\xf7\xcc\x26\x8f\x42\xfc\x16\x85\xde\x35\x00\xdc\x09\x04\xaa\x00\xa2\x52\x14\x31\x05\x84\x05\x3d\x22\x2d\x8a\xb3\xe8\x1a\xeb\x1b\x72\x04\xd8\x51\x88\x07\xed\xa6\x6a\xcd\x14\xad\xc9\xbd\x6d\x14\x47\xec\x16\x86\x83\xdf\xc9\xee\x54\xeb\xa9\xbc\x7c\x69\x20\x33\x86\x93\x2a\x13\x93\x7d\xb5\xe0\x73\x07\x48\x7f\x74\xbf\xa7\xb1\xb3\x94\xd4\x48\xea\x66\x89\xd9\x58\x4b\xbd\x46\x3e\x16\xae\x44\x46\xe9\x2f\xd1\xe1\x46\xc5\x96\xce\xd6\x9c\x5e\x5f\x91\x9b\xe4\xae\xb5\xca\x53\x1b\x2a\xcf\x2b\x0b\xc0\x85\x70\xc5\x62\x10\xcd\x9e\x2e\xaa\xa4\xdb\x9b\xa0\xa1\x0d\x11\x91\x13\xb1\x77\x3d\xb7\x40\x66\x36\xca\x59\x4d\x1f\x05\x2c\xf8\xbc\xed\xfe\xb6\xd2\x4b\xb6\x91\xb8\x7c\x1e\x84\x87\x82\x88\x42\x97\x3c\x0f\xf2\x88\x66\x29\x59\xa0\x73\xdd\x01\x0d\x31\x2f\x9d\x63\x06\x8f\xf8\xf0\x8d\xb7\x55\x12\xf2\xa6\x14\x27\xac\x97\x2d\xd7\xdd\x16\x80\x5b\x35\x8c\x7f\x4b\xbd\x46\x75\x44\x20\x8f\x75\x6a\xe3\x01\xc0\x39\x6a\x85\xdc\x62\xef\xd7\xe1\xa2\x49\x9a\x98\xa8\x16\xcc\x12\x5e\x14\xcd\xdd\x80\x81\xc3\xb2\xf2\x62\x6a\x73\x3e\xed\x1b\x2e\xdd\x8b\xab\xba\x49\x8f\x7e\xe4\xd8\xe1\xc0\x33\xce\x00\x0e\x47\x32\x2a\x1b\x83\xe5\x20\x24\x1b\x0b\xb6\xd2\x7a\x40\x50\x1f\xe1\xf3\xfc\x10\x2c\x76\x6b\x59\xc1\xfd\x96\x21\xc3\x17\x2c\x4a\x16\x18\xa9\x96\x83\x94\x37\x42\x9e\xc2\x0d\xe6\xe6\xfc\x6a\xfb\x66\x10\xb2\x79\xa0\xdd\x20\xab\x96\xd8\x27\x7c\x2b\x6e\x07\x47\xc6\x58\x46\x11\x39\xb0\x18\x72\x06\x78\x36\xc3\x3e\x3b\x76\xde\x13\x95\x57\xec\xac\x64\xb9\xa4\xb9\xb2\xfa\x84\x6f\x07\xdc\x77\xf7\x51\x7b\x16\xbe\x39\x57\xe4\x14\xbf\x82\x73\x52\x1c\x9c\xde\xca\x4b\xdd\x93\x8c\x11\xea\x83\xc4\xbf\xfa\x31\xc9\x82\xd1\x9c\x90\x79\x8c\x47\x3e\x59\x1c\x44\x0b\xe8\x52\x19\x25\xf3\x21\x0c\x34\x54\x6d\x7a\x23\x92\x7c\xf5\x6e\xe8\xda\x2f\x87\xa1\x97\x04\x31\x4e\x0f\xe8\x92\xb0\x61\xc4\x12\x67\xd5\xdf\x01\x44\x0e\x9c\x41\x8c\xd9\x15\x21\xef\xbc\xe4\x16\x52\x41\x1a\x69\x5f\xbe\x56\x66\x05\xfc\x04\xcb\x24\x98\x6d\x38\xbf\x2a\xbd\xeb\x84\x02\x0d\x75\x68\x5d\xe5\x75\xf8\x92\xc5\x9d\x32\x74\x3a\xcc\x9d\xce\x9b\xda\x88\xdc\xa1\x65\xa5\xde\x64\x8f\xd7\x0b\xc1\xb3\x72\x5b\x02\x6c\x48\x9f\x57\x01\x26\x90\xf6\xfc\x03\xd9\xac\x36\x07\x3e\x04\x9a\x32\xb0\x43\x9b\x5e\xec\x4d\x06\x06\x58\x09\x7a\x26\x57\xda\x95\x75\x79\xde\x7f\xb4\x7e\xfe\xa6\xd6\xa6\x35\x31\xac\xfb\x1d\x75\x0f\xb0\x24\x01\xad\x1e\x0e\xfd\xcd\x58\x93\x84\x30\x1a\x3d\x7f\xda\xa9\xb3\x01\x9f\x16\x25\x98\xd2\xd7\xb1\xb7\x6c\x0c\x81\x06\x41\x5a\xaa\xde\x54\x47\xdc\x64\x26\xb2\x93\x4a\xdc\x11\xb8\x6e\x91\xcc\x19\x18\x2b\xd4\x64\x91\x81\x36\xb3\xb1\x4d\x37\x27\x3a\xc2\x21\x47\x2e\x47\xb4\xdc\xb7\xe7\x1c\xf6\xba\x43\x5f\x89\xf4\x51\x71\xcc\x0a\x14\xec\xc8\x6b\x77\x29\xc7\x98\x9d\xc7\x78\xae\xfa\xfd\x43\x40\xda\x58\xc6\x6a\x72\xc3\x34\x7c\x1b\xcd\xb0\x7f\xeb\xc7\x18\xfd\x74\x0d\xbb\x28\x09\x13\x41\x44\x9e\x8a\x87\x9e\x3f\x67\x21\x5a\x8a\xe9\x0c\xa8\x9b\x81\x1c\x50\x53\x46\x10\xe1\x1a\x1f\xb0\x5a\x21\xb9\xc7\x00\x58\xc8\x0a\xcd\x55\x49\x1e\xfe\xac\x37\x3c\x2f\x64\x08\xe0\x2e\x63\x3c\x77\x53\xcc\xfb\x17\x06\x59\x7e\x23\xf3\x62\x7d\xa3\xf4\x45\xfd\x70\xec\x8c\x47\xcf\x9f\xc3\xe6\xea\xb8\x00\x1b\xbb\xab\xe0\x49\x70\x09\x40\x77\x1f\x97\x6f\x3d\x86\x13\xff\xb6\xb1\xf4\x6d\xc9\x73\x64\xbe\x6c\xb9\x26\xe1\x58\xd0\x04\x2f\x5b\x26\x56\x4d\x14\x6d\x21\xe0\xd2\x6d\xe5\xf3\xe7\x83\x7c\x2b\x40\xb8\xac\x03\xaf\x05\xcb\x3a\x4e\xdd\x20\xab\xd9\x3c\x36\xb8\x7e\x2b\xbb\x1c\x88\x71\xbf\xe0\x80\x4b\x5c\xad\xe2\x05\x3e\x8a\x17\x8e\x34\x1d\x12\xbd\x44\xcf\xba\x8b\x9e\x62\xcc\x5a\xc5\xde\x31\x66\xfb\x8a\xbd\x1b\x7c\xfa\xfe\x42\x72\x15\xd2\xd5\x1f\xd2\xa9\xc0\x2b\x6d\x25\x33\xfd\xd6\xbe\x24\xa7\x99\xdf\xab\x88\x20\x19\x96\xa3\x8e\xa4\x43\xd9\x7f\xba\x56\x56\x67\xfa\xf1\x77\xe2\xe9\x56\x32\x5b\x7b\x7b\x9f\x56\x57\xf2\x69\x1d\x4a\xd2\xf4\xe5\xae\x94\x97\xf9\x8c\x53\x2f\x38\xe3\x30\xc4\x75\x92\xca\x41\x48\x5b\x22\xf8\xf2\x45\xbb\x4a\x15\xb3\xf1\xf3\x07\xf5\xdb\x25\x09\x56\x2b\x1b\x6a\x88\x11\x4d\x13\xce\x95\x60\x9b\xc3\x63\x58\x05\x6c\xcb\x07\x2e\x81\xb3\x15\x57\xe7\xb6\xf4\x73\x6f\x48\x52\x55\x1a\xf8\x3b\xfa\x55\x42\xd1\x40\x91\x83\x3e\x82\x18\x28\xae\x04\xee\x34\xd0\xcc\xe2\x00\xc1\x39\x10\x83\x04\xee\x98\x42\xd3\x99\xb9\xc7\xa2\x6b\x4c\x07\x88\x62\x73\xf9\xfc\x77\x14\x32\xb6\xa4\x2f\x0e\x0e\x6e\x6e\x6e\x46\x29\x99\x66\x94\xf1\x55\x87\xcb\x08\x76\x52\x5c\xd0\xb0\x4f\x21\xc9\x70\xee\x65\x73\x4c\x87\x51\x32\xb4\x5a\xa6\x1f\x0e\xc7\xa0\x65\x40\x71\xf6\xa2\x58\xc7\x4f\x28\xcf\xa3\xdd\xb3\x5f\x7c\xb2\x0b\xd8\xff\xb0\x82\x13\x68\x96\x0f\x45\x73\x94\xce\x32\xd1\x57\xf6\x9b\x63\x0d\xf0\xe9\x7f\xa8\xdd\xa2\x3d\xd7\xce\x5e\x2e\x24\x5b\xa1\xf9\x5a\x16\x57\x6a\x51\xef\x89\x3c\x2b\x8d\x48\xf2\xcf\x0c\x67\x58\x6e\x15\x20\x3f\xb4\xb1\x91\x9d\x80\xf8\x50\x62\x91\x42\xff\x05\x5a\x10\x1f\xb5\x35\x40\x3d\x02\xb2\x37\x5b\xb3\x2c\x8e\x4b\x2c\xcd\x22\xee\x55\xf1\xb0\xd6\xb3\x08\x68\xa9\xa3\x6e\x6b\x34\x09\x01\xbe\x68\x60\x97\x51\x72\xef\xed\xd2\xde\x2d\x15\x92\x92\xcc\xeb\xae\x2d\x2e\xea\x72\x9d\xb9\x31\x4e\xe6\x2c\xdc\xc6\x42\x07\x02\xa2\xf8\xc8\xba\x93\x33\x55\x0a\x28\x2f\xb6\x4d\x5c\xa8\x0d\xdd\x56\x33\x45\x0f\xda\xef\x24\x12\x32\x56\xc6\xaf\x9f\x2f\xc5\x99\x06\x37\x7a\x7a\x45\x2e\xc9\x02\xf3\x9f\xe5\xda\x40\x1b\x4f\x9b\xc7\x39\x60\xea\x89\x0d\x4c\xfd\x9d\x3a\xa8\xbf\x09\x61\xeb\x41\xc5\x90\x82\x21\xca\x17\x37\x4d\x12\xb5\x9e\x62\x70\x1d\x64\x73\x6c\xbd\xe5\x59\xbf\x5e\xad\x46\x8f\x6b\xa7\x5c\xa1\xf9\x86\x85\xa4\x3f\xd1\xfc\x17\x38\xcf\x2d\x29\x1e\xa2\x4b\xec\x83\xcf\xc8\xb1\x53\x35\x01\x6d\x93\xe6\x01\x7b\xa5\x09\x89\xb1\x65\xfa\xcf\x4e\x06\x74\xd0\x78\x4c\x08\x56\xbb\x18\xd1\xfc\xc4\x8f\xd2\xf5\xbf\x5e\xa2\xc9\x1d\x18\xf3\x49\x72\x6b\xab\xa2\x1b\x53\x5e\x44\x49\xb4\xc8\x16\x75\x26\xad\xa9\xa2\xb5\xc8\xad\xb1\x49\x43\x8b\x9c\x9c\x1d\xd5\x5b\xaf\xb6\x5c\x6a\xb7\x80\xa8\x36\xdc\x75\xfa\x15\x76\xba\x88\x12\x85\xa6\xd7\xb3\xe7\x53\xff\x2f\x6f\xc1\x47\x6d\x2d\xb8\x41\xf4\x02\x1d\x6c\x38\x94\xec\x15\xd9\xce\x0b\xb7\x36\x35\xd9\x05\xc2\x57\x03\x6b\xb2\xa8\xcc\x4a\xe8\xa8\xd6\x0a\x8b\xc4\x6a\x49\xd4\x84\x24\x0f\x39\x54\xce\x9b\xa1\x3d\x65\xa8\xab\x36\x6c\x38\xd8\xca\x28\xd1\x8f\x68\xd2\x36\x64\xde\xe0\x36\xaf\x2e\x5f\x9d\x5e\xf0\x80\x9e\xc2\x46\x47\x3d\xb9\x17\x53\x83\x15\x56\x35\x8e\xd1\x24\xa1\xb7\xd5\xda\xb5\xc3\x6a\xa5\x76\x68\xb2\x96\x95\x16\xae\x81\x8f\xc2\x7a\x43\x84\xa1\x22\x4a\x33\x4c\x25\x21\x70\xce\x53\x68\x4c\x22\xe2\x0d\xd8\x5d\x57\xc2\xca\xea\xfb\x78\xa3\x0e\x97\xd1\x60\x2a\x77\x3e\xd4\x55\x4c\x6d\xe9\x5f\x8e\xc2\xdf\xd7\xe0\x99\x9e\x84\x5d\x68\x12\xe0\x5e\x3c\x1f\xd4\x73\xcf\xb4\xe9\x6b\xc6\x3a\xd0\xa8\x22\xa6\x55\xda\x5a\x91\x86\x1d\x2a\x31\x4c\xee\x95\x2a\xdf\x13\xf6\x06\x52\x4a\xe0\x13\x2f\xa1\x9a\xa7\x45\x77\xc9\xd6\x7a\x94\x3e\x3b\x52\x03\x6a\xb2\x48\x54\x12\x61\x5a\xaf\x98\xe2\xfb\xc5\xb7\x8a\x4a\x29\x2e\xdb\x5c\x21\x21\x86\x13\x05\xf1\xa2\x0b\xc7\x5e\x49\xb0\xb5\x4e\x8a\xdd\x98\xba\x72\x95\xaf\x32\x8e\x8f\xc1\xf0\x15\xdc\x45\x31\x6f\xb1\xdc\xaf\x56\x82\x94\xd8\x57\x6e\x6b\x6d\x00\x9f\xcc\x75\x5c\x0a\x37\x64\x52\x7a\x07\x94\x87\x6b\x28\x88\x66\x33\x0c\x75\x55\x2a\xbd\x09\x1a\x78\x04\xea\x13\xec\x19\xcb\xd4\xe7\x6c\xd4\xb3\xe2\x48\x8d\x25\x47\x52\x44\x0d\xa5\xe6\xca\xe7\x07\x99\xb2\xfb\x1a\x95\x16\xe0\x6a\x36\x5d\x4d\x71\x17\x5b\x28\xcc\xcf\x49\xb8\xab\x25\xf0\xa6\xaf\x1f\xd2\x00\xa7\x0f\xc2\x14\x8c\x5a\x90\x97\xa6\xd1\x35\xac\x85\xb2\x6d\x1c\x81\x8f\xa8\xd7\x3b\x28\x9d\xe6\x35\x4e\x32\x36\x24\xb3\x21\x7f\xb9\x33\xa5\x93\x8c\xb9\x64\xe6\x72\xa2\xf7\x4a\xdb\x17\x78\x41\x18\xbe\x14\x65\xc3\xaf\xe5\xea\xb5\x3f\x75\xcb\x68\x17\xfa\x17\xe2\x24\xa8\xd2\x78\x61\x53\xaa\x92\x13\xa5\x96\x18\xb1\xef\x12\x53\xfe\x01\xfc\xe2\x6d\xb5\x7a\x51\x5a\x6a\x96\xa5\xb1\xdd\x5d\xb8\xcc\x0e\x80\x2f\xbe\x73\x84\xbd\xae\x3d\x92\x20\x8f\x64\x5d\xf5\x36\xe7\x1d\x92\x41\x49\x41\xad\xc8\x72\x88\x1d\x6c\xa1\x74\xdb\x68\x33\xf2\x27\xb0\x82\xd0\x0f\x6d\x18\xd0\xd0\x74\xdd\xf2\xd0\x7f\xb4\xfe\xd3\xce\x27\x34\x9b\xf6\xb7\x62\x22\xfd\x9a\x46\x0c\x9f\xe2\x30\x4a\x82\xfd\x4d\x22\x69\x93\x37\x30\x96\x26\x89\xe4\x55\x7c\xc5\x2c\xa2\x68\xca\xb9\x2a\xf6\xc4\x28\x4e\x1f\x7b\xd2\x58\xb4\x57\xab\x7a\x3f\x6a\xb3\x04\x8c\x88\xc1\xfe\x7f\x6c\x86\xed\x39\x55\xb9\x31\x2e\x58\x76\x18\xcd\x43\x4c\x99\xb5\xc4\x47\x3a\x33\x61\x37\xf3\x1e\xca\xec\xfb\x01\x2a\xe6\x24\xa4\xd1\x3c\x64\x5b\xf0\x2a\x4e\xf1\x15\xc7\xe2\x68\x49\xb3\xdd\x09\xcf\xe6\xa7\x97\x68\x72\x38\xde\xe3\xd4\x7b\x25\x00\xe1\x2f\x01\x6b\x83\xde\xf9\x0c\x54\x58\x88\x94\x0f\x8f\x7c\x2f\xf6\xb3\x98\xcf\x7d\x74\xe3\x25\x8c\xaa\x6b\xad\x7c\xc6\x8c\x78\x18\xe6\x25\xd7\x44\x79\xb9\x74\xdd\x3c\x45\xab\xd5\x00\xdd\x84\x91\x1f\xa2\xc8\x3e\x21\x85\xbc\x72\xc8\xcb\x23\xb3\x3c\x4d\xe9\x22\xfe\xa8\x36\x09\xf1\x01\xee\xc2\xfb\xfc\x45\x59\xd9\xb1\xf3\x1d\x75\x06\x2d\xcd\xe0\x8f\x92\x2f\x51\x04\xd1\x57\xb8\xf5\x4b\x6f\xd1\x57\x34\x8b\x52\xca\xd0\x57\xa4\x64\xd2\xc2\xd7\x34\x15\x79\x19\x7e\xab\xba\xea\x06\x68\x32\xef\xb3\x24\xf1\xd7\xf5\x59\x52\xe9\x52\xa2\xbb\xcd\xf8\x97\xdb\x8f\x0f\x46\xd7\x89\xb7\x69\xec\x5f\x36\x85\xc8\x59\x7c\x07\x91\x71\x55\x32\x07\x86\x49\x01\x87\xc1\x35\x5d\xf2\x79\x96\x53\xae\x37\xfe\xd3\x45\xfd\x1c\x8a\xc4\x60\xb0\x59\x82\x97\xd5\x28\x10\xa5\x54\x4d\x80\xb2\xf3\x26\x78\xd7\x35\xef\xee\x7a\x76\x58\xba\xc5\xe9\x60\x81\x78\x17\x51\x00\xbc\xba\xc8\x62\xfc\x93\xf9\xd0\xfd\xea\x12\x3a\x7f\xe0\xa0\xd4\xf1\x96\xea\x4f\x24\x16\xda\x8a\x68\xab\x50\x18\xb1\x4c\xa1\x28\xc8\x78\xad\x28\x8d\xc9\x4d\xf9\x50\xad\x55\xce\x09\xb8\x11\x93\x7d\xbf\x28\x07\x0d\xc7\xc1\x1d\xab\xdd\xcc\x5b\x95\xa1\x65\x5f\x48\x8c\x80\xd3\xea\x4c\x2d\xfb\xd6\x46\x24\x6a\xbd\x49\x7c\x92\x48\xb8\x91\x3a\xdb\xd0\xe9\x6d\x46\x50\x88\x67\x2c\xe7\x53\xa7\xe4\xae\x55\x2d\x77\x54\xfd\xc2\x1e\x18\xc9\x44\x7c\xf4\x87\xb5\xa2\x6b\xcb\x2a\x54\x12\xca\xf2\x2d\xb4\x5a\xfd\xc1\x11\xdf\xad\xe6\xf8\xc6\x36\xf8\xd9\x49\x0a\x69\xe1\xda\xda\x6c\xa1\xa8\x5c\xa7\xd0\xa3\xa1\x85\x66\xf3\x6f\xe7\x3b\xfa\x6f\x67\x20\x47\x90\xff\x5c\x39\x25\x2c\x15\x99\x51\x8b\x78\x2e\x1b\xe8\x2c\x27\x08\x18\x0b\xe6\x32\x74\x8f\x00\xee\x46\x6f\xf5\x5c\x91\xe5\x3a\x4e\xce\xb7\xcd\x1c\x34\xfa\x97\x9c\x1a\x4e\xfe\xc3\x60\x73\xbe\x5a\x55\x58\xab\xa9\xc5\x50\xdc\xaa\x0f\xea\x4b\xe0\x4d\x57\x9d\x16\x96\x3f\xe3\x58\xc2\x71\x06\xa8\x52\x6e\xe0\xd6\x1c\xfb\xaf\xc3\x85\x17\x25\x36\xee\x8c\x63\x50\x67\x16\x24\x89\x18\x2f\xe9\x71\x56\xfd\xbe\x5d\xd5\xd0\x34\xc9\xbb\xdc\xbb\xd9\x66\x04\xab\x13\x0e\xc4\x4d\x57\x23\xcb\xbd\xd0\xd7\x46\x36\x99\xc7\xc5\xec\x3f\x6b\x4d\x2a\x6c\x1b\xf5\xa7\x6a\xda\xa8\x60\xad\xdc\x13\x56\x39\x96\x32\xd1\xd6\xa4\xdf\x6d\x25\xec\xad\x72\xf0\x4a\x43\x01\x5b\x46\xef\x30\x94\xe7\xd2\xd6\xbe\xc2\xa6\xa1\x11\xd5\x67\x24\x4b\x02\xe4\xc5\x31\x22\x2c\xc4\x29\x5a\x08\xe2\xca\x53\x48\xe7\xd0\x4c\x88\xe2\x59\x57\x92\xd8\x5e\x6c\x9a\x3c\x02\x5b\x25\x09\xea\xe9\x09\xf2\x8f\x8b\x0f\x1f\xcf\xdd\xb7\x3f\xbd\xbe\xea\xf5\xd7\x66\x9d\xf5\x5c\x6f\x3f\x9c\xf5\xb7\x9f\x37\x6a\x7d\x10\xbd\x41\xe3\x0d\x4b\xc3\x15\x3f\xc2\x6d\x9c\xe5\xfc\xb8\xea\xb4\xda\x3a\xbb\xac\x85\x60\x92\x82\xd3\x94\x61\x2a\xc9\x2c\x68\x28\xdc\x2e\x9d\x77\xf0\x88\xf9\x07\x2c\x07\x01\xb9\x29\x76\xfc\x80\xfa\xde\xef\xa5\xef\xeb\x65\x4b\x3e\x23\xfa\xe8\xf4\x7f\x65\x61\xbe\x7e\x7f\xa0\x0a\xbf\x0d\xd2\x74\xb6\x34\x0f\x6a\xc6\x4a\x5e\x80\x85\x7b\xd2\xcd\x34\xfb\x15\xfa\x0b\x04\x64\xde\x48\xe8\x66\x1a\xc0\xda\x0b\x6d\x59\x29\xf2\x12\xe1\x1c\xd1\x02\x7b\x09\x83\x90\x07\x27\x34\x4b\xad\x9a\x77\x28\x5e\x49\x31\x52\xfb\x2a\xb4\x8c\x96\x18\x52\x2d\xe1\xed\x59\x96\xf0\xcb\x65\x2f\x1e\x15\x69\xf3\xe7\xe1\x19\x2f\xbe\xf1\x6e\x29\xec\x57\x15\x04\x61\x8a\x67\x90\x91\x14\x31\x80\xc3\x81\x9d\x9f\x7c\x64\x8a\xe5\x53\xa0\x40\x7b\xf2\x5b\xb4\xbd\x44\x3f\x3e\xe3\x6c\xcd\x3d\x88\x16\x90\xc7\xc1\x0e\xa3\x6b\x9c\x8e\x20\xae\x80\xbf\xc0\x10\x09\xc3\x73\x19\x6f\x89\x9b\x8a\x6b\x2f\x8d\x48\x46\x73\x99\xb4\x16\xf9\x05\x86\xfa\xb0\x88\x2e\x38\x4a\x04\xe3\x27\xee\xc8\xcb\x3d\x0d\x4d\x64\x20\xc8\xb4\xbf\x11\x5a\x3d\x08\xd6\x47\xe8\x35\x49\x11\xfe\xcc\xcf\x66\x0b\x6d\x1f\x9c\x57\xd8\x0b\xde\x79\x09\xbd\x4c\x22\xe6\x87\x8e\xcd\x1e\x7c\xf2\x39\x7c\xeb\xab\xac\x58\x39\x79\x8d\x7d\x46\xd2\xde\xa4\xbf\xd1\x44\x12\x92\x60\x6b\xe6\x42\x75\xcd\x30\xc1\x0c\xc2\xc6\xca\x89\x0b\x45\x7d\xef\xc5\x33\x6f\x12\x86\xd3\x99\xe7\xe3\x56\xb5\x7d\xf2\x65\xfe\x31\xfc\x6d\xe4\x58\x93\x2f\xc0\x32\xfe\x72\x04\x2c\x02\xd7\x2e\x7b\x4c\x01\x03\x52\xd4\xd9\x69\x8a\x48\x80\x25\xa8\xe2\x27\x8d\x15\x5c\x35\x97\x0b\x2b\xa7\x15\xf9\x14\xbd\xbe\xaa\xf9\x03\xb2\xae\x14\x88\x9b\x2d\xcb\x70\x89\x07\x82\xe1\xbf\x7d\x73\xae\x31\x0b\x47\x3f\x38\xab\xdf\x0e\x17\xbf\xaf\x55\xf8\x1d\x2e\x36\x2a\xc3\xcc\x57\xa5\x0f\x2b\x9c\x27\x4b\xd8\x06\x90\xea\x82\x1b\xb3\x4d\xf9\x20\x1f\x7d\x1b\xd1\x36\xf0\x76\x3f\xd9\xd9\x88\x4b\x9c\xce\x08\x14\x22\xcf\x11\x90\x41\x62\x78\xb5\x61\x32\x05\x20\xd6\x5e\xcd\xfc\x32\x17\xa6\x46\x89\xf5\x8c\xd6\x86\xed\x58\x47\x15\x4a\xe8\x29\x80\x13\x43\xd9\xac\x5f\x0a\xed\xc4\x88\xc8\x55\x22\x72\x79\x79\xa0\xe1\x58\x5d\xc1\x98\x7d\x93\x53\x22\xd8\x06\x6b\xe3\x6f\x93\x31\xaf\x5f\x3b\x40\x5d\x71\xd6\x15\x4b\x60\x6e\xe3\xd1\x93\xed\xf7\x76\xb5\x86\xc4\xd7\x8c\x0e\x2c\x89\xd3\x79\x58\xa6\x74\x03\x2c\xdf\x4f\x5b\x5a\x63\xed\x61\x18\xd3\x05\xdc\x75\xf8\x51\x8c\xb7\x35\xa8\x54\x12\x50\x80\x99\x6b\x36\x23\x91\x86\xad\xbf\xe9\x2f\xd7\xa4\x79\xf4\xf7\x7e\x93\xd9\x54\xca\x5e\xf1\x80\xd7\x13\x93\xb7\x95\xbd\x3a\x9f\x19\x4d\x3a\x89\xf3\xd6\x05\x0f\xeb\xf6\x5b\x42\x3e\x65\xcb\x2e\x24\x6f\xe8\xd7\x05\xcf\xdb\x4b\x18\x16\x59\xd7\x0b\x02\xe8\xbd\xec\xc6\x9c\xed\xfb\x25\x6b\xb5\x46\x63\xe6\x07\x95\x8b\x32\xfc\x51\x6e\x66\x9b\xef\x74\xe0\x25\x7d\x66\x65\x87\x46\x02\x57\xd6\x79\xa1\xb7\xb0\x6a\x47\x82\x7a\xf6\x65\x57\x7f\xf4\xb8\x42\xda\xb9\xbe\xf6\x38\x09\x96\x24\x4a\x58\x3e\x0d\x1f\xbc\x91\x7e\x44\x1d\xab\xf5\x91\x2a\x00\xfd\xe6\x8c\xbe\x07\xfe\x46\xdf\x8b\x92\xcf\x29\x21\xb1\x0d\xb5\x2f\x03\x14\x7b\xd7\xaa\xa9\x5d\x91\xfc\x50\xd5\x83\xf1\x79\x07\xc3\xe8\xd8\x6b\x89\xa1\xc3\x26\xdc\x9a\x96\x9e\x7e\x1b\xa6\x7e\x9b\x1c\x8e\xe9\xef\xfd\x7e\x1e\x65\x36\x7f\x3f\x6a\x7e\x2a\x9e\x87\x8e\x5b\x6d\x78\x0b\x8a\x7e\x93\xd0\x6c\x36\x8b\x7c\xc0\x7e\x93\x4a\xef\x4a\xe1\x91\x45\x5a\x6b\xdf\x28\x5d\xd3\x44\xb5\xea\x87\xca\xfe\x3a\x3d\x4e\xfa\x65\xda\xf8\x11\xf5\xf4\xe6\x76\xed\xdd\xd2\x37\x7e\x40\xbc\x9b\xd2\xa1\x92\x33\x97\xed\xd1\x2e\xa2\x7d\x4f\xde\x62\x2f\xc0\x69\x57\xf2\x14\x22\xb4\xdd\x96\xe2\x3e\x7f\xc6\x2a\xce\x90\x50\xcc\x07\xaf\x94\x2b\x8c\xe7\x4a\xcc\xbd\xd0\xa3\xae\x78\xbc\x54\xce\x79\x73\xdb\x45\x24\x00\x34\xf1\x9e\xc3\xd8\x7d\x98\x09\xe1\x9c\x89\x4d\x4b\x57\x32\xb2\x5d\x8a\x94\x80\xda\x17\x49\x30\x7e\x4d\x16\x95\x62\xaa\xa0\xd7\x1c\x15\x36\x61\x08\xc7\x98\xef\xfa\x35\x28\x94\x87\x68\x34\xe7\xa7\xf7\x39\xcb\x56\xed\x41\xa8\x39\x0d\x41\x08\x72\x26\x90\xf4\x03\x0a\x11\x63\x00\x2d\xc4\x83\x88\xa6\x19\xbf\x3d\xa2\x10\x8c\x8b\xd3\x3d\xc8\xcb\x26\x09\x86\x3c\x00\xd8\xf9\xcb\xf3\x82\x29\xec\xec\xaf\x21\xbb\x98\x63\xcf\x8d\x1e\x6f\xba\x8b\xe9\xe5\x5c\xa5\x31\xed\x9e\xad\x6b\x21\x14\x57\x0a\xc5\xa5\x18\x27\x15\x5e\x89\xb7\x87\x1f\x7f\x2f\x8b\xe9\xb7\xa4\xd1\x07\x18\x99\x17\x13\x1e\x27\x1c\xa3\x5c\x7c\xb6\x6d\x78\x06\xb4\xff\x71\x71\x7e\x76\x21\x31\xbc\x2f\x63\x72\xd3\x95\xfd\xcc\x2f\xce\xcf\x4c\xff\xa2\x7c\x3e\xe1\x3c\x5d\xfa\x2e\xac\xdc\x24\xc8\x87\x64\xb0\xa2\xc9\x66\xb0\xb6\xfd\x51\xd8\xe3\xf3\x21\xf5\x3c\xb5\xa8\xe9\xdf\xad\x56\x95\x9a\xdd\x0c\x77\xc2\x79\x92\x5a\xe1\xc0\x5b\xd0\x58\xbb\x04\xe7\xc4\xd2\xc9\x00\xf1\x97\x64\x6b\x9c\xc4\x4b\x6f\x65\x70\xa1\xdd\x62\x4f\x3f\xd0\xcf\xad\x3a\xa6\x5f\x42\x07\x0b\x8f\x58\x6c\xce\xc8\x62\x91\x25\xf2\xcc\xa9\x4b\x45\x4a\x67\xe9\xdb\xf4\xf9\xf7\xd9\x1a\xbd\xb2\xaf\xd3\x73\x4d\x7d\xef\x48\x8f\x15\x31\x43\x0a\xa7\xff\x2e\x4b\xa3\x25\xcf\x8c\xd8\xa0\x50\xa9\xbd\x1d\x35\x55\x39\xd5\x6c\x9f\x2d\xae\x93\xce\x53\xb2\x24\xd4\x8b\xf7\xe3\xb5\x97\x92\xba\xae\x98\xd1\x24\x91\xea\xa7\xa2\x1d\xf7\xd1\x58\x39\xee\x9d\x75\x64\x54\x21\xa7\x93\xe2\xa3\x64\xfb\x9c\x13\xfe\x44\x06\xe6\x4f\x3b\xd9\x7b\x2a\x89\xbf\xa6\xb7\x89\xaf\xd0\xb1\x3a\x13\x74\x11\xab\x69\x76\x9b\xf8\x28\x28\x8c\x02\xff\x83\x4b\xbb\xf3\x39\x00\xc5\x69\xee\x8d\x17\xbb\x33\xf8\xf8\x5a\xdc\xa6\x0d\xe6\xdf\xad\xf5\x83\x93\x8a\xd8\xde\x95\x01\xbe\x2a\x62\xa5\xea\xf8\x73\x54\x31\xf5\xfc\x4f\x38\x81\x16\x99\xc0\xd8\x2e\xfa\x38\xdc\xa7\x3b\xfa\xf9\xea\xea\x5c\xc5\x02\xcd\x74\x63\x8b\x93\x5f\xc4\xe9\x15\xbf\x70\x99\x2d\x56\x7b\xab\x4b\xb8\x14\xbd\xa6\x89\x36\x29\xa1\x4a\x07\x7a\x05\x87\x6f\x72\x21\xdd\xaf\xd6\xcd\x0c\x10\x34\x40\xf9\xdb\xb1\xf3\x64\xfc\xa4\xb8\x5c\xc3\x29\x1c\xc0\xf3\x1d\x94\x11\x95\xf7\x45\x95\xde\x2b\xaf\xab\x02\xc5\x92\xa6\x27\x7f\x21\xd5\x8d\x1e\xbe\xee\x3a\x8c\xd7\x6c\x55\x35\x0e\xd4\x9a\xb8\x29\x04\x94\xab\x62\xee\xaa\x70\x1b\x32\xd6\x46\xdb\xbb\x37\x2e\x5e\x93\x4a\x52\xe5\xda\x3a\x0d\xac\xfe\x6f\x00\x9c\xe2\x86\xdb\x59\x84\x01\x00")
 
 func assetsPrometheusK8sRulesYamlBytes() ([]byte, error) {
 	return bindataRead(
@@ -1782,7 +1782,7 @@ func assetsPrometheusK8sRulesYaml() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "assets/prometheus-k8s/rules.yaml", size: 98491, mode: os.FileMode(420), modTime: time.Unix(1, 0)}
+	info := bindataFileInfo{name: "assets/prometheus-k8s/rules.yaml", size: 99417, mode: os.FileMode(420), modTime: time.Unix(1, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
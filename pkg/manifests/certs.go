@@ -0,0 +1,533 @@
+// Copyright 2020 The Cluster Monitoring Operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package manifests
+
+import (
+	"bytes"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"fmt"
+	"math"
+	"math/big"
+	"reflect"
+	"sort"
+	"time"
+
+	"github.com/openshift/library-go/pkg/crypto"
+	"github.com/pkg/errors"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/klog"
+)
+
+const (
+	// Keys of the secrets holding a CA and its certificates.
+	caBundleKey   = "ca.crt"
+	caKeyKey      = "ca.key"
+	nextCACertKey = "next-ca.crt"
+	nextCAKeyKey  = "next-ca.key"
+
+	// GRPCTLSForcedRotationAnnotation makes the next reconciliation
+	// replace the CA and all its certificates right away.
+	GRPCTLSForcedRotationAnnotation = "monitoring.openshift.io/grpc-tls-forced-rotate"
+)
+
+// CertificateUsage tells what a leaf certificate authenticates.
+type CertificateUsage string
+
+const (
+	// ServerCertificate authenticates servers to their clients.
+	ServerCertificate CertificateUsage = "Server"
+	// ClientCertificate authenticates clients to servers.
+	ClientCertificate CertificateUsage = "Client"
+	// ServerClientCertificate authenticates both ways.
+	ServerClientCertificate CertificateUsage = "ServerClient"
+)
+
+// LeafCertificate declares a certificate issued by a CertificateAuthority.
+// Its certificate and key are stored under the "<Name>.crt" and
+// "<Name>.key" keys of the secret.
+type LeafCertificate struct {
+	Name string
+	// CommonName is the subject of client certificates. Server
+	// certificates are named after their first host.
+	CommonName string
+	// Hosts are the subject alternative names of server certificates.
+	Hosts []string
+	Usage CertificateUsage
+	// Lifetime defaults to the lifetime of the CA.
+	Lifetime time.Duration
+}
+
+// CertificateAuthority declares a CA and the certificates it issues, all
+// stored in a single secret. The "ca.crt" key holds the CA bundle: the
+// certificate of the signing CA, followed by the certificates of the CAs
+// which are trusted alongside it. The "ca.key" key holds the key of the
+// signing CA.
+//
+// The CA is replaced with a new key 1/5 of its lifetime before it expires.
+// The new CA is first added to the bundle and only signs certificates once
+// the overlap has passed, giving the bundle time to reach every consumer.
+// The previous CA stays in the bundle until it expires, so that the
+// certificates it signed remain valid until they are reissued.
+type CertificateAuthority struct {
+	// Name is the prefix of the common name of the CA.
+	Name string
+	// Lifetime defaults to the default certificate lifetime.
+	Lifetime time.Duration
+	// Overlap defaults to 1/10 of the lifetime.
+	Overlap      time.Duration
+	Certificates []LeafCertificate
+}
+
+func (a *CertificateAuthority) lifetime() time.Duration {
+	if a.Lifetime == 0 {
+		return certificateLifetime
+	}
+	return a.Lifetime
+}
+
+func (a *CertificateAuthority) overlap() time.Duration {
+	if a.Overlap == 0 {
+		return a.lifetime() / 10
+	}
+	return a.Overlap
+}
+
+// CertificateIssuer signs certificates with a CA which isn't managed by
+// the operator, e.g. an intermediate of a corporate PKI.
+type CertificateIssuer interface {
+	// CACertificates returns the certificate of the issuing CA followed by
+	// its chain.
+	CACertificates() []*x509.Certificate
+	// Sign issues a certificate from template for the public key pub.
+	Sign(template *x509.Certificate, pub interface{}) (*x509.Certificate, error)
+}
+
+// caIssuer issues certificates from a CA whose key is at hand.
+type caIssuer struct {
+	ca *crypto.CA
+}
+
+func (i caIssuer) CACertificates() []*x509.Certificate {
+	return i.ca.Config.Certs
+}
+
+func (i caIssuer) Sign(template *x509.Certificate, pub interface{}) (*x509.Certificate, error) {
+	serial, err := i.ca.SerialGenerator.Next(template)
+	if err != nil {
+		return nil, err
+	}
+	template.SerialNumber = big.NewInt(serial)
+	return createCertificate(template, i.ca.Config.Certs[0], pub, i.ca.Config.Key)
+}
+
+// NewCertificateIssuer returns an issuer signing with the CA of a
+// kubernetes.io/tls secret. The "tls.crt" key holds the certificate of the
+// CA followed by its chain and the "tls.key" key holds its private key.
+func NewCertificateIssuer(s *v1.Secret) (CertificateIssuer, error) {
+	ca, err := crypto.GetCAFromBytes(s.Data[v1.TLSCertKey], s.Data[v1.TLSPrivateKeyKey])
+	if err != nil {
+		return nil, errors.Wrapf(err, "reading CA from secret %s/%s failed", s.Namespace, s.Name)
+	}
+	c := ca.Config.Certs[0]
+	if !c.IsCA || (c.KeyUsage != 0 && c.KeyUsage&x509.KeyUsageCertSign == 0) {
+		return nil, errors.Errorf("certificate %q of secret %s/%s is not allowed to sign certificates", c.Subject.CommonName, s.Namespace, s.Name)
+	}
+	return caIssuer{ca: ca}, nil
+}
+
+// CAExpiringError reports that the CA of a CertificateIssuer reached the
+// point where the operator would have replaced its own CA. Certificates are
+// still issued from it, but their lifetime is cut short by the expiry.
+type CAExpiringError struct {
+	CommonName string
+	NotAfter   time.Time
+}
+
+func (e *CAExpiringError) Error() string {
+	return fmt.Sprintf("CA %q expires at %s, it must be replaced before the certificates it issued expire", e.CommonName, e.NotAfter.UTC().Format(time.RFC3339))
+}
+
+// Rotate creates the missing key material of the secret and rotates the CA
+// and the certificates which are about to expire or don't match their
+// declaration anymore.
+func (a *CertificateAuthority) Rotate(s *v1.Secret, now time.Time) error {
+	if s.Data == nil {
+		s.Data = map[string][]byte{}
+	}
+
+	_, forced := s.Annotations[GRPCTLSForcedRotationAnnotation]
+	delete(s.Annotations, GRPCTLSForcedRotationAnnotation)
+
+	signer, bundle, err := a.rotateCA(s, forced, now)
+	if err != nil {
+		return err
+	}
+
+	return a.issueCertificates(s, caIssuer{ca: signer}, bundle, forced, now, time.Time{})
+}
+
+// Issue is the counterpart of Rotate for CAs managed outside of the
+// operator: the certificates are issued by issuer, whose CA is trusted by
+// the bundle. The key material of the operator's own CA is removed from the
+// secret. Its certificates remain in the bundle until they expire so that
+// the certificates they signed are trusted until they were replaced
+// everywhere, but nothing can be signed with them anymore.
+//
+// An error is returned if the CA of the issuer isn't valid, the secret is
+// left untouched then. A *CAExpiringError is returned once the CA reached the
+// rotation threshold of the operator's own CAs, after the secret was updated.
+func (a *CertificateAuthority) Issue(s *v1.Secret, issuer CertificateIssuer, now time.Time) error {
+	chain := issuer.CACertificates()
+	if len(chain) == 0 {
+		return errors.New("the issuer has no CA certificate")
+	}
+	ca := chain[0]
+	if now.Before(ca.NotBefore) {
+		return errors.Errorf("CA %q is not valid before %s", ca.Subject.CommonName, ca.NotBefore.UTC().Format(time.RFC3339))
+	}
+	if !ca.NotAfter.After(now) {
+		return errors.Errorf("CA %q expired at %s", ca.Subject.CommonName, ca.NotAfter.UTC().Format(time.RFC3339))
+	}
+
+	if s.Data == nil {
+		s.Data = map[string][]byte{}
+	}
+
+	_, forced := s.Annotations[GRPCTLSForcedRotationAnnotation]
+	delete(s.Annotations, GRPCTLSForcedRotationAnnotation)
+
+	bundle := append([]*x509.Certificate(nil), chain...)
+	if previous, err := crypto.CertsFromPEM(s.Data[caBundleKey]); err == nil {
+		bundle = append(bundle, previous...)
+	}
+	delete(s.Data, caKeyKey)
+	delete(s.Data, nextCACertKey)
+	delete(s.Data, nextCAKeyKey)
+
+	// Certificates can't outlive a CA which isn't rotated along with them.
+	if err := a.issueCertificates(s, issuer, bundle, forced, now, ca.NotAfter); err != nil {
+		return err
+	}
+
+	if needsNewCert(ca.NotBefore, ca.NotAfter, func() time.Time { return now }) {
+		return &CAExpiringError{CommonName: ca.Subject.CommonName, NotAfter: ca.NotAfter}
+	}
+	return nil
+}
+
+// issueCertificates issues the declared certificates which need it and
+// stores the given CA bundle in the secret. Unless it is zero, maxNotAfter
+// bounds the validity of the certificates.
+func (a *CertificateAuthority) issueCertificates(s *v1.Secret, issuer CertificateIssuer, bundle []*x509.Certificate, forced bool, now, maxNotAfter time.Time) error {
+	for _, cert := range a.Certificates {
+		if err := a.rotateCertificate(s, issuer, cert, forced, now, maxNotAfter); err != nil {
+			return errors.Wrapf(err, "rotating certificate %s failed", cert.Name)
+		}
+	}
+
+	// Drop the duplicates and the CAs which can't have signed valid
+	// certificates anymore.
+	var trusted []*x509.Certificate
+	for _, c := range bundle {
+		if c.NotAfter.After(now) && !containsCertificate(trusted, c) {
+			trusted = append(trusted, c)
+		}
+	}
+	b, err := crypto.EncodeCertificates(trusted...)
+	if err != nil {
+		return errors.Wrap(err, "encoding CA bundle failed")
+	}
+	s.Data[caBundleKey] = b
+
+	return nil
+}
+
+// rotateCA returns the signing CA of the secret and the CA bundle to
+// trust, creating, staging or promoting CAs as needed.
+func (a *CertificateAuthority) rotateCA(s *v1.Secret, forced bool, now time.Time) (*crypto.CA, []*x509.Certificate, error) {
+	current, err := crypto.GetCAFromBytes(s.Data[caBundleKey], s.Data[caKeyKey])
+	if err != nil {
+		if len(s.Data[caBundleKey]) > 0 && len(s.Data[caKeyKey]) > 0 {
+			klog.Warningf("generating a new CA due to error reading CA: %v", err)
+		}
+		current = nil
+	}
+
+	var next *crypto.CA
+	if _, ok := s.Data[nextCACertKey]; ok {
+		next, err = crypto.GetCAFromBytes(s.Data[nextCACertKey], s.Data[nextCAKeyKey])
+		if err != nil {
+			klog.Warningf("discarding the next CA due to error reading it: %v", err)
+			next = nil
+		}
+	}
+
+	switch {
+	case current == nil || forced || !current.Config.Certs[0].NotAfter.After(now):
+		// Replace the CA right away, the certificates are reissued.
+		ca, err := a.newCA(now)
+		if err != nil {
+			return nil, nil, err
+		}
+		var bundle []*x509.Certificate
+		if current != nil {
+			bundle = current.Config.Certs
+		} else if previous, err := crypto.CertsFromPEM(s.Data[caBundleKey]); err == nil {
+			// The CAs of an issuer which was used before remain trusted.
+			bundle = previous
+		}
+		return a.promote(s, ca, bundle)
+
+	case next != nil:
+		if now.Sub(next.Config.Certs[0].NotBefore) >= a.overlap() {
+			return a.promote(s, next, current.Config.Certs)
+		}
+		return signingCA(current), withNext(current, next), nil
+
+	case needsNewCert(current.Config.Certs[0].NotBefore, current.Config.Certs[0].NotAfter, func() time.Time { return now }):
+		// Stage the new CA, it signs certificates once the bundle had time
+		// to reach every consumer.
+		ca, err := a.newCA(now)
+		if err != nil {
+			return nil, nil, err
+		}
+		crt, key, err := ca.Config.GetPEMBytes()
+		if err != nil {
+			return nil, nil, errors.Wrap(err, "error getting PEM bytes from CA")
+		}
+		s.Data[nextCACertKey] = crt
+		s.Data[nextCAKeyKey] = key
+		return signingCA(current), withNext(current, ca), nil
+	}
+
+	return signingCA(current), current.Config.Certs, nil
+}
+
+// promote makes ca the signing CA of the secret, trusted along with the
+// given CAs.
+func (a *CertificateAuthority) promote(s *v1.Secret, ca *crypto.CA, trusted []*x509.Certificate) (*crypto.CA, []*x509.Certificate, error) {
+	crt, key, err := ca.Config.GetPEMBytes()
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "error getting PEM bytes from CA")
+	}
+	s.Data[caBundleKey] = crt
+	s.Data[caKeyKey] = key
+	delete(s.Data, nextCACertKey)
+	delete(s.Data, nextCAKeyKey)
+
+	return signingCA(ca), append([]*x509.Certificate{ca.Config.Certs[0]}, trusted...), nil
+}
+
+// withNext returns the bundle of current with the next CA following the
+// signing CA.
+func withNext(current, next *crypto.CA) []*x509.Certificate {
+	bundle := []*x509.Certificate{current.Config.Certs[0], next.Config.Certs[0]}
+	return append(bundle, current.Config.Certs[1:]...)
+}
+
+// newCA returns a self-signed CA valid from now on.
+func (a *CertificateAuthority) newCA(now time.Time) (*crypto.CA, error) {
+	pub, priv, err := crypto.NewKeyPair()
+	if err != nil {
+		return nil, errors.Wrap(err, "error generating CA key")
+	}
+	serial, err := rand.Int(rand.Reader, big.NewInt(math.MaxInt64))
+	if err != nil {
+		return nil, errors.Wrap(err, "error generating CA serial number")
+	}
+
+	template := &x509.Certificate{
+		Subject:               pkix.Name{CommonName: fmt.Sprintf("%s@%d", a.Name, now.Unix())},
+		SerialNumber:          serial,
+		SignatureAlgorithm:    x509.SHA256WithRSA,
+		NotBefore:             now.Add(-1 * time.Second),
+		NotAfter:              now.Add(a.lifetime()),
+		KeyUsage:              x509.KeyUsageKeyEncipherment | x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	cert, err := createCertificate(template, template, pub, priv)
+	if err != nil {
+		return nil, errors.Wrap(err, "error generating self signed CA")
+	}
+
+	return &crypto.CA{
+		SerialGenerator: &crypto.RandomSerialGenerator{},
+		Config: &crypto.TLSCertificateConfig{
+			Certs: []*x509.Certificate{cert},
+			Key:   priv,
+		},
+	}, nil
+}
+
+// createCertificate creates a new certificate and returns it in x509.Certificate form.
+func createCertificate(template, parent *x509.Certificate, pub, priv interface{}) (*x509.Certificate, error) {
+	rawCert, err := x509.CreateCertificate(rand.Reader, template, parent, pub, priv)
+	if err != nil {
+		return nil, fmt.Errorf("error creating certificate: %v", err)
+	}
+	parsedCerts, err := x509.ParseCertificates(rawCert)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing certificate: %v", err)
+	}
+	return parsedCerts[0], nil
+}
+
+// signingCA returns ca without the other CAs of its bundle, which would
+// otherwise be appended to the certificates it signs.
+func signingCA(ca *crypto.CA) *crypto.CA {
+	return &crypto.CA{
+		SerialGenerator: ca.SerialGenerator,
+		Config: &crypto.TLSCertificateConfig{
+			Certs: ca.Config.Certs[:1:1],
+			Key:   ca.Config.Key,
+		},
+	}
+}
+
+// rotateCertificate issues cert unless the secret holds a valid one
+// signed by the CA of issuer.
+func (a *CertificateAuthority) rotateCertificate(s *v1.Secret, issuer CertificateIssuer, cert LeafCertificate, forced bool, now, maxNotAfter time.Time) error {
+	crtKey, keyKey := cert.Name+".crt", cert.Name+".key"
+	chain := issuer.CACertificates()
+	signer := chain[0]
+
+	if !forced {
+		current, err := crypto.GetTLSCertificateConfigFromBytes(s.Data[crtKey], s.Data[keyKey])
+		if err == nil && !cert.needsReissue(current.Certs[0], signer, now) {
+			return nil
+		}
+	}
+
+	lifetime := cert.Lifetime
+	if lifetime == 0 {
+		lifetime = a.lifetime()
+	}
+	notAfter := now.Add(lifetime)
+	if !maxNotAfter.IsZero() && notAfter.After(maxNotAfter) {
+		notAfter = maxNotAfter
+	}
+
+	template, err := cert.template(now, notAfter)
+	if err != nil {
+		return err
+	}
+	pub, priv, err := crypto.NewKeyPair()
+	if err != nil {
+		return errors.Wrap(err, "error generating key")
+	}
+	c, err := issuer.Sign(template, pub)
+	if err != nil {
+		return errors.Wrap(err, "error signing certificate")
+	}
+
+	cfg := &crypto.TLSCertificateConfig{Certs: []*x509.Certificate{c}, Key: priv}
+	if cert.Usage != ClientCertificate {
+		// Servers present the chain, clients don't present the CA.
+		cfg.Certs = append(cfg.Certs, chain...)
+	}
+
+	crt, key, err := cfg.GetPEMBytes()
+	if err != nil {
+		return errors.Wrap(err, "error getting PEM bytes for certificate")
+	}
+	s.Data[crtKey] = crt
+	s.Data[keyKey] = key
+
+	return nil
+}
+
+// template returns the template of cert valid from now until notAfter.
+// Server certificates are named after their first host.
+func (cert LeafCertificate) template(now, notAfter time.Time) (*x509.Certificate, error) {
+	template := &x509.Certificate{
+		SignatureAlgorithm:    x509.SHA256WithRSA,
+		NotBefore:             now.Add(-1 * time.Second),
+		NotAfter:              notAfter,
+		KeyUsage:              x509.KeyUsageKeyEncipherment | x509.KeyUsageDigitalSignature,
+		BasicConstraintsValid: true,
+	}
+
+	switch cert.Usage {
+	case ClientCertificate:
+		template.Subject = pkix.Name{CommonName: cert.CommonName}
+		template.ExtKeyUsage = []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth}
+		return template, nil
+	case ServerCertificate:
+		template.ExtKeyUsage = []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth}
+	case ServerClientCertificate:
+		template.ExtKeyUsage = []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth}
+	default:
+		return nil, errors.Errorf("unknown usage %q", cert.Usage)
+	}
+
+	hosts := sets.NewString(cert.Hosts...).List()
+	if len(hosts) == 0 {
+		return nil, errors.New("server certificates need at least one host")
+	}
+	template.Subject = pkix.Name{CommonName: hosts[0]}
+	template.IPAddresses, template.DNSNames = crypto.IPAddressesDNSNames(hosts)
+
+	return template, nil
+}
+
+// needsReissue returns true if c is about to expire, wasn't signed by
+// signer or doesn't match the declaration.
+func (cert LeafCertificate) needsReissue(c, signer *x509.Certificate, now time.Time) bool {
+	if needsNewCert(c.NotBefore, c.NotAfter, func() time.Time { return now }) {
+		return true
+	}
+	if !bytes.Equal(c.RawIssuer, signer.RawSubject) || c.CheckSignatureFrom(signer) != nil {
+		return true
+	}
+
+	if hasExtKeyUsage(c, x509.ExtKeyUsageServerAuth) != (cert.Usage != ClientCertificate) ||
+		hasExtKeyUsage(c, x509.ExtKeyUsageClientAuth) != (cert.Usage != ServerCertificate) {
+		return true
+	}
+
+	switch cert.Usage {
+	case ClientCertificate:
+		return c.Subject.CommonName != cert.CommonName
+	default:
+		hosts := append([]string(nil), c.DNSNames...)
+		for _, ip := range c.IPAddresses {
+			hosts = append(hosts, ip.String())
+		}
+		sort.Strings(hosts)
+		return !reflect.DeepEqual(hosts, sets.NewString(cert.Hosts...).List())
+	}
+}
+
+func hasExtKeyUsage(c *x509.Certificate, usage x509.ExtKeyUsage) bool {
+	for _, u := range c.ExtKeyUsage {
+		if u == usage {
+			return true
+		}
+	}
+	return false
+}
+
+func containsCertificate(certs []*x509.Certificate, c *x509.Certificate) bool {
+	for _, cert := range certs {
+		if cert.Equal(c) {
+			return true
+		}
+	}
+	return false
+}
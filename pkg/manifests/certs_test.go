@@ -0,0 +1,399 @@
+// Copyright 2020 The Cluster Monitoring Operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package manifests
+
+import (
+	"bytes"
+	"crypto/x509"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/openshift/library-go/pkg/crypto"
+	"github.com/pkg/errors"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+func newTestCertificateAuthority() *CertificateAuthority {
+	return &CertificateAuthority{
+		Name:     "test",
+		Lifetime: 10 * time.Hour,
+		Certificates: []LeafCertificate{
+			{Name: "client", CommonName: "foo", Usage: ClientCertificate},
+			{Name: "server", Hosts: []string{"bar", "baz"}, Usage: ServerCertificate},
+			{Name: "peer", Hosts: []string{"qux"}, Usage: ServerClientCertificate, Lifetime: 20 * time.Hour},
+		},
+	}
+}
+
+func parseCertificates(t *testing.T, b []byte) []*x509.Certificate {
+	t.Helper()
+	certs, err := crypto.CertsFromPEM(b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return certs
+}
+
+func TestCertificateAuthorityIssuesDeclaredCertificates(t *testing.T) {
+	a := newTestCertificateAuthority()
+	s := &v1.Secret{}
+	now := time.Now()
+
+	if err := a.Rotate(s, now); err != nil {
+		t.Fatal(err)
+	}
+
+	ca := parseCertificates(t, s.Data["ca.crt"])
+	if len(ca) != 1 {
+		t.Fatalf("expected 1 CA in the bundle, got %d", len(ca))
+	}
+
+	for _, tc := range []struct {
+		name      string
+		cn        string
+		hosts     []string
+		usages    []x509.ExtKeyUsage
+		lifetime  time.Duration
+		chainSize int
+	}{
+		{name: "client", cn: "foo", usages: []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth}, lifetime: 10 * time.Hour, chainSize: 1},
+		{name: "server", cn: "bar", hosts: []string{"bar", "baz"}, usages: []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth}, lifetime: 10 * time.Hour, chainSize: 2},
+		{name: "peer", cn: "qux", hosts: []string{"qux"}, usages: []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth}, lifetime: 20 * time.Hour, chainSize: 2},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			if _, err := crypto.GetTLSCertificateConfigFromBytes(s.Data[tc.name+".crt"], s.Data[tc.name+".key"]); err != nil {
+				t.Fatal(err)
+			}
+
+			certs := parseCertificates(t, s.Data[tc.name+".crt"])
+			if len(certs) != tc.chainSize {
+				t.Fatalf("expected %d certificates, got %d", tc.chainSize, len(certs))
+			}
+			c := certs[0]
+			if c.Subject.CommonName != tc.cn {
+				t.Errorf("expected common name %q, got %q", tc.cn, c.Subject.CommonName)
+			}
+			if !reflect.DeepEqual(c.DNSNames, tc.hosts) {
+				t.Errorf("expected hosts %v, got %v", tc.hosts, c.DNSNames)
+			}
+			if !reflect.DeepEqual(c.ExtKeyUsage, tc.usages) {
+				t.Errorf("expected usages %v, got %v", tc.usages, c.ExtKeyUsage)
+			}
+			if d := c.NotAfter.Sub(c.NotBefore); d < tc.lifetime || d > tc.lifetime+time.Minute {
+				t.Errorf("expected lifetime %s, got %s", tc.lifetime, d)
+			}
+			if err := c.CheckSignatureFrom(ca[0]); err != nil {
+				t.Errorf("expected the certificate to be signed by the CA: %v", err)
+			}
+		})
+	}
+
+	// Nothing changes as long as the certificates are valid.
+	pre := s.DeepCopy()
+	if err := a.Rotate(s, now.Add(time.Hour)); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(pre.Data, s.Data) {
+		t.Fatal("expected the key material to be kept")
+	}
+
+	// Changed declarations are reissued.
+	a.Certificates[1].Hosts = []string{"bar"}
+	if err := a.Rotate(s, now.Add(time.Hour)); err != nil {
+		t.Fatal(err)
+	}
+	if bytes.Equal(pre.Data["server.crt"], s.Data["server.crt"]) {
+		t.Fatal("expected the server certificate to be reissued")
+	}
+	if !bytes.Equal(pre.Data["client.crt"], s.Data["client.crt"]) {
+		t.Fatal("expected the client certificate to be kept")
+	}
+}
+
+func TestCertificateAuthorityOverlapsCAs(t *testing.T) {
+	a := newTestCertificateAuthority()
+	s := &v1.Secret{}
+	t0 := time.Now()
+
+	if err := a.Rotate(s, t0); err != nil {
+		t.Fatal(err)
+	}
+	initial := s.DeepCopy()
+	initialCA := parseCertificates(t, initial.Data["ca.crt"])[0]
+
+	// 4/5 into its lifetime, the next CA is staged.
+	if err := a.Rotate(s, t0.Add(8*time.Hour+30*time.Minute)); err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(initial.Data["ca.key"], s.Data["ca.key"]) {
+		t.Fatal("expected the CA key to be kept while the next CA is staged")
+	}
+	if _, ok := s.Data["next-ca.key"]; !ok {
+		t.Fatal("expected the next CA to be staged")
+	}
+	bundle := parseCertificates(t, s.Data["ca.crt"])
+	if len(bundle) != 2 || !bundle[0].Equal(initialCA) {
+		t.Fatalf("expected the bundle to hold the current and the next CA, got %d certificates", len(bundle))
+	}
+	next := bundle[1]
+
+	// The staged CA is kept until the overlap passed.
+	staged := s.DeepCopy()
+	if err := a.Rotate(s, t0.Add(9*time.Hour)); err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(staged.Data["ca.crt"], s.Data["ca.crt"]) || !bytes.Equal(staged.Data["ca.key"], s.Data["ca.key"]) {
+		t.Fatal("expected the staged CA to be kept")
+	}
+
+	// Once the overlap passed, the next CA signs the certificates and the
+	// previous one remains trusted.
+	if err := a.Rotate(s, t0.Add(9*time.Hour+45*time.Minute)); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := s.Data["next-ca.crt"]; ok {
+		t.Fatal("expected the next CA to be promoted")
+	}
+	if bytes.Equal(initial.Data["ca.key"], s.Data["ca.key"]) {
+		t.Fatal("expected the CA key to be rotated")
+	}
+	bundle = parseCertificates(t, s.Data["ca.crt"])
+	if len(bundle) != 2 || !bundle[0].Equal(next) || !bundle[1].Equal(initialCA) {
+		t.Fatalf("expected the bundle to hold the promoted and the previous CA, got %d certificates", len(bundle))
+	}
+	for _, name := range []string{"client", "server", "peer"} {
+		c := parseCertificates(t, s.Data[name+".crt"])[0]
+		if err := c.CheckSignatureFrom(next); err != nil {
+			t.Errorf("expected certificate %s to be signed by the promoted CA: %v", name, err)
+		}
+	}
+
+	// The previous CA is dropped once it expired.
+	if err := a.Rotate(s, t0.Add(10*time.Hour+time.Minute)); err != nil {
+		t.Fatal(err)
+	}
+	bundle = parseCertificates(t, s.Data["ca.crt"])
+	if len(bundle) != 1 || !bundle[0].Equal(next) {
+		t.Fatalf("expected the bundle to hold only the current CA, got %d certificates", len(bundle))
+	}
+}
+
+func TestCertificateAuthorityReplacesExpiredCA(t *testing.T) {
+	a := newTestCertificateAuthority()
+	s := &v1.Secret{}
+	now := time.Now()
+
+	if err := a.Rotate(s, now); err != nil {
+		t.Fatal(err)
+	}
+	pre := s.DeepCopy()
+
+	// The operator didn't run while the CA expired.
+	if err := a.Rotate(s, now.Add(11*time.Hour)); err != nil {
+		t.Fatal(err)
+	}
+
+	if bytes.Equal(pre.Data["ca.key"], s.Data["ca.key"]) {
+		t.Fatal("expected the CA key to be rotated")
+	}
+	if bundle := parseCertificates(t, s.Data["ca.crt"]); len(bundle) != 1 {
+		t.Fatalf("expected the expired CA to be dropped, got %d certificates", len(bundle))
+	}
+}
+
+func TestCertificateAuthorityForcedRotation(t *testing.T) {
+	a := newTestCertificateAuthority()
+	s := &v1.Secret{ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{}}}
+	now := time.Now()
+
+	if err := a.Rotate(s, now); err != nil {
+		t.Fatal(err)
+	}
+	pre := s.DeepCopy()
+
+	s.Annotations[GRPCTLSForcedRotationAnnotation] = "true"
+	if err := a.Rotate(s, now); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, ok := s.Annotations[GRPCTLSForcedRotationAnnotation]; ok {
+		t.Fatal("expected the annotation to be removed")
+	}
+	if bytes.Equal(pre.Data["ca.key"], s.Data["ca.key"]) {
+		t.Fatal("expected the CA key to be rotated")
+	}
+	for _, name := range []string{"client", "server", "peer"} {
+		if bytes.Equal(pre.Data[name+".crt"], s.Data[name+".crt"]) {
+			t.Errorf("expected certificate %s to be reissued", name)
+		}
+	}
+	if bundle := parseCertificates(t, s.Data["ca.crt"]); len(bundle) != 2 {
+		t.Fatalf("expected the previous CA to remain trusted, got %d certificates", len(bundle))
+	}
+}
+
+// stubIssuer stands in for an external signer, it signs with an
+// intermediate CA and counts the issued certificates.
+type stubIssuer struct {
+	ca     *crypto.CA
+	issued int
+}
+
+func newStubIssuer(t *testing.T, lifetime time.Duration) *stubIssuer {
+	t.Helper()
+
+	root, err := crypto.MakeSelfSignedCAConfigForDuration("root", 2*lifetime)
+	if err != nil {
+		t.Fatal(err)
+	}
+	intermediate, err := crypto.MakeCAConfigForDuration("intermediate", lifetime, &crypto.CA{Config: root, SerialGenerator: &crypto.RandomSerialGenerator{}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &stubIssuer{ca: &crypto.CA{Config: intermediate, SerialGenerator: &crypto.RandomSerialGenerator{}}}
+}
+
+func (i *stubIssuer) CACertificates() []*x509.Certificate {
+	return i.ca.Config.Certs
+}
+
+func (i *stubIssuer) Sign(template *x509.Certificate, pub interface{}) (*x509.Certificate, error) {
+	i.issued++
+	return caIssuer{ca: i.ca}.Sign(template, pub)
+}
+
+func TestCertificateAuthorityIssue(t *testing.T) {
+	a := newTestCertificateAuthority()
+	s := &v1.Secret{}
+	now := time.Now()
+
+	// The secret was managed by the operator's own CA so far.
+	if err := a.Rotate(s, now); err != nil {
+		t.Fatal(err)
+	}
+	ownCA := parseCertificates(t, s.Data["ca.crt"])[0]
+
+	issuer := newStubIssuer(t, 5*time.Hour)
+	intermediate, root := issuer.ca.Config.Certs[0], issuer.ca.Config.Certs[1]
+	if err := a.Issue(s, issuer, now); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, ok := s.Data["ca.key"]; ok {
+		t.Fatal("expected the key of the operator's CA to be removed")
+	}
+	bundle := parseCertificates(t, s.Data["ca.crt"])
+	if len(bundle) != 3 || !bundle[0].Equal(intermediate) || !bundle[1].Equal(root) || !bundle[2].Equal(ownCA) {
+		t.Fatalf("expected the bundle to hold the issuer chain and the previous CA, got %d certificates", len(bundle))
+	}
+	if issuer.issued != 3 {
+		t.Fatalf("expected 3 certificates to be issued, got %d", issuer.issued)
+	}
+
+	roots := x509.NewCertPool()
+	roots.AddCert(root)
+	for _, tc := range []struct {
+		name      string
+		usage     x509.ExtKeyUsage
+		chainSize int
+	}{
+		{name: "client", usage: x509.ExtKeyUsageClientAuth, chainSize: 1},
+		{name: "server", usage: x509.ExtKeyUsageServerAuth, chainSize: 3},
+		{name: "peer", usage: x509.ExtKeyUsageServerAuth, chainSize: 3},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			certs := parseCertificates(t, s.Data[tc.name+".crt"])
+			if len(certs) != tc.chainSize {
+				t.Fatalf("expected %d certificates, got %d", tc.chainSize, len(certs))
+			}
+			intermediates := x509.NewCertPool()
+			intermediates.AddCert(intermediate)
+			if _, err := certs[0].Verify(x509.VerifyOptions{Roots: roots, Intermediates: intermediates, KeyUsages: []x509.ExtKeyUsage{tc.usage}}); err != nil {
+				t.Fatalf("expected the certificate to chain to the root: %v", err)
+			}
+			if certs[0].NotAfter.After(intermediate.NotAfter) {
+				t.Fatalf("expected the certificate not to outlive the CA, got %s", certs[0].NotAfter)
+			}
+		})
+	}
+
+	// Nothing is issued as long as the certificates are valid.
+	if err := a.Issue(s, issuer, now.Add(time.Hour)); err != nil {
+		t.Fatal(err)
+	}
+	if issuer.issued != 3 {
+		t.Fatalf("expected the certificates to be kept, got %d issued", issuer.issued)
+	}
+
+	// Certificates are still issued from a CA which is about to expire but
+	// it is reported.
+	err := a.Issue(s, issuer, now.Add(4*time.Hour+30*time.Minute))
+	var expiring *CAExpiringError
+	if !errors.As(err, &expiring) {
+		t.Fatalf("expected a CAExpiringError, got %v", err)
+	}
+	if issuer.issued != 6 {
+		t.Fatalf("expected the certificates to be reissued, got %d issued", issuer.issued)
+	}
+
+	// Expired CAs are rejected.
+	pre := s.DeepCopy()
+	if err := a.Issue(s, issuer, now.Add(6*time.Hour)); err == nil || errors.As(err, &expiring) {
+		t.Fatalf("expected an error about the expired CA, got %v", err)
+	}
+	if !reflect.DeepEqual(pre, s) {
+		t.Fatal("expected the secret to be left untouched")
+	}
+}
+
+func TestNewCertificateIssuer(t *testing.T) {
+	issuer := newStubIssuer(t, time.Hour)
+	crt, key, err := issuer.ca.Config.GetPEMBytes()
+	if err != nil {
+		t.Fatal(err)
+	}
+	leaf, err := issuer.ca.MakeServerCertForDuration(sets.NewString("foo"), time.Hour)
+	if err != nil {
+		t.Fatal(err)
+	}
+	leafCrt, leafKey, err := leaf.GetPEMBytes()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, tc := range []struct {
+		name  string
+		data  map[string][]byte
+		valid bool
+	}{
+		{name: "CA", data: map[string][]byte{"tls.crt": crt, "tls.key": key}, valid: true},
+		{name: "missing key", data: map[string][]byte{"tls.crt": crt}},
+		{name: "mismatching key", data: map[string][]byte{"tls.crt": crt, "tls.key": leafKey}},
+		{name: "not a CA", data: map[string][]byte{"tls.crt": leafCrt, "tls.key": leafKey}},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := NewCertificateIssuer(&v1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "foo", Name: "ca"},
+				Data:       tc.data,
+			})
+			if tc.valid != (err == nil) {
+				t.Fatalf("expected valid=%v, got %v", tc.valid, err)
+			}
+		})
+	}
+}
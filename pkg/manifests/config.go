@@ -19,11 +19,12 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"io/ioutil"
 
 	monv1 "github.com/coreos/prometheus-operator/pkg/apis/monitoring/v1"
 	configv1 "github.com/openshift/api/config/v1"
 	v1 "k8s.io/api/core/v1"
-	k8syaml "k8s.io/apimachinery/pkg/util/yaml"
+	"k8s.io/klog"
 )
 
 const (
@@ -37,6 +38,13 @@ type Config struct {
 
 	ClusterMonitoringConfiguration *ClusterMonitoringConfiguration `json:"-"`
 	UserWorkloadConfiguration      *UserWorkloadConfiguration      `json:"-"`
+
+	// deprecatedFields are the deprecated fields set in the configuration
+	// before defaults were applied.
+	deprecatedFields []string
+	// removedFields are the paths of the fields set in the configuration
+	// which earlier releases accepted and which are now ignored.
+	removedFields []string
 }
 
 type ClusterMonitoringConfiguration struct {
@@ -52,6 +60,8 @@ type ClusterMonitoringConfiguration struct {
 	K8sPrometheusAdapter     *K8sPrometheusAdapter        `json:"k8sPrometheusAdapter"`
 	ThanosQuerierConfig      *ThanosQuerierConfig         `json:"thanosQuerier"`
 	UserWorkloadEnabled      *bool                        `json:"enableUserWorkload"`
+	GRPCTLSConfig            *GRPCTLSConfig               `json:"grpcTLS"`
+	SecretRotationConfig     *SecretRotationConfig        `json:"secretRotation"`
 	// TODO: Remove in 4.7 release.
 	PrometheusUserWorkloadConfig         *PrometheusK8sConfig      `json:"prometheusUserWorkload"`
 	PrometheusOperatorUserWorkloadConfig *PrometheusOperatorConfig `json:"prometheusOperatorUserWorkload"`
@@ -59,6 +69,19 @@ type ClusterMonitoringConfiguration struct {
 	UserWorkloadConfig                   *UserWorkloadConfig       `json:"techPreviewUserWorkload"`
 }
 
+// ManagementState tells the operator how to handle the objects of a
+// component.
+type ManagementState string
+
+const (
+	// Managed components are reconciled, it is the default.
+	Managed ManagementState = "Managed"
+	// Unmanaged components are left alone, e.g. while debugging them.
+	Unmanaged ManagementState = "Unmanaged"
+	// Removed components are deleted.
+	Removed ManagementState = "Removed"
+)
+
 type Images struct {
 	K8sPrometheusAdapter     string
 	PromLabelProxy           string
@@ -84,12 +107,14 @@ type HTTPConfig struct {
 }
 
 type PrometheusOperatorConfig struct {
-	LogLevel     string            `json:"logLevel"`
-	NodeSelector map[string]string `json:"nodeSelector"`
-	Tolerations  []v1.Toleration   `json:"tolerations"`
+	ManagementState ManagementState   `json:"managementState"`
+	LogLevel        string            `json:"logLevel"`
+	NodeSelector    map[string]string `json:"nodeSelector"`
+	Tolerations     []v1.Toleration   `json:"tolerations"`
 }
 
 type PrometheusK8sConfig struct {
+	ManagementState     ManagementState                      `json:"managementState"`
 	LogLevel            string                               `json:"logLevel"`
 	Retention           string                               `json:"retention"`
 	NodeSelector        map[string]string                    `json:"nodeSelector"`
@@ -102,6 +127,7 @@ type PrometheusK8sConfig struct {
 }
 
 type AlertmanagerMainConfig struct {
+	ManagementState     ManagementState                      `json:"managementState"`
 	NodeSelector        map[string]string                    `json:"nodeSelector"`
 	Tolerations         []v1.Toleration                      `json:"tolerations"`
 	Resources           *v1.ResourceRequirements             `json:"resources"`
@@ -117,29 +143,34 @@ type ThanosRulerConfig struct {
 }
 
 type ThanosQuerierConfig struct {
-	NodeSelector map[string]string        `json:"nodeSelector"`
-	Tolerations  []v1.Toleration          `json:"tolerations"`
-	Resources    *v1.ResourceRequirements `json:"resources"`
+	ManagementState ManagementState          `json:"managementState"`
+	NodeSelector    map[string]string        `json:"nodeSelector"`
+	Tolerations     []v1.Toleration          `json:"tolerations"`
+	Resources       *v1.ResourceRequirements `json:"resources"`
 }
 
 type GrafanaConfig struct {
-	NodeSelector map[string]string `json:"nodeSelector"`
-	Tolerations  []v1.Toleration   `json:"tolerations"`
+	ManagementState ManagementState   `json:"managementState"`
+	NodeSelector    map[string]string `json:"nodeSelector"`
+	Tolerations     []v1.Toleration   `json:"tolerations"`
 }
 
 type KubeStateMetricsConfig struct {
-	NodeSelector map[string]string `json:"nodeSelector"`
-	Tolerations  []v1.Toleration   `json:"tolerations"`
+	ManagementState ManagementState   `json:"managementState"`
+	NodeSelector    map[string]string `json:"nodeSelector"`
+	Tolerations     []v1.Toleration   `json:"tolerations"`
 }
 
 type OpenShiftStateMetricsConfig struct {
-	NodeSelector map[string]string `json:"nodeSelector"`
-	Tolerations  []v1.Toleration   `json:"tolerations"`
+	ManagementState ManagementState   `json:"managementState"`
+	NodeSelector    map[string]string `json:"nodeSelector"`
+	Tolerations     []v1.Toleration   `json:"tolerations"`
 }
 
 type K8sPrometheusAdapter struct {
-	NodeSelector map[string]string `json:"nodeSelector"`
-	Tolerations  []v1.Toleration   `json:"tolerations"`
+	ManagementState ManagementState   `json:"managementState"`
+	NodeSelector    map[string]string `json:"nodeSelector"`
+	Tolerations     []v1.Toleration   `json:"tolerations"`
 }
 
 type EtcdConfig struct {
@@ -155,11 +186,31 @@ func (e *EtcdConfig) IsEnabled() bool {
 	return *e.Enabled
 }
 
+// GRPCTLSConfig configures the certificates securing the Thanos gRPC
+// connections.
+type GRPCTLSConfig struct {
+	// CASecret names a kubernetes.io/tls secret in the namespace of the
+	// operator holding the CA which issues the certificates, followed by its
+	// chain. The operator manages its own CA if it is empty.
+	CASecret string `json:"caSecret"`
+}
+
+// SecretRotationConfig configures the rotation of the credentials generated
+// by the operator: the session cookie secrets of the OAuth proxies and the
+// Prometheus credential of Grafana along with the htpasswd secrets accepting
+// it.
+type SecretRotationConfig struct {
+	// Period is the age after which the generated secrets are rotated, e.g.
+	// 30d. They are only rotated on request if it is empty.
+	Period string `json:"period"`
+}
+
 type UserWorkloadConfig struct {
 	Enabled *bool `json:"enabled"`
 }
 
 type TelemeterClientConfig struct {
+	ManagementState    ManagementState   `json:"managementState"`
 	ClusterID          string            `json:"clusterID"`
 	Enabled            *bool             `json:"enabled"`
 	TelemeterServerURL string            `json:"telemeterServerURL"`
@@ -174,6 +225,7 @@ func (cfg *TelemeterClientConfig) IsEnabled() bool {
 	}
 
 	if (cfg.Enabled != nil && *cfg.Enabled == false) ||
+		cfg.ManagementState == Removed ||
 		cfg.ClusterID == "" ||
 		cfg.Token == "" {
 		return false
@@ -182,14 +234,55 @@ func (cfg *TelemeterClientConfig) IsEnabled() bool {
 	return true
 }
 
+// ManagementStates returns the management state of every component which can
+// be configured, keyed by the name of its configuration field. Components
+// without an explicit state are Managed.
+func (c *ClusterMonitoringConfiguration) ManagementStates() map[string]ManagementState {
+	states := map[string]ManagementState{
+		"prometheusOperator":    c.PrometheusOperatorConfig.ManagementState,
+		"prometheusK8s":         c.PrometheusK8sConfig.ManagementState,
+		"alertmanagerMain":      c.AlertmanagerMainConfig.ManagementState,
+		"kubeStateMetrics":      c.KubeStateMetricsConfig.ManagementState,
+		"openshiftStateMetrics": c.OpenShiftMetricsConfig.ManagementState,
+		"grafana":               c.GrafanaConfig.ManagementState,
+		"telemeterClient":       c.TelemeterClientConfig.ManagementState,
+		"k8sPrometheusAdapter":  c.K8sPrometheusAdapter.ManagementState,
+		"thanosQuerier":         c.ThanosQuerierConfig.ManagementState,
+	}
+	for k, v := range states {
+		if v == "" {
+			states[k] = Managed
+		}
+	}
+	return states
+}
+
+// NewConfig parses the cluster monitoring configuration from content.
+// Unknown fields and invalid values are rejected and all of them are
+// returned in the error along with their YAML path. Fields removed in
+// earlier releases are ignored with a warning.
 func NewConfig(content io.Reader) (*Config, error) {
+	b, err := ioutil.ReadAll(content)
+	if err != nil {
+		return nil, err
+	}
+
 	c := Config{}
 	cmc := ClusterMonitoringConfiguration{}
-	err := k8syaml.NewYAMLOrJSONDecoder(content, 4096).Decode(&cmc)
+	allErrs, removed, err := decodeStrict(b, &cmc, removedClusterMonitoringFields)
 	if err != nil {
 		return nil, err
 	}
+	allErrs = append(allErrs, cmc.Validate()...)
+	if err := allErrs.ToAggregate(); err != nil {
+		return nil, err
+	}
+	for _, f := range removed {
+		klog.Warningf("Ignoring the %q field of the cluster monitoring configuration, it is no longer supported.", f)
+	}
 	c.ClusterMonitoringConfiguration = &cmc
+	c.deprecatedFields = cmc.deprecatedFields()
+	c.removedFields = removed
 	res := &c
 	res.applyDefaults()
 	c.UserWorkloadConfiguration = NewDefaultUserWorkloadMonitoringConfig()
@@ -261,6 +354,9 @@ func (c *Config) applyDefaults() {
 	if c.ClusterMonitoringConfiguration.UserWorkloadConfig == nil {
 		c.ClusterMonitoringConfiguration.UserWorkloadConfig = &UserWorkloadConfig{}
 	}
+	if c.ClusterMonitoringConfiguration.GRPCTLSConfig == nil {
+		c.ClusterMonitoringConfiguration.GRPCTLSConfig = &GRPCTLSConfig{}
+	}
 }
 
 func (c *Config) SetImages(images map[string]string) {
@@ -414,10 +510,14 @@ func NewUserConfigFromString(content string) (*UserWorkloadConfiguration, error)
 		return NewDefaultUserWorkloadMonitoringConfig(), nil
 	}
 	u := &UserWorkloadConfiguration{}
-	err := k8syaml.NewYAMLOrJSONDecoder(bytes.NewBuffer([]byte(content)), 100).Decode(&u)
+	allErrs, _, err := decodeStrict([]byte(content), u, nil)
 	if err != nil {
 		return nil, err
 	}
+	allErrs = append(allErrs, u.Validate()...)
+	if err := allErrs.ToAggregate(); err != nil {
+		return nil, err
+	}
 
 	u.applyDefaults()
 
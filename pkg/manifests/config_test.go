@@ -17,11 +17,12 @@ package manifests
 import (
 	"errors"
 	"fmt"
-	"io/ioutil"
 	"os"
 	"testing"
 
 	configv1 "github.com/openshift/api/config/v1"
+	v1 "k8s.io/api/core/v1"
+	k8syaml "k8s.io/apimachinery/pkg/util/yaml"
 )
 
 func TestConfigParsing(t *testing.T) {
@@ -37,15 +38,23 @@ func TestConfigParsing(t *testing.T) {
 	if c.ClusterMonitoringConfiguration.AlertmanagerMainConfig.VolumeClaimTemplate == nil {
 		t.Fatal("config parsing failed: AlertmanagerMainConfig VolumeClaimTemplate was not parsed correctly")
 	}
+	if len(c.removedFields) != 0 {
+		t.Fatalf("expected the example not to use removed fields, got %v", c.removedFields)
+	}
 }
 
 func TestNewUserConfigFromStringParsing(t *testing.T) {
-	c, err := ioutil.ReadFile("../../examples/user-workload/configmap.yaml")
+	f, err := os.Open("../../examples/user-workload/configmap.yaml")
 	if err != nil {
 		t.Fatal(err)
 	}
 
-	uwmc, err := NewUserConfigFromString(string(c))
+	cm := v1.ConfigMap{}
+	if err := k8syaml.NewYAMLOrJSONDecoder(f, 100).Decode(&cm); err != nil {
+		t.Fatal(err)
+	}
+
+	uwmc, err := NewUserConfigFromString(cm.Data["config.yaml"])
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -165,6 +174,22 @@ func TestTelemeterClientConfig(t *testing.T) {
 			},
 			enabled: false,
 		},
+		{
+			cfg: &TelemeterClientConfig{
+				ClusterID:       "test",
+				Token:           "test",
+				ManagementState: Removed,
+			},
+			enabled: false,
+		},
+		{
+			cfg: &TelemeterClientConfig{
+				ClusterID:       "test",
+				Token:           "test",
+				ManagementState: Unmanaged,
+			},
+			enabled: true,
+		},
 	}
 
 	for i, tc := range tcs {
@@ -295,3 +320,26 @@ func TestLoadProxy(t *testing.T) {
 		})
 	}
 }
+
+func TestManagementStates(t *testing.T) {
+	c, err := NewConfigFromString(`grafana:
+  managementState: Unmanaged
+telemeterClient:
+  managementState: Removed
+`)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	states := c.ClusterMonitoringConfiguration.ManagementStates()
+	for component, expected := range map[string]ManagementState{
+		"grafana":          Unmanaged,
+		"telemeterClient":  Removed,
+		"alertmanagerMain": Managed,
+		"prometheusK8s":    Managed,
+	} {
+		if states[component] != expected {
+			t.Errorf("expected %s to be %s, got %s", component, expected, states[component])
+		}
+	}
+}
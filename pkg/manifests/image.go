@@ -48,3 +48,18 @@ func (i *image) SetTagIfNotEmpty(tag string) {
 		i.tag = tag
 	}
 }
+
+// imageTag returns the tag of an image reference. It is empty for
+// references by digest, references without a tag and the "latest" tag,
+// none of which identify a version.
+func imageTag(s string) string {
+	if s == "" || strings.Contains(s, "@") {
+		return ""
+	}
+
+	i, err := imageFromString(s)
+	if err != nil || strings.Contains(i.tag, "/") || i.tag == "latest" {
+		return ""
+	}
+	return i.tag
+}
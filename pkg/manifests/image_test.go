@@ -59,3 +59,24 @@ func TestImageParsing(t *testing.T) {
 		}
 	}
 }
+
+func TestImageTag(t *testing.T) {
+	for _, tc := range []struct {
+		image string
+		tag   string
+	}{
+		{image: "quay.io/prometheus/prometheus:v2.20.0", tag: "v2.20.0"},
+		{image: "quay.io:443/prometheus/prometheus:v2.20.0", tag: "v2.20.0"},
+		{image: "quay.io/prometheus/prometheus:latest"},
+		{image: "quay.io/prometheus/prometheus"},
+		{image: "quay.io:443/prometheus/prometheus"},
+		{image: "quay.io/openshift/origin-prometheus@sha256:0123456789abcdef"},
+		{image: ""},
+	} {
+		t.Run(tc.image, func(t *testing.T) {
+			if tag := imageTag(tc.image); tag != tc.tag {
+				t.Fatalf("expected tag %q, got %q", tc.tag, tag)
+			}
+		})
+	}
+}
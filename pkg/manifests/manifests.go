@@ -16,9 +16,6 @@ package manifests
 
 import (
 	"bytes"
-
-	// #nosec
-	"crypto/sha1"
 	"encoding/base64"
 	"encoding/json"
 	"fmt"
@@ -28,6 +25,7 @@ import (
 	"net/url"
 	"strconv"
 	"strings"
+	"time"
 
 	monv1 "github.com/coreos/prometheus-operator/pkg/apis/monitoring/v1"
 	configv1 "github.com/openshift/api/config/v1"
@@ -238,7 +236,7 @@ const (
 )
 
 func MustAssetReader(asset string) io.Reader {
-	return bytes.NewReader(MustAsset(asset))
+	return &assetReader{Reader: bytes.NewReader(MustAsset(asset)), asset: asset}
 }
 
 type Factory struct {
@@ -293,6 +291,7 @@ func (f *Factory) AlertmanagerProxySecret() (*v1.Secret, error) {
 	}
 	s.Data["session_secret"] = []byte(p)
 	s.Namespace = f.namespace
+	markGenerated(s, time.Now())
 
 	return s, nil
 }
@@ -917,6 +916,7 @@ func (f *Factory) PrometheusK8sProxySecret() (*v1.Secret, error) {
 	}
 	s.Data["session_secret"] = []byte(p)
 	s.Namespace = f.namespace
+	markGenerated(s, time.Now())
 
 	return s, nil
 }
@@ -966,48 +966,74 @@ func (f *Factory) ThanosQuerierOauthCookieSecret() (*v1.Secret, error) {
 	}
 	s.Data["session_secret"] = []byte(p)
 	s.Namespace = f.namespace
+	markGenerated(s, time.Now())
 
 	return s, nil
 }
 
-func (f *Factory) PrometheusK8sHtpasswdSecret(password string) (*v1.Secret, error) {
+// PrometheusK8sHtpasswdSecret returns the htpasswd secret accepting the
+// current, the staged and the previous Prometheus credentials of the Grafana
+// datasources secret.
+func (f *Factory) PrometheusK8sHtpasswdSecret(datasources *v1.Secret) (*v1.Secret, error) {
 	s, err := f.NewSecret(MustAssetReader(PrometheusK8sHtpasswd))
 	if err != nil {
 		return nil, err
 	}
 
-	f.generateHtpasswdSecret(s, password)
+	err = f.generateHtpasswdSecret(s, datasources)
+	if err != nil {
+		return nil, err
+	}
 	return s, nil
 }
 
-func (f *Factory) ThanosQuerierHtpasswdSecret(password string) (*v1.Secret, error) {
+func (f *Factory) ThanosQuerierHtpasswdSecret(datasources *v1.Secret) (*v1.Secret, error) {
 	s, err := f.NewSecret(MustAssetReader(ThanosQuerierHtpasswdSecret))
 	if err != nil {
 		return nil, err
 	}
 
-	f.generateHtpasswdSecret(s, password)
+	err = f.generateHtpasswdSecret(s, datasources)
+	if err != nil {
+		return nil, err
+	}
 	return s, nil
 }
 
-func (f *Factory) ThanosRulerHtpasswdSecret(password string) (*v1.Secret, error) {
+func (f *Factory) ThanosRulerHtpasswdSecret(datasources *v1.Secret) (*v1.Secret, error) {
 	s, err := f.NewSecret(MustAssetReader(ThanosRulerHtpasswdSecret))
 	if err != nil {
 		return nil, err
 	}
 
-	f.generateHtpasswdSecret(s, password)
+	err = f.generateHtpasswdSecret(s, datasources)
+	if err != nil {
+		return nil, err
+	}
 	s.Namespace = f.namespaceUserWorkload
 	return s, nil
 }
 
-func (f *Factory) generateHtpasswdSecret(s *v1.Secret, password string) {
-	// #nosec
-	// TODO: Replace this with a safer algorithm
-	h := sha1.New()
-	h.Write([]byte(password))
-	s.Data["auth"] = []byte("internal:{SHA}" + base64.StdEncoding.EncodeToString(h.Sum(nil)))
+func (f *Factory) generateHtpasswdSecret(s *v1.Secret, datasources *v1.Secret) error {
+	var lines []string
+	for _, k := range []string{grafanaDatasourceKey, pendingGrafanaDatasourceKey, previousGrafanaDatasourceKey} {
+		b, ok := datasources.Data[k]
+		if !ok {
+			continue
+		}
+		d, err := grafanaDatasource(b)
+		if err != nil {
+			return err
+		}
+		lines = append(lines, htpasswdLine(d.Datasources[0].BasicAuthUser, d.Datasources[0].BasicAuthPassword))
+	}
+	if len(lines) == 0 {
+		return errors.New("grafana datasource is missing")
+	}
+
+	s.Data["auth"] = []byte(strings.Join(lines, "\n"))
 	s.Namespace = f.namespace
+	return nil
 }
 
 func (f *Factory) ThanosRulerQueryConfigSecret() (*v1.Secret, error) {
@@ -1141,7 +1167,7 @@ func (f *Factory) PrometheusK8sEtcdSecret(tlsClient *v1.Secret, ca *v1.ConfigMap
 		return nil, errors.Wrap(r.err, "couldn't find etcd certificate data")
 	}
 
-	return &v1.Secret{
+	s := &v1.Secret{
 		ObjectMeta: metav1.ObjectMeta{
 			Namespace: f.namespace,
 			Name:      "kube-etcd-client-certs",
@@ -1151,7 +1177,10 @@ func (f *Factory) PrometheusK8sEtcdSecret(tlsClient *v1.Secret, ca *v1.ConfigMap
 			"etcd-client.key":    clientKey,
 			"etcd-client.crt":    clientCert,
 		},
-	}, nil
+	}
+	setComponent(s, "prometheus-k8s")
+
+	return s, nil
 }
 
 func (f *Factory) PrometheusK8sRoute() (*routev1.Route, error) {
@@ -1195,7 +1224,7 @@ func (f *Factory) SharingConfigDeprecated(promHost, amHost, grafanaHost, thanosH
 // End of remove
 
 func (f *Factory) SharingConfig(promHost, amHost, grafanaHost, thanosHost *url.URL) *v1.ConfigMap {
-	return &v1.ConfigMap{
+	cm := &v1.ConfigMap{
 		ObjectMeta: metav1.ObjectMeta{
 			Name:      sharedConfigMap,
 			Namespace: configManagedNamespace,
@@ -1209,6 +1238,9 @@ func (f *Factory) SharingConfig(promHost, amHost, grafanaHost, thanosHost *url.U
 			"thanosPublicURL":       thanosHost.String(),
 		},
 	}
+	setComponent(cm, "cluster-monitoring-operator")
+
+	return cm
 }
 
 func (f *Factory) PrometheusK8sTrustedCABundle() (*v1.ConfigMap, error) {
@@ -1262,7 +1294,7 @@ func (f *Factory) PrometheusK8s(host string, grpcTLS *v1.Secret, trustedCABundle
 	telemetryEnabled := f.config.ClusterMonitoringConfiguration.TelemeterClientConfig.IsEnabled()
 	if telemetryEnabled && f.config.RemoteWrite {
 
-		selectorRelabelConfig, err := promqlgen.LabelSelectorsToRelabelConfig(f.config.ClusterMonitoringConfiguration.PrometheusK8sConfig.TelemetryMatches)
+		selectorRelabelConfigs, err := promqlgen.LabelSelectorsToRelabelConfigs(f.config.ClusterMonitoringConfiguration.PrometheusK8sConfig.TelemetryMatches)
 		if err != nil {
 			return nil, errors.Wrap(err, "generate label selector relabel config")
 		}
@@ -1295,8 +1327,7 @@ func (f *Factory) PrometheusK8s(host string, grpcTLS *v1.Secret, trustedCABundle
 				// produce (concurrency/256) number of requests per second.
 				MaxBackoff: "256s",
 			},
-			WriteRelabelConfigs: []monv1.RelabelConfig{
-				*selectorRelabelConfig,
+			WriteRelabelConfigs: append(selectorRelabelConfigs,
 				monv1.RelabelConfig{
 					TargetLabel: "_id",
 					Replacement: f.config.ClusterMonitoringConfiguration.TelemeterClientConfig.ClusterID,
@@ -1310,7 +1341,7 @@ func (f *Factory) PrometheusK8s(host string, grpcTLS *v1.Secret, trustedCABundle
 					Regex:        "ALERTS",
 					Replacement:  "alerts",
 				},
-			},
+			),
 		}
 
 		p.Spec.RemoteWrite = []monv1.RemoteWriteSpec{spec}
@@ -1739,7 +1770,7 @@ func (f *Factory) PrometheusAdapterSecret(tlsSecret *v1.Secret, apiAuthConfigmap
 	h.Write([]byte(clientCA + requestheaderClientCA + tlsCA + tlsKey))
 	hash := strconv.FormatUint(h.Sum64(), 32)
 
-	return &v1.Secret{
+	s := &v1.Secret{
 		ObjectMeta: metav1.ObjectMeta{
 			Namespace: f.namespace,
 			Name:      fmt.Sprintf("prometheus-adapter-%s", hash),
@@ -1754,7 +1785,10 @@ func (f *Factory) PrometheusAdapterSecret(tlsSecret *v1.Secret, apiAuthConfigmap
 			"tls.crt":                      []byte(tlsCA),
 			"tls.key":                      []byte(tlsKey),
 		},
-	}, nil
+	}
+	setComponent(s, "prometheus-adapter")
+
+	return s, nil
 }
 
 func (f *Factory) PrometheusAdapterAPIService() (*apiregistrationv1beta1.APIService, error) {
@@ -2078,9 +2112,10 @@ func (f *Factory) GrafanaDatasources() (*v1.Secret, error) {
 	if err != nil {
 		return nil, err
 	}
-	s.Data["prometheus.yaml"] = b
+	s.Data[grafanaDatasourceKey] = b
 
 	s.Namespace = f.namespace
+	markGenerated(s, time.Now())
 
 	return s, nil
 }
@@ -2210,6 +2245,7 @@ func (f *Factory) GrafanaProxySecret() (*v1.Secret, error) {
 	}
 	s.Data["session_secret"] = []byte(p)
 	s.Namespace = f.namespace
+	markGenerated(s, time.Now())
 
 	return s, nil
 }
@@ -2359,6 +2395,7 @@ func (f *Factory) NewDaemonSet(manifest io.Reader) (*appsv1.DaemonSet, error) {
 	if ds.GetNamespace() == "" {
 		ds.SetNamespace(f.namespace)
 	}
+	setOwnership(ds, manifest)
 
 	return ds, nil
 }
@@ -2372,6 +2409,7 @@ func (f *Factory) NewService(manifest io.Reader) (*v1.Service, error) {
 	if s.GetNamespace() == "" {
 		s.SetNamespace(f.namespace)
 	}
+	setOwnership(s, manifest)
 
 	return s, nil
 }
@@ -2385,6 +2423,7 @@ func (f *Factory) NewEndpoints(manifest io.Reader) (*v1.Endpoints, error) {
 	if e.GetNamespace() == "" {
 		e.SetNamespace(f.namespace)
 	}
+	setOwnership(e, manifest)
 
 	return e, nil
 }
@@ -2398,6 +2437,7 @@ func (f *Factory) NewRoute(manifest io.Reader) (*routev1.Route, error) {
 	if r.GetNamespace() == "" {
 		r.SetNamespace(f.namespace)
 	}
+	setOwnership(r, manifest)
 
 	return r, nil
 }
@@ -2411,6 +2451,7 @@ func (f *Factory) NewSecret(manifest io.Reader) (*v1.Secret, error) {
 	if s.GetNamespace() == "" {
 		s.SetNamespace(f.namespace)
 	}
+	setOwnership(s, manifest)
 
 	return s, nil
 }
@@ -2424,6 +2465,7 @@ func (f *Factory) NewRoleBinding(manifest io.Reader) (*rbacv1.RoleBinding, error
 	if rb.GetNamespace() == "" {
 		rb.SetNamespace(f.namespace)
 	}
+	setOwnership(rb, manifest)
 
 	return rb, nil
 }
@@ -2440,6 +2482,10 @@ func (f *Factory) NewRoleList(manifest io.Reader) (*rbacv1.RoleList, error) {
 		}
 	}
 
+	for i := range rl.Items {
+		setOwnership(&rl.Items[i], manifest)
+	}
+
 	return rl, nil
 }
 
@@ -2455,6 +2501,10 @@ func (f *Factory) NewRoleBindingList(manifest io.Reader) (*rbacv1.RoleBindingLis
 		}
 	}
 
+	for i := range rbl.Items {
+		setOwnership(&rbl.Items[i], manifest)
+	}
+
 	return rbl, nil
 }
 
@@ -2467,6 +2517,7 @@ func (f *Factory) NewRole(manifest io.Reader) (*rbacv1.Role, error) {
 	if r.GetNamespace() == "" {
 		r.SetNamespace(f.namespace)
 	}
+	setOwnership(r, manifest)
 
 	return r, nil
 }
@@ -2480,6 +2531,7 @@ func (f *Factory) NewConfigMap(manifest io.Reader) (*v1.ConfigMap, error) {
 	if cm.GetNamespace() == "" {
 		cm.SetNamespace(f.namespace)
 	}
+	setOwnership(cm, manifest)
 
 	return cm, nil
 }
@@ -2496,6 +2548,10 @@ func (f *Factory) NewConfigMapList(manifest io.Reader) (*v1.ConfigMapList, error
 		}
 	}
 
+	for i := range cml.Items {
+		setOwnership(&cml.Items[i], manifest)
+	}
+
 	return cml, nil
 }
 
@@ -2508,6 +2564,7 @@ func (f *Factory) NewServiceAccount(manifest io.Reader) (*v1.ServiceAccount, err
 	if sa.GetNamespace() == "" {
 		sa.SetNamespace(f.namespace)
 	}
+	setOwnership(sa, manifest)
 
 	return sa, nil
 }
@@ -2521,6 +2578,7 @@ func (f *Factory) NewPrometheus(manifest io.Reader) (*monv1.Prometheus, error) {
 	if p.GetNamespace() == "" {
 		p.SetNamespace(f.namespace)
 	}
+	setOwnership(p, manifest)
 
 	return p, nil
 }
@@ -2534,6 +2592,7 @@ func (f *Factory) NewPrometheusRule(manifest io.Reader) (*monv1.PrometheusRule,
 	if p.GetNamespace() == "" {
 		p.SetNamespace(f.namespace)
 	}
+	setOwnership(p, manifest)
 
 	return p, nil
 }
@@ -2562,6 +2621,8 @@ func (f *Factory) NewTelemeterPrometheusRecRuleFromString(expr string) (*monv1.P
 		p.SetNamespace(f.namespace)
 	}
 
+	setComponent(p, "telemeter-client")
+
 	return p, nil
 }
 
@@ -2574,6 +2635,7 @@ func (f *Factory) NewAlertmanager(manifest io.Reader) (*monv1.Alertmanager, erro
 	if a.GetNamespace() == "" {
 		a.SetNamespace(f.namespace)
 	}
+	setOwnership(a, manifest)
 
 	return a, nil
 }
@@ -2587,6 +2649,7 @@ func (f *Factory) NewThanosRuler(manifest io.Reader) (*monv1.ThanosRuler, error)
 	if t.GetNamespace() == "" {
 		t.SetNamespace(f.namespaceUserWorkload)
 	}
+	setOwnership(t, manifest)
 
 	return t, nil
 }
@@ -2600,6 +2663,7 @@ func (f *Factory) NewServiceMonitor(manifest io.Reader) (*monv1.ServiceMonitor,
 	if sm.GetNamespace() == "" {
 		sm.SetNamespace(f.namespace)
 	}
+	setOwnership(sm, manifest)
 
 	return sm, nil
 }
@@ -2613,6 +2677,7 @@ func (f *Factory) NewDeployment(manifest io.Reader) (*appsv1.Deployment, error)
 	if d.GetNamespace() == "" {
 		d.SetNamespace(f.namespace)
 	}
+	setOwnership(d, manifest)
 
 	return d, nil
 }
@@ -2626,28 +2691,64 @@ func (f *Factory) NewIngress(manifest io.Reader) (*v1beta1.Ingress, error) {
 	if i.GetNamespace() == "" {
 		i.SetNamespace(f.namespace)
 	}
+	setOwnership(i, manifest)
 
 	return i, nil
 }
 
 func (f *Factory) NewAPIService(manifest io.Reader) (*apiregistrationv1beta1.APIService, error) {
-	return NewAPIService(manifest)
+	o, err := NewAPIService(manifest)
+	if err != nil {
+		return nil, err
+	}
+
+	setOwnership(o, manifest)
+
+	return o, nil
 }
 
 func (f *Factory) NewSecurityContextConstraints(manifest io.Reader) (*securityv1.SecurityContextConstraints, error) {
-	return NewSecurityContextConstraints(manifest)
+	o, err := NewSecurityContextConstraints(manifest)
+	if err != nil {
+		return nil, err
+	}
+
+	setOwnership(o, manifest)
+
+	return o, nil
 }
 
 func (f *Factory) NewClusterRoleBinding(manifest io.Reader) (*rbacv1.ClusterRoleBinding, error) {
-	return NewClusterRoleBinding(manifest)
+	o, err := NewClusterRoleBinding(manifest)
+	if err != nil {
+		return nil, err
+	}
+
+	setOwnership(o, manifest)
+
+	return o, nil
 }
 
 func (f *Factory) NewClusterRole(manifest io.Reader) (*rbacv1.ClusterRole, error) {
-	return NewClusterRole(manifest)
+	o, err := NewClusterRole(manifest)
+	if err != nil {
+		return nil, err
+	}
+
+	setOwnership(o, manifest)
+
+	return o, nil
 }
 
 func (f *Factory) NewValidatingWebhook(manifest io.Reader) (*admissionv1.ValidatingWebhookConfiguration, error) {
-	return NewValidatingWebhook(manifest)
+	o, err := NewValidatingWebhook(manifest)
+	if err != nil {
+		return nil, err
+	}
+
+	setOwnership(o, manifest)
+
+	return o, nil
 }
 
 func (f *Factory) ThanosQuerierDeployment(grpcTLS *v1.Secret, enableUserWorkloadMonitoring bool, trustedCA *v1.ConfigMap) (*appsv1.Deployment, error) {
@@ -3072,6 +3173,7 @@ func (f *Factory) ThanosRulerOauthCookieSecret() (*v1.Secret, error) {
 	}
 	s.Data["session_secret"] = []byte(p)
 	s.Namespace = f.namespaceUserWorkload
+	markGenerated(s, time.Now())
 
 	return s, nil
 }
@@ -3426,7 +3528,8 @@ func NewValidatingWebhook(manifest io.Reader) (*admissionv1.ValidatingWebhookCon
 
 // HashTrustedCA synthesizes a configmap just by copying "ca-bundle.crt" from the given configmap
 // and naming it by hashing the contents of "ca-bundle.crt".
-// It adds "monitoring.openshift.io/name" and "monitoring.openshift.io/hash" labels.
+// It adds "monitoring.openshift.io/name" and "monitoring.openshift.io/hash" labels
+// and keeps the ownership labels of the given configmap.
 // Any other labels from the given configmap are discarded.
 //
 // It returns an error if the given configmap does not contain the "ca-bundle.crt" data key
@@ -3449,7 +3552,7 @@ func (f *Factory) HashTrustedCA(caBundleCM *v1.ConfigMap, prefix string) (*v1.Co
 		ns = caBundleCM.ObjectMeta.Namespace
 	}
 
-	return &v1.ConfigMap{
+	cm := &v1.ConfigMap{
 		ObjectMeta: metav1.ObjectMeta{
 			Namespace: ns,
 			Name:      fmt.Sprintf("%s-trusted-ca-bundle-%s", prefix, hash),
@@ -3461,7 +3564,10 @@ func (f *Factory) HashTrustedCA(caBundleCM *v1.ConfigMap, prefix string) (*v1.Co
 		Data: map[string]string{
 			TrustedCABundleKey: caBundle,
 		},
-	}, nil
+	}
+	copyOwnership(cm, caBundleCM)
+
+	return cm, nil
 }
 
 // HashSecret synthesizes a secret by setting the given data
@@ -3470,7 +3576,8 @@ func (f *Factory) HashTrustedCA(caBundleCM *v1.ConfigMap, prefix string) (*v1.Co
 // For simplicity, data is expected to be given in a key-value format,
 // i.e. HashSecret(someSecret, value1, key1, value2, key2, ...).
 //
-// It adds "monitoring.openshift.io/name" and "monitoring.openshift.io/hash" labels.
+// It adds "monitoring.openshift.io/name" and "monitoring.openshift.io/hash" labels
+// and keeps the ownership labels of the given secret.
 // Any other labels from the given secret are discarded.
 //
 // It still returns a secret if the given secret does not contain any data.
@@ -3490,7 +3597,7 @@ func (f *Factory) HashSecret(secret *v1.Secret, data ...string) (*v1.Secret, err
 	}
 	hash := strconv.FormatUint(h.Sum64(), 32)
 
-	return &v1.Secret{
+	s := &v1.Secret{
 		ObjectMeta: metav1.ObjectMeta{
 			Namespace: secret.GetNamespace(),
 			Name:      fmt.Sprintf("%s-%s", secret.GetName(), hash),
@@ -3500,7 +3607,10 @@ func (f *Factory) HashSecret(secret *v1.Secret, data ...string) (*v1.Secret, err
 			},
 		},
 		Data: m,
-	}, nil
+	}
+	copyOwnership(s, secret)
+
+	return s, nil
 }
 
 func trustedCABundleVolumeMount(name string) v1.VolumeMount {
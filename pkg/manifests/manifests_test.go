@@ -102,6 +102,29 @@ func TestHashSecret(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "ownership labels",
+			given: &v1.Secret{ObjectMeta: metav1.ObjectMeta{
+				Name: "foo",
+				Labels: map[string]string{
+					ManagedByLabel: ManagedByValue,
+					ComponentLabel: "prometheus-k8s",
+					"other":        "label",
+				},
+			}},
+			expected: &v1.Secret{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "foo-cnskssi2248p5",
+					Labels: map[string]string{
+						"monitoring.openshift.io/hash": "cnskssi2248p5",
+						"monitoring.openshift.io/name": "foo",
+						ManagedByLabel:                 ManagedByValue,
+						ComponentLabel:                 "prometheus-k8s",
+					},
+				},
+				Data: make(map[string][]byte),
+			},
+		},
 	} {
 		t.Run(tt.name, func(t *testing.T) {
 			f := NewFactory("openshift-monitoring", "openshift-user-workload-monitoring", NewDefaultConfig())
@@ -185,7 +208,12 @@ func TestUnconfiguredManifests(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	_, err = f.ThanosQuerierHtpasswdSecret("foo")
+	gs, err := f.GrafanaDatasources()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = f.ThanosQuerierHtpasswdSecret(gs)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -695,6 +723,45 @@ func TestSharingConfig(t *testing.T) {
 	}
 }
 
+func TestOwnershipLabels(t *testing.T) {
+	f := NewFactory("openshift-monitoring", "openshift-user-workload-monitoring", NewDefaultConfig())
+
+	d, err := f.KubeStateMetricsDeployment()
+	if err != nil {
+		t.Fatal(err)
+	}
+	cr, err := f.AlertmanagerClusterRole()
+	if err != nil {
+		t.Fatal(err)
+	}
+	dashboards, err := f.GrafanaDashboardDefinitions()
+	if err != nil {
+		t.Fatal(err)
+	}
+	u, _ := url.Parse("https://example.com")
+	cm := f.SharingConfig(u, u, u, u)
+
+	for _, tc := range []struct {
+		obj       metav1.Object
+		component string
+	}{
+		{obj: d, component: "kube-state-metrics"},
+		{obj: cr, component: "alertmanager"},
+		{obj: &dashboards.Items[0], component: "grafana"},
+		{obj: cm, component: "cluster-monitoring-operator"},
+	} {
+		t.Run(tc.obj.GetName(), func(t *testing.T) {
+			labels := tc.obj.GetLabels()
+			if labels[ManagedByLabel] != ManagedByValue {
+				t.Errorf("expected label %s=%s, got labels %v", ManagedByLabel, ManagedByValue, labels)
+			}
+			if labels[ComponentLabel] != tc.component {
+				t.Errorf("expected label %s=%s, got labels %v", ComponentLabel, tc.component, labels)
+			}
+		})
+	}
+}
+
 func TestPrometheusOperatorConfiguration(t *testing.T) {
 	c, err := NewConfigFromString(`prometheusOperator:
   nodeSelector:
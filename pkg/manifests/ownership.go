@@ -0,0 +1,86 @@
+// Copyright 2020 The Cluster Monitoring Operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package manifests
+
+import (
+	"bytes"
+	"io"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// ManagedByLabel marks the objects built by the Factory, its value is
+	// ManagedByValue.
+	ManagedByLabel = "app.kubernetes.io/managed-by"
+	ManagedByValue = "cluster-monitoring-operator"
+	// ComponentLabel names the component an object belongs to. Components
+	// are named after the directory of their assets, e.g.
+	// "prometheus-k8s".
+	ComponentLabel = "monitoring.openshift.io/component"
+)
+
+// assetReader reads an asset and remembers its name, so that the objects
+// decoded from it can be labelled with their component.
+type assetReader struct {
+	*bytes.Reader
+	asset string
+}
+
+// assetComponent returns the component of an asset, the name of its
+// directory below assets/.
+func assetComponent(asset string) string {
+	parts := strings.Split(asset, "/")
+	if len(parts) < 3 || parts[0] != "assets" {
+		return ""
+	}
+	return parts[1]
+}
+
+// setOwnership labels obj as managed by the operator and belonging to the
+// component of the asset manifest was read from. Objects not read from an
+// asset are left alone.
+func setOwnership(obj metav1.Object, manifest io.Reader) {
+	r, ok := manifest.(*assetReader)
+	if !ok {
+		return
+	}
+	setComponent(obj, assetComponent(r.asset))
+}
+
+// setComponent labels obj as managed by the operator and belonging to
+// component.
+func setComponent(obj metav1.Object, component string) {
+	if component == "" {
+		return
+	}
+
+	labels := obj.GetLabels()
+	if labels == nil {
+		labels = map[string]string{}
+	}
+	labels[ManagedByLabel] = ManagedByValue
+	labels[ComponentLabel] = component
+	obj.SetLabels(labels)
+}
+
+// copyOwnership copies the ownership labels of src to dst.
+func copyOwnership(dst, src metav1.Object) {
+	if src.GetLabels()[ManagedByLabel] != ManagedByValue {
+		return
+	}
+	setComponent(dst, src.GetLabels()[ComponentLabel])
+}
@@ -0,0 +1,253 @@
+// Copyright 2020 The Cluster Monitoring Operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package manifests
+
+import (
+	// #nosec
+	"crypto/sha1"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"hash/fnv"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prometheus/common/model"
+	v1 "k8s.io/api/core/v1"
+)
+
+const (
+	// GeneratedAtAnnotation records when the operator generated the content
+	// of a secret, in RFC 3339 format.
+	GeneratedAtAnnotation = "monitoring.openshift.io/generated-at"
+	// RotateAnnotation makes the next reconciliation rotate a generated
+	// secret regardless of its age.
+	RotateAnnotation = "monitoring.openshift.io/rotate"
+	// SecretsHashAnnotation is set on pod templates to the hash of the
+	// generated secrets read by the pods at startup, so that the pods are
+	// recreated when the secrets are rotated.
+	SecretsHashAnnotation = "monitoring.openshift.io/secrets-hash"
+
+	// Keys of the Grafana datasources secret. The pending and previous
+	// datasources aren't provisioned by Grafana which only reads the files
+	// ending in .yaml.
+	grafanaDatasourceKey         = "prometheus.yaml"
+	pendingGrafanaDatasourceKey  = "prometheus.yaml.pending"
+	previousGrafanaDatasourceKey = "prometheus.yaml.previous"
+)
+
+// grafanaDatasourceUsers are the users of the Grafana datasource. Rotations
+// alternate between them so that the htpasswd secrets can hold the current
+// and the pending credentials at once.
+var grafanaDatasourceUsers = [2]string{"internal", "internal-2"}
+
+// markGenerated records now as the generation time of s.
+func markGenerated(s *v1.Secret, now time.Time) {
+	if s.Annotations == nil {
+		s.Annotations = map[string]string{}
+	}
+	s.Annotations[GeneratedAtAnnotation] = now.UTC().Format(time.RFC3339)
+}
+
+// generatedAt returns when s was generated. Secrets generated before the
+// time was recorded fall back to their creation time.
+func generatedAt(s *v1.Secret) time.Time {
+	if v, ok := s.Annotations[GeneratedAtAnnotation]; ok {
+		if t, err := time.Parse(time.RFC3339, v); err == nil {
+			return t
+		}
+	}
+	return s.CreationTimestamp.Time
+}
+
+// rotationDue tells whether the generated secret s must be rotated, either
+// on request or because it is older than period. A zero period disables the
+// periodic rotation.
+func rotationDue(s *v1.Secret, period time.Duration, now time.Time) bool {
+	if _, ok := s.Annotations[RotateAnnotation]; ok {
+		return true
+	}
+	if period <= 0 {
+		return false
+	}
+	return !now.Before(generatedAt(s).Add(period))
+}
+
+// secretRotationPeriod returns the age after which generated secrets are
+// rotated, zero if they are only rotated on request.
+func (f *Factory) secretRotationPeriod() time.Duration {
+	c := f.config.ClusterMonitoringConfiguration.SecretRotationConfig
+	if c == nil || c.Period == "" {
+		return 0
+	}
+	// The period was validated along with the rest of the configuration.
+	d, err := model.ParseDuration(c.Period)
+	if err != nil {
+		return 0
+	}
+	return time.Duration(d)
+}
+
+// RotateGeneratedSecret returns the rotation function of the generated
+// secret s for client.CreateOrRotateSecret: the existing secret is replaced
+// with s once it is due for rotation.
+func (f *Factory) RotateGeneratedSecret(s *v1.Secret) func(*v1.Secret) (*v1.Secret, error) {
+	return func(existing *v1.Secret) (*v1.Secret, error) {
+		if !rotationDue(existing, f.secretRotationPeriod(), time.Now()) {
+			return nil, nil
+		}
+		return s, nil
+	}
+}
+
+// StageGrafanaDatasources is the rotation function of the Grafana
+// datasources secret for client.CreateOrRotateSecret. Once the Prometheus
+// credential is due for rotation, a new one is staged next to it. The
+// htpasswd secrets accept both until PromoteGrafanaDatasources makes the
+// staged credential current, and keep accepting the previous one until
+// DropPreviousGrafanaDatasource, so that Grafana keeps working mid-rotation.
+func (f *Factory) StageGrafanaDatasources(existing *v1.Secret) (*v1.Secret, error) {
+	return f.stageGrafanaDatasources(existing, time.Now())
+}
+
+func (f *Factory) stageGrafanaDatasources(existing *v1.Secret, now time.Time) (*v1.Secret, error) {
+	if _, ok := existing.Data[pendingGrafanaDatasourceKey]; ok {
+		return nil, nil
+	}
+	// The next credential would reuse the user of the previous one, which
+	// is still accepted.
+	if _, ok := existing.Data[previousGrafanaDatasourceKey]; ok {
+		return nil, nil
+	}
+	if !rotationDue(existing, f.secretRotationPeriod(), now) {
+		return nil, nil
+	}
+
+	d, err := grafanaDatasource(existing.Data[grafanaDatasourceKey])
+	if err != nil {
+		return nil, err
+	}
+	ds := d.Datasources[0]
+	if ds.BasicAuthUser == grafanaDatasourceUsers[0] {
+		ds.BasicAuthUser = grafanaDatasourceUsers[1]
+	} else {
+		ds.BasicAuthUser = grafanaDatasourceUsers[0]
+	}
+	ds.BasicAuthPassword, err = GeneratePassword(255)
+	if err != nil {
+		return nil, err
+	}
+
+	b, err := json.MarshalIndent(d, "", "    ")
+	if err != nil {
+		return nil, err
+	}
+
+	s := existing.DeepCopy()
+	s.Data[pendingGrafanaDatasourceKey] = b
+	delete(s.Annotations, RotateAnnotation)
+	return s, nil
+}
+
+// PromoteGrafanaDatasources makes the staged Prometheus credential of the
+// Grafana datasources secret s current, the current one is kept as the
+// previous credential. It must only be called once every htpasswd secret
+// accepts the staged credential and returns false if there is none.
+func PromoteGrafanaDatasources(s *v1.Secret, now time.Time) bool {
+	b, ok := s.Data[pendingGrafanaDatasourceKey]
+	if !ok {
+		return false
+	}
+	s.Data[previousGrafanaDatasourceKey] = s.Data[grafanaDatasourceKey]
+	s.Data[grafanaDatasourceKey] = b
+	delete(s.Data, pendingGrafanaDatasourceKey)
+	markGenerated(s, now)
+	return true
+}
+
+// DropPreviousGrafanaDatasource removes the previous Prometheus credential
+// of the Grafana datasources secret s so that the htpasswd secrets stop
+// accepting it. It must only be called once Grafana has been rolled out
+// with the current credential and returns false if there is none.
+func DropPreviousGrafanaDatasource(s *v1.Secret) bool {
+	if _, ok := s.Data[previousGrafanaDatasourceKey]; !ok {
+		return false
+	}
+	delete(s.Data, previousGrafanaDatasourceKey)
+	return true
+}
+
+// HtpasswdAcceptsPendingDatasource tells whether the htpasswd secret hs
+// accepts the staged Prometheus credential of the Grafana datasources
+// secret. It returns false if no credential is staged.
+func HtpasswdAcceptsPendingDatasource(datasources, hs *v1.Secret) (bool, error) {
+	b, ok := datasources.Data[pendingGrafanaDatasourceKey]
+	if !ok {
+		return false, nil
+	}
+	d, err := grafanaDatasource(b)
+	if err != nil {
+		return false, err
+	}
+	line := htpasswdLine(d.Datasources[0].BasicAuthUser, d.Datasources[0].BasicAuthPassword)
+	for _, l := range strings.Split(string(hs.Data["auth"]), "\n") {
+		if l == line {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// grafanaDatasource decodes a Grafana datasource file holding the
+// Prometheus datasource.
+func grafanaDatasource(b []byte) (*GrafanaDatasources, error) {
+	d := &GrafanaDatasources{}
+	if err := json.Unmarshal(b, d); err != nil {
+		return nil, errors.Wrap(err, "unmarshalling grafana datasource failed")
+	}
+	if len(d.Datasources) == 0 {
+		return nil, errors.New("grafana datasource is missing")
+	}
+	return d, nil
+}
+
+// htpasswdLine returns the htpasswd entry of a user.
+func htpasswdLine(user, password string) string {
+	// #nosec
+	// TODO: Replace this with a safer algorithm
+	h := sha1.New()
+	h.Write([]byte(password))
+	return user + ":{SHA}" + base64.StdEncoding.EncodeToString(h.Sum(nil))
+}
+
+// WithSecretsHash sets the SecretsHashAnnotation of the pod template
+// annotations to the hash of data, the content of the generated secrets
+// read by the pods at startup.
+func WithSecretsHash(annotations map[string]string, data ...[]byte) map[string]string {
+	h := fnv.New64()
+	for _, d := range data {
+		// Length-prefix the values so that their boundaries count.
+		fmt.Fprintf(h, "%d:", len(d))
+		h.Write(d)
+	}
+
+	if annotations == nil {
+		annotations = map[string]string{}
+	}
+	annotations[SecretsHashAnnotation] = strconv.FormatUint(h.Sum64(), 32)
+	return annotations
+}
@@ -0,0 +1,221 @@
+// Copyright 2020 The Cluster Monitoring Operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package manifests
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestRotationDue(t *testing.T) {
+	now := time.Date(2020, 6, 1, 0, 0, 0, 0, time.UTC)
+
+	for _, tc := range []struct {
+		name   string
+		secret *v1.Secret
+		period time.Duration
+		due    bool
+	}{
+		{
+			name: "young secret",
+			secret: &v1.Secret{ObjectMeta: metav1.ObjectMeta{
+				Annotations: map[string]string{GeneratedAtAnnotation: "2020-05-31T00:00:00Z"},
+			}},
+			period: 48 * time.Hour,
+		},
+		{
+			name: "old secret",
+			secret: &v1.Secret{ObjectMeta: metav1.ObjectMeta{
+				Annotations: map[string]string{GeneratedAtAnnotation: "2020-05-30T00:00:00Z"},
+			}},
+			period: 48 * time.Hour,
+			due:    true,
+		},
+		{
+			name: "old secret without generation time",
+			secret: &v1.Secret{ObjectMeta: metav1.ObjectMeta{
+				CreationTimestamp: metav1.NewTime(now.Add(-72 * time.Hour)),
+			}},
+			period: 48 * time.Hour,
+			due:    true,
+		},
+		{
+			name: "periodic rotation disabled",
+			secret: &v1.Secret{ObjectMeta: metav1.ObjectMeta{
+				Annotations: map[string]string{GeneratedAtAnnotation: "2019-01-01T00:00:00Z"},
+			}},
+		},
+		{
+			name: "forced rotation",
+			secret: &v1.Secret{ObjectMeta: metav1.ObjectMeta{
+				Annotations: map[string]string{
+					GeneratedAtAnnotation: "2020-05-31T00:00:00Z",
+					RotateAnnotation:      "true",
+				},
+			}},
+			due: true,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			if due := rotationDue(tc.secret, tc.period, now); due != tc.due {
+				t.Fatalf("expected due to be %v, got %v", tc.due, due)
+			}
+		})
+	}
+}
+
+func TestGrafanaDatasourcesRotation(t *testing.T) {
+	c, err := NewConfigFromString(`secretRotation:
+  period: 30d
+`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	f := NewFactory("openshift-monitoring", "openshift-user-workload-monitoring", c)
+
+	gs, err := f.GrafanaDatasources()
+	if err != nil {
+		t.Fatal(err)
+	}
+	generated := generatedAt(gs)
+
+	htpasswd := func(gs *v1.Secret) []string {
+		t.Helper()
+		hs, err := f.PrometheusK8sHtpasswdSecret(gs)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return strings.Split(string(hs.Data["auth"]), "\n")
+	}
+	current := htpasswd(gs)
+	if len(current) != 1 || !strings.HasPrefix(current[0], "internal:") {
+		t.Fatalf("expected a single credential of the internal user, got %v", current)
+	}
+
+	// Nothing is staged before the period is over.
+	staged, err := f.stageGrafanaDatasources(gs, generated.Add(24*time.Hour))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if staged != nil {
+		t.Fatal("expected no credential to be staged")
+	}
+
+	now := generated.Add(31 * 24 * time.Hour)
+	staged, err = f.stageGrafanaDatasources(gs, now)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if staged == nil {
+		t.Fatal("expected a credential to be staged")
+	}
+	if string(staged.Data[grafanaDatasourceKey]) != string(gs.Data[grafanaDatasourceKey]) {
+		t.Fatal("expected the current datasource to be kept while staging")
+	}
+
+	// The htpasswd secrets accept the current and the staged credentials.
+	both := htpasswd(staged)
+	if len(both) != 2 || both[0] != current[0] || !strings.HasPrefix(both[1], "internal-2:") {
+		t.Fatalf("expected the current and the staged credentials, got %v", both)
+	}
+	hs, err := f.PrometheusK8sHtpasswdSecret(gs)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ok, err := HtpasswdAcceptsPendingDatasource(staged, hs); err != nil || ok {
+		t.Fatalf("expected the outdated htpasswd secret not to accept the staged credential, got %v (err: %v)", ok, err)
+	}
+	hs, err = f.PrometheusK8sHtpasswdSecret(staged)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ok, err := HtpasswdAcceptsPendingDatasource(staged, hs); err != nil || !ok {
+		t.Fatalf("expected the htpasswd secret to accept the staged credential, got %v (err: %v)", ok, err)
+	}
+
+	// Staging is idempotent until the credential is promoted.
+	again, err := f.stageGrafanaDatasources(staged, now.Add(time.Hour))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if again != nil {
+		t.Fatal("expected the staged credential to be kept")
+	}
+
+	if !PromoteGrafanaDatasources(staged, now) {
+		t.Fatal("expected the staged credential to be promoted")
+	}
+	if PromoteGrafanaDatasources(staged, now) {
+		t.Fatal("expected nothing to promote")
+	}
+	if !generatedAt(staged).Equal(now) {
+		t.Fatalf("expected the generation time to be %v, got %v", now, generatedAt(staged))
+	}
+	// The previous credential stays accepted until Grafana has been rolled
+	// out with the promoted one, nothing is staged until it is dropped.
+	promoted := htpasswd(staged)
+	if len(promoted) != 2 || promoted[0] != both[1] || promoted[1] != current[0] {
+		t.Fatalf("expected the promoted and the previous credentials, got %v", promoted)
+	}
+	staged.Annotations[RotateAnnotation] = "true"
+	if next, err := f.stageGrafanaDatasources(staged, now); err != nil || next != nil {
+		t.Fatalf("expected no credential to be staged while the previous one is accepted, got %v (err: %v)", next, err)
+	}
+
+	if !DropPreviousGrafanaDatasource(staged) {
+		t.Fatal("expected the previous credential to be dropped")
+	}
+	if DropPreviousGrafanaDatasource(staged) {
+		t.Fatal("expected nothing to drop")
+	}
+	if dropped := htpasswd(staged); len(dropped) != 1 || dropped[0] != both[1] {
+		t.Fatalf("expected only the promoted credential, got %v", dropped)
+	}
+
+	// The next rotation switches back to the first user.
+	next, err := f.stageGrafanaDatasources(staged, now)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if next == nil {
+		t.Fatal("expected a forced rotation to stage a credential")
+	}
+	if _, ok := next.Annotations[RotateAnnotation]; ok {
+		t.Fatal("expected the rotation request to be cleared")
+	}
+	if got := htpasswd(next); len(got) != 2 || !strings.HasPrefix(got[1], "internal:") || got[1] == current[0] {
+		t.Fatalf("expected a new credential of the internal user, got %v", got)
+	}
+}
+
+func TestWithSecretsHash(t *testing.T) {
+	a := WithSecretsHash(nil, []byte("foo"), []byte("bar"))
+	b := WithSecretsHash(map[string]string{"other": "value"}, []byte("foo"), []byte("bar"))
+	if a[SecretsHashAnnotation] == "" || a[SecretsHashAnnotation] != b[SecretsHashAnnotation] {
+		t.Fatalf("expected identical hashes, got %v and %v", a, b)
+	}
+	if b["other"] != "value" {
+		t.Fatal("expected other annotations to be kept")
+	}
+
+	c := WithSecretsHash(nil, []byte("foob"), []byte("ar"))
+	if c[SecretsHashAnnotation] == a[SecretsHashAnnotation] {
+		t.Fatal("expected the boundaries of the values to change the hash")
+	}
+}
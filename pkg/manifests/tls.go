@@ -15,19 +15,10 @@
 package manifests
 
 import (
-	"crypto/rand"
-	"crypto/x509"
-	"fmt"
-	"math/big"
 	"time"
 
-	"k8s.io/klog"
-
 	"github.com/openshift/library-go/pkg/crypto"
-	"github.com/pkg/errors"
 	v1 "k8s.io/api/core/v1"
-	"k8s.io/apimachinery/pkg/util/sets"
-	"k8s.io/apiserver/pkg/authentication/user"
 )
 
 const certificateLifetime = time.Duration(crypto.DefaultCertificateLifetimeInDays) * 24 * time.Hour
@@ -54,10 +45,24 @@ func (f *Factory) GRPCSecret() (*v1.Secret, error) {
 	return s, nil
 }
 
+// GRPCCertificateAuthority declares the CA securing the Thanos gRPC
+// connections and the identities of the components using them. Thanos
+// Querier verifies the stores with the "prometheus-grpc" server name.
+var GRPCCertificateAuthority = CertificateAuthority{
+	Name: "openshift-cluster-monitoring",
+	Certificates: []LeafCertificate{
+		{Name: "thanos-querier-client", CommonName: "thanos-querier", Usage: ClientCertificate},
+		{Name: "prometheus-server", Hosts: []string{"prometheus-grpc"}, Usage: ServerCertificate},
+		{Name: "prometheus-user-workload-server", Hosts: []string{"prometheus-grpc"}, Usage: ServerCertificate},
+		{Name: "thanos-ruler-server", Hosts: []string{"prometheus-grpc"}, Usage: ServerCertificate},
+	},
+}
+
 // RotateGRPCSecret rotates key material for Thanos GRPC TLS based communication.
 //
 // If no key material is present, it creates it.
-// It "rotates" the CA and all server and client certificates and keys 1/5 before the expiry timespan.
+// It rotates the CA and all server and client certificates and keys 1/5 before the expiry timespan,
+// see CertificateAuthority for the details.
 //
 // The rotation scheme here assumes the following threat model:
 //
@@ -66,127 +71,17 @@ func (f *Factory) GRPCSecret() (*v1.Secret, error) {
 //    This is addressed by expiry and time based rotation.
 // 2. Client and server certificates as well as their private key could be compromised
 //    as they are being mounted into multiple pods reachable externally i.e. via routes.
-//    This is addressed by re-issuing them at the same time the CA expires.
-// 3. The CA's private key is left out of the thread model as it is not mounted in any pod.
-//    This implementation assumes it can stay immutable and does not need rotation.
+//    This is addressed by re-issuing them when the CA is rotated.
+// 3. The CA's private key is not mounted in any pod. It is replaced along with the CA
+//    certificate, the old CA remains trusted until it expires to avoid downtime.
 func RotateGRPCSecret(s *v1.Secret) error {
-	var (
-		curCA, newCA              *crypto.CA
-		curCABytes, crtPresent    = s.Data["ca.crt"]
-		curCAKeyBytes, keyPresent = s.Data["ca.key"]
-		rotate                    = !crtPresent || !keyPresent
-	)
-
-	if crtPresent && keyPresent {
-		var err error
-		curCA, err = crypto.GetCAFromBytes(curCABytes, curCAKeyBytes)
-		if err != nil {
-			klog.Warningf("generating a new CA due to error reading CA: %v", err)
-			rotate = true
-		} else if needsNewCert(curCA.Config.Certs[0].NotBefore, curCA.Config.Certs[0].NotAfter, time.Now) {
-			rotate = true
-		}
-	}
-
-	if _, ok := s.Annotations["monitoring.openshift.io/grpc-tls-forced-rotate"]; ok {
-		rotate = true
-		delete(s.Annotations, "monitoring.openshift.io/grpc-tls-forced-rotate")
-	}
-
-	if !rotate {
-		return nil
-	}
-
-	if curCA == nil {
-		newCAConfig, err := crypto.MakeSelfSignedCAConfig(
-			fmt.Sprintf("%s@%d", "openshift-cluster-monitoring", time.Now().Unix()),
-			crypto.DefaultCertificateLifetimeInDays,
-		)
-		if err != nil {
-			return errors.Wrap(err, "error generating self signed CA")
-		}
-
-		newCA = &crypto.CA{
-			SerialGenerator: &crypto.RandomSerialGenerator{},
-			Config:          newCAConfig,
-		}
-	} else {
-		template := curCA.Config.Certs[0]
-		now := time.Now()
-		template.NotBefore = now.Add(-1 * time.Second)
-		template.NotAfter = now.Add(certificateLifetime)
-		template.SerialNumber = template.SerialNumber.Add(template.SerialNumber, big.NewInt(1))
-
-		newCACert, err := createCertificate(template, template, template.PublicKey, curCA.Config.Key)
-		if err != nil {
-			return errors.Wrap(err, "error rotating CA")
-		}
-
-		newCA = &crypto.CA{
-			SerialGenerator: &crypto.RandomSerialGenerator{},
-			Config: &crypto.TLSCertificateConfig{
-				Certs: []*x509.Certificate{newCACert},
-				Key:   curCA.Config.Key,
-			},
-		}
-	}
-
-	newCABytes, newCAKeyBytes, err := newCA.Config.GetPEMBytes()
-	if err != nil {
-		return errors.Wrap(err, "error getting PEM bytes from CA")
-	}
-
-	s.Data["ca.crt"] = newCABytes
-	s.Data["ca.key"] = newCAKeyBytes
-
-	{
-		cfg, err := newCA.MakeClientCertificateForDuration(
-			&user.DefaultInfo{
-				Name: "thanos-querier",
-			},
-			time.Duration(crypto.DefaultCertificateLifetimeInDays)*24*time.Hour,
-		)
-		if err != nil {
-			return errors.Wrap(err, "error making client certificate")
-		}
-
-		crt, key, err := cfg.GetPEMBytes()
-		if err != nil {
-			return errors.Wrap(err, "error getting PEM bytes for thanos querier client certificate")
-		}
-		s.Data["thanos-querier-client.crt"] = crt
-		s.Data["thanos-querier-client.key"] = key
-	}
-
-	{
-		cfg, err := newCA.MakeServerCert(
-			sets.NewString("prometheus-grpc"),
-			crypto.DefaultCertificateLifetimeInDays,
-		)
-		if err != nil {
-			return errors.Wrap(err, "error making server certificate")
-		}
-
-		crt, key, err := cfg.GetPEMBytes()
-		if err != nil {
-			return errors.Wrap(err, "error getting PEM bytes for prometheus-k8s server certificate")
-		}
-		s.Data["prometheus-server.crt"] = crt
-		s.Data["prometheus-server.key"] = key
-	}
-
-	return nil
+	return GRPCCertificateAuthority.Rotate(s, time.Now())
 }
 
-// createCertificate creates a new certificate and returns it in x509.Certificate form.
-func createCertificate(template, parent *x509.Certificate, pub, priv interface{}) (*x509.Certificate, error) {
-	rawCert, err := x509.CreateCertificate(rand.Reader, template, parent, pub, priv)
-	if err != nil {
-		return nil, fmt.Errorf("error creating certificate: %v", err)
-	}
-	parsedCerts, err := x509.ParseCertificates(rawCert)
-	if err != nil {
-		return nil, fmt.Errorf("error parsing certificate: %v", err)
-	}
-	return parsedCerts[0], nil
+// IssueGRPCSecret issues the Thanos GRPC TLS certificates from a CA provided
+// by the user instead of the operator's own CA. The certificates are
+// reissued with the same thresholds as in RotateGRPCSecret, see
+// CertificateAuthority.Issue for the details.
+func IssueGRPCSecret(s *v1.Secret, issuer CertificateIssuer) error {
+	return GRPCCertificateAuthority.Issue(s, issuer, time.Now())
 }
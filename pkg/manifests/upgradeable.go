@@ -0,0 +1,124 @@
+// Copyright 2020 The Cluster Monitoring Operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package manifests
+
+import (
+	"fmt"
+	"strings"
+)
+
+// UpgradeBlocker is a reason for the cluster not to be upgraded.
+type UpgradeBlocker struct {
+	// Reason is a PascalCase identifier of the blocker.
+	Reason string
+	// Message tells the administrator how to lift the blocker.
+	Message string
+}
+
+// UpgradeRule checks a configuration and returns the blocker it finds, nil
+// if the configuration doesn't prevent upgrades. The rules of
+// UpgradeAdvisoryRules return findings which don't block upgrades.
+type UpgradeRule func(*Config) *UpgradeBlocker
+
+// UpgradeRules are the rules evaluated by the operator to compute the
+// Upgradeable condition. New checks are added by appending to them.
+var UpgradeRules = []UpgradeRule{
+	deprecatedFieldsRule,
+}
+
+// UpgradeAdvisoryRules are evaluated like UpgradeRules but the operator only
+// reports what they find in the message of the Upgradeable condition. They
+// check settings which are risky during upgrades but common, e.g. defaults,
+// or which have no effect anymore.
+var UpgradeAdvisoryRules = []UpgradeRule{
+	removedFieldsRule,
+	prometheusStorageRule,
+}
+
+// UpgradeBlockers evaluates rules against the configuration and returns the
+// blockers they found, in the order of the rules.
+func (c *Config) UpgradeBlockers(rules []UpgradeRule) []UpgradeBlocker {
+	var blockers []UpgradeBlocker
+	for _, rule := range rules {
+		if b := rule(c); b != nil {
+			blockers = append(blockers, *b)
+		}
+	}
+	return blockers
+}
+
+// deprecatedFields returns the YAML keys of the deprecated fields set in
+// the configuration. It must be called before defaults are applied.
+func (c *ClusterMonitoringConfiguration) deprecatedFields() []string {
+	var fields []string
+	for _, f := range []struct {
+		name string
+		set  bool
+	}{
+		{"prometheusUserWorkload", c.PrometheusUserWorkloadConfig != nil},
+		{"prometheusOperatorUserWorkload", c.PrometheusOperatorUserWorkloadConfig != nil},
+		{"thanosRuler", c.ThanosRulerConfig != nil},
+		{"techPreviewUserWorkload", c.UserWorkloadConfig != nil},
+	} {
+		if f.set {
+			fields = append(fields, f.name)
+		}
+	}
+	return fields
+}
+
+// deprecatedFieldsRule blocks upgrades while the configuration sets fields
+// which the next release no longer supports.
+func deprecatedFieldsRule(c *Config) *UpgradeBlocker {
+	if len(c.deprecatedFields) == 0 {
+		return nil
+	}
+	return &UpgradeBlocker{
+		Reason: "DeprecatedConfigurationFields",
+		Message: fmt.Sprintf(
+			"The cluster monitoring configuration uses deprecated fields: %s. Move the user workload settings to the user-workload-monitoring-config ConfigMap and use enableUserWorkload instead.",
+			strings.Join(c.deprecatedFields, ", "),
+		),
+	}
+}
+
+// removedFieldsRule reports the fields of the configuration which are
+// ignored since earlier releases. They don't block upgrades as they have no
+// effect, but later releases may reject them.
+func removedFieldsRule(c *Config) *UpgradeBlocker {
+	if len(c.removedFields) == 0 {
+		return nil
+	}
+	return &UpgradeBlocker{
+		Reason: "RemovedConfigurationFields",
+		Message: fmt.Sprintf(
+			"The cluster monitoring configuration sets fields which are no longer supported and ignored: %s. Remove them from the configuration.",
+			strings.Join(c.removedFields, ", "),
+		),
+	}
+}
+
+// prometheusStorageRule reports that Prometheus stores its data in ephemeral
+// volumes, which lose the data on rollout. This is the default and doesn't
+// block upgrades.
+func prometheusStorageRule(c *Config) *UpgradeBlocker {
+	if c.ClusterMonitoringConfiguration.PrometheusK8sConfig.VolumeClaimTemplate != nil {
+		return nil
+	}
+	return &UpgradeBlocker{
+		Reason:  "PrometheusDataPersistenceNotConfigured",
+		Message: "Prometheus runs without persistent storage, its data is lost when the pods are rescheduled during upgrades. Configure prometheusK8s.volumeClaimTemplate.",
+	}
+}
@@ -0,0 +1,129 @@
+// Copyright 2020 The Cluster Monitoring Operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package manifests
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestUpgradeBlockers(t *testing.T) {
+	const persistent = `prometheusK8s:
+  volumeClaimTemplate:
+    spec:
+      resources:
+        requests:
+          storage: 40Gi
+`
+
+	for _, tc := range []struct {
+		name       string
+		config     string
+		expected   []string
+		advisories []string
+	}{
+		{
+			// The default configuration must never block upgrades.
+			name:       "default config",
+			config:     "",
+			advisories: []string{"PrometheusDataPersistenceNotConfigured"},
+		},
+		{
+			name:   "persistent storage",
+			config: persistent,
+		},
+		{
+			name:   "new user workload fields",
+			config: persistent + "enableUserWorkload: true\n",
+		},
+		{
+			name: "deprecated user workload fields",
+			config: persistent + `techPreviewUserWorkload:
+  enabled: true
+prometheusUserWorkload:
+  retention: 1d
+`,
+			expected: []string{"DeprecatedConfigurationFields"},
+		},
+		{
+			name: "removed fields",
+			config: persistent + `ingress:
+  baseAddress: example.com
+`,
+			advisories: []string{"RemovedConfigurationFields"},
+		},
+		{
+			name:       "blockers and advisories",
+			config:     "thanosRuler: {}\n",
+			expected:   []string{"DeprecatedConfigurationFields"},
+			advisories: []string{"PrometheusDataPersistenceNotConfigured"},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			c, err := NewConfigFromString(tc.config)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			reasons := func(rules []UpgradeRule) []string {
+				var reasons []string
+				for _, b := range c.UpgradeBlockers(rules) {
+					if b.Message == "" {
+						t.Errorf("expected a message for %q", b.Reason)
+					}
+					reasons = append(reasons, b.Reason)
+				}
+				return reasons
+			}
+			if got := reasons(UpgradeRules); !reflect.DeepEqual(got, tc.expected) {
+				t.Fatalf("expected blockers %v, got %v", tc.expected, got)
+			}
+			if got := reasons(UpgradeAdvisoryRules); !reflect.DeepEqual(got, tc.advisories) {
+				t.Fatalf("expected advisories %v, got %v", tc.advisories, got)
+			}
+		})
+	}
+}
+
+func TestRemovedFieldsMessage(t *testing.T) {
+	c, err := NewConfigFromString("etcd: {}\nprometheusK8s:\n  baseImage: quay.io/prometheus/prometheus\n")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	b := removedFieldsRule(c)
+	if b == nil {
+		t.Fatal("expected an advisory")
+	}
+	if !strings.Contains(b.Message, "etcd, prometheusK8s.baseImage") {
+		t.Fatalf("expected the message to list the removed fields, got %q", b.Message)
+	}
+}
+
+func TestDeprecatedFieldsMessage(t *testing.T) {
+	c, err := NewConfigFromString("techPreviewUserWorkload: {}\nprometheusOperatorUserWorkload: {}\n")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	b := deprecatedFieldsRule(c)
+	if b == nil {
+		t.Fatal("expected a blocker")
+	}
+	if !strings.Contains(b.Message, "prometheusOperatorUserWorkload, techPreviewUserWorkload") {
+		t.Fatalf("expected the message to list the deprecated fields, got %q", b.Message)
+	}
+}
@@ -0,0 +1,525 @@
+// Copyright 2020 The Cluster Monitoring Operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package manifests
+
+import (
+	"encoding/json"
+	"net/url"
+	"reflect"
+	"sort"
+	"strings"
+
+	monv1 "github.com/coreos/prometheus-operator/pkg/apis/monitoring/v1"
+	"github.com/prometheus/common/model"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	k8syaml "k8s.io/apimachinery/pkg/util/yaml"
+)
+
+var (
+	supportedLogLevels = []string{"debug", "info", "warn", "error"}
+
+	supportedTolerationOperators = []string{
+		string(v1.TolerationOpEqual),
+		string(v1.TolerationOpExists),
+	}
+
+	supportedManagementStates = []string{
+		string(Managed),
+		string(Unmanaged),
+		string(Removed),
+	}
+
+	supportedTaintEffects = []string{
+		string(v1.TaintEffectNoSchedule),
+		string(v1.TaintEffectPreferNoSchedule),
+		string(v1.TaintEffectNoExecute),
+	}
+
+	jsonUnmarshalerType = reflect.TypeOf((*json.Unmarshaler)(nil)).Elem()
+)
+
+// removedClusterMonitoringFields are the paths of the fields which earlier
+// releases accepted in the cluster monitoring configuration and which are
+// now ignored. Unlike unknown fields they don't invalidate the configuration
+// so that clusters configured by these releases keep being reconciled.
+var removedClusterMonitoringFields = sets.NewString(
+	"auth",
+	"etcd",
+	"ingress",
+	"kubeRbacProxy",
+	"nodeExporter",
+	"alertmanagerMain.baseImage",
+	"alertmanagerMain.externalUrl",
+	"grafana.baseImage",
+	"kubeStateMetrics.addonResizerBaseImage",
+	"kubeStateMetrics.baseImage",
+	"prometheusK8s.baseImage",
+	"prometheusK8s.externalUrl",
+	"prometheusOperator.baseImage",
+	"prometheusOperator.configReloaderBaseImage",
+	"prometheusOperator.configReloaderImage",
+	"prometheusOperator.image",
+	"prometheusOperator.prometheusConfigReloaderBaseImage",
+	"prometheusOperator.prometheusConfigReloaderImage",
+)
+
+// decodeStrict converts the given YAML or JSON content to JSON and decodes it
+// into out. Fields which are not known to out are returned as field errors
+// together with their full path instead of being silently dropped, unless
+// their path is one of the removed ones. The removed fields which are set
+// are returned instead. The error is only set when the content can't be
+// decoded at all.
+func decodeStrict(content []byte, out interface{}, removed sets.String) (field.ErrorList, []string, error) {
+	data, err := k8syaml.ToJSON(content)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var raw interface{}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return nil, nil, err
+	}
+
+	if err := json.Unmarshal(data, out); err != nil {
+		return nil, nil, err
+	}
+
+	v := &knownFieldsValidator{removed: removed}
+	return v.validate(raw, reflect.TypeOf(out), nil), v.removedSet, nil
+}
+
+// knownFieldsValidator checks that the keys of a configuration are known.
+type knownFieldsValidator struct {
+	// removed are the paths of the removed fields, which are tolerated.
+	removed sets.String
+	// removedSet are the paths of the removed fields found.
+	removedSet []string
+}
+
+// validate walks the generic value decoded from the configuration and checks
+// that every map key matches a JSON field of the given type.
+func (v *knownFieldsValidator) validate(raw interface{}, t reflect.Type, fldPath *field.Path) field.ErrorList {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+
+	// Types with custom decoding such as resource.Quantity handle their
+	// own validation.
+	if reflect.PtrTo(t).Implements(jsonUnmarshalerType) {
+		return nil
+	}
+
+	allErrs := field.ErrorList{}
+	switch t.Kind() {
+	case reflect.Struct:
+		m, ok := raw.(map[string]interface{})
+		if !ok {
+			return allErrs
+		}
+
+		fields := jsonFields(t)
+		keys := make([]string, 0, len(m))
+		for k := range m {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+
+		for _, k := range keys {
+			f, ok := lookupJSONField(fields, k)
+			if !ok {
+				if p := fldPath.Child(k).String(); v.removed.Has(p) {
+					v.removedSet = append(v.removedSet, p)
+					continue
+				}
+				allErrs = append(allErrs, field.NotSupported(fldPath.Child(k), k, knownFieldNames(fields)))
+				continue
+			}
+			allErrs = append(allErrs, v.validate(m[k], f, fldPath.Child(k))...)
+		}
+	case reflect.Map:
+		m, ok := raw.(map[string]interface{})
+		if !ok {
+			return allErrs
+		}
+		keys := make([]string, 0, len(m))
+		for k := range m {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+
+		for _, k := range keys {
+			allErrs = append(allErrs, v.validate(m[k], t.Elem(), fldPath.Key(k))...)
+		}
+	case reflect.Slice, reflect.Array:
+		s, ok := raw.([]interface{})
+		if !ok {
+			return allErrs
+		}
+		for i, e := range s {
+			allErrs = append(allErrs, v.validate(e, t.Elem(), fldPath.Index(i))...)
+		}
+	}
+
+	return allErrs
+}
+
+// jsonFields returns the JSON field names of the given struct type mapped to
+// their Go types, following the encoding/json rules for embedded structs.
+func jsonFields(t reflect.Type) map[string]reflect.Type {
+	fields := map[string]reflect.Type{}
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if f.PkgPath != "" && !f.Anonymous {
+			continue
+		}
+
+		tag := f.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+		name := strings.Split(tag, ",")[0]
+
+		ft := f.Type
+		for ft.Kind() == reflect.Ptr {
+			ft = ft.Elem()
+		}
+		if f.Anonymous && name == "" && ft.Kind() == reflect.Struct {
+			for k, v := range jsonFields(ft) {
+				if _, ok := fields[k]; !ok {
+					fields[k] = v
+				}
+			}
+			continue
+		}
+
+		if name == "" {
+			name = f.Name
+		}
+		fields[name] = f.Type
+	}
+	return fields
+}
+
+// lookupJSONField finds the field for the given key. Like encoding/json it
+// prefers an exact match and falls back to a case-insensitive one.
+func lookupJSONField(fields map[string]reflect.Type, key string) (reflect.Type, bool) {
+	if t, ok := fields[key]; ok {
+		return t, true
+	}
+	for name, t := range fields {
+		if strings.EqualFold(name, key) {
+			return t, true
+		}
+	}
+	return nil, false
+}
+
+func knownFieldNames(fields map[string]reflect.Type) []string {
+	names := make([]string, 0, len(fields))
+	for name := range fields {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Validate checks the values of the cluster monitoring configuration and
+// returns all errors found.
+func (c *ClusterMonitoringConfiguration) Validate() field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if c.PrometheusOperatorConfig != nil {
+		fldPath := field.NewPath("prometheusOperator")
+		allErrs = append(allErrs, validateManagementState(c.PrometheusOperatorConfig.ManagementState, false, fldPath.Child("managementState"))...)
+		allErrs = append(allErrs, c.PrometheusOperatorConfig.validate(fldPath)...)
+	}
+	if c.PrometheusOperatorUserWorkloadConfig != nil {
+		fldPath := field.NewPath("prometheusOperatorUserWorkload")
+		allErrs = append(allErrs, forbidManagementState(c.PrometheusOperatorUserWorkloadConfig.ManagementState, fldPath.Child("managementState"))...)
+		allErrs = append(allErrs, c.PrometheusOperatorUserWorkloadConfig.validate(fldPath)...)
+	}
+	if c.PrometheusK8sConfig != nil {
+		fldPath := field.NewPath("prometheusK8s")
+		allErrs = append(allErrs, validateManagementState(c.PrometheusK8sConfig.ManagementState, false, fldPath.Child("managementState"))...)
+		allErrs = append(allErrs, c.PrometheusK8sConfig.validate(fldPath)...)
+	}
+	if c.PrometheusUserWorkloadConfig != nil {
+		fldPath := field.NewPath("prometheusUserWorkload")
+		allErrs = append(allErrs, forbidManagementState(c.PrometheusUserWorkloadConfig.ManagementState, fldPath.Child("managementState"))...)
+		allErrs = append(allErrs, c.PrometheusUserWorkloadConfig.validate(fldPath)...)
+	}
+	if c.AlertmanagerMainConfig != nil {
+		fldPath := field.NewPath("alertmanagerMain")
+		allErrs = append(allErrs, validateManagementState(c.AlertmanagerMainConfig.ManagementState, false, fldPath.Child("managementState"))...)
+		allErrs = append(allErrs, validateNodeSelector(c.AlertmanagerMainConfig.NodeSelector, fldPath.Child("nodeSelector"))...)
+		allErrs = append(allErrs, validateTolerations(c.AlertmanagerMainConfig.Tolerations, fldPath.Child("tolerations"))...)
+	}
+	if c.ThanosRulerConfig != nil {
+		allErrs = append(allErrs, c.ThanosRulerConfig.validate(field.NewPath("thanosRuler"))...)
+	}
+	if c.ThanosQuerierConfig != nil {
+		fldPath := field.NewPath("thanosQuerier")
+		allErrs = append(allErrs, validateManagementState(c.ThanosQuerierConfig.ManagementState, false, fldPath.Child("managementState"))...)
+		allErrs = append(allErrs, validateNodeSelector(c.ThanosQuerierConfig.NodeSelector, fldPath.Child("nodeSelector"))...)
+		allErrs = append(allErrs, validateTolerations(c.ThanosQuerierConfig.Tolerations, fldPath.Child("tolerations"))...)
+	}
+	if c.GrafanaConfig != nil {
+		fldPath := field.NewPath("grafana")
+		allErrs = append(allErrs, validateManagementState(c.GrafanaConfig.ManagementState, false, fldPath.Child("managementState"))...)
+		allErrs = append(allErrs, validateNodeSelector(c.GrafanaConfig.NodeSelector, fldPath.Child("nodeSelector"))...)
+		allErrs = append(allErrs, validateTolerations(c.GrafanaConfig.Tolerations, fldPath.Child("tolerations"))...)
+	}
+	if c.KubeStateMetricsConfig != nil {
+		fldPath := field.NewPath("kubeStateMetrics")
+		allErrs = append(allErrs, validateManagementState(c.KubeStateMetricsConfig.ManagementState, false, fldPath.Child("managementState"))...)
+		allErrs = append(allErrs, validateNodeSelector(c.KubeStateMetricsConfig.NodeSelector, fldPath.Child("nodeSelector"))...)
+		allErrs = append(allErrs, validateTolerations(c.KubeStateMetricsConfig.Tolerations, fldPath.Child("tolerations"))...)
+	}
+	if c.OpenShiftMetricsConfig != nil {
+		fldPath := field.NewPath("openshiftStateMetrics")
+		allErrs = append(allErrs, validateManagementState(c.OpenShiftMetricsConfig.ManagementState, true, fldPath.Child("managementState"))...)
+		allErrs = append(allErrs, validateNodeSelector(c.OpenShiftMetricsConfig.NodeSelector, fldPath.Child("nodeSelector"))...)
+		allErrs = append(allErrs, validateTolerations(c.OpenShiftMetricsConfig.Tolerations, fldPath.Child("tolerations"))...)
+	}
+	if c.K8sPrometheusAdapter != nil {
+		fldPath := field.NewPath("k8sPrometheusAdapter")
+		allErrs = append(allErrs, validateManagementState(c.K8sPrometheusAdapter.ManagementState, false, fldPath.Child("managementState"))...)
+		allErrs = append(allErrs, validateNodeSelector(c.K8sPrometheusAdapter.NodeSelector, fldPath.Child("nodeSelector"))...)
+		allErrs = append(allErrs, validateTolerations(c.K8sPrometheusAdapter.Tolerations, fldPath.Child("tolerations"))...)
+	}
+	if c.HTTPConfig != nil {
+		fldPath := field.NewPath("http")
+		allErrs = append(allErrs, validateURL(c.HTTPConfig.HTTPProxy, fldPath.Child("httpProxy"))...)
+		allErrs = append(allErrs, validateURL(c.HTTPConfig.HTTPSProxy, fldPath.Child("httpsProxy"))...)
+	}
+	if c.TelemeterClientConfig != nil {
+		fldPath := field.NewPath("telemeterClient")
+		allErrs = append(allErrs, validateManagementState(c.TelemeterClientConfig.ManagementState, true, fldPath.Child("managementState"))...)
+		allErrs = append(allErrs, validateURL(c.TelemeterClientConfig.TelemeterServerURL, fldPath.Child("telemeterServerURL"))...)
+		allErrs = append(allErrs, validateNodeSelector(c.TelemeterClientConfig.NodeSelector, fldPath.Child("nodeSelector"))...)
+		allErrs = append(allErrs, validateTolerations(c.TelemeterClientConfig.Tolerations, fldPath.Child("tolerations"))...)
+	}
+	if c.GRPCTLSConfig != nil && c.GRPCTLSConfig.CASecret != "" {
+		fldPath := field.NewPath("grpcTLS", "caSecret")
+		for _, msg := range validation.IsDNS1123Subdomain(c.GRPCTLSConfig.CASecret) {
+			allErrs = append(allErrs, field.Invalid(fldPath, c.GRPCTLSConfig.CASecret, msg))
+		}
+	}
+	if c.SecretRotationConfig != nil {
+		allErrs = append(allErrs, validateDuration(c.SecretRotationConfig.Period, field.NewPath("secretRotation", "period"))...)
+	}
+
+	return allErrs
+}
+
+// Validate checks the values of the user workload configuration and returns
+// all errors found.
+func (u *UserWorkloadConfiguration) Validate() field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if u.PrometheusOperator != nil {
+		fldPath := field.NewPath("prometheusOperator")
+		allErrs = append(allErrs, forbidManagementState(u.PrometheusOperator.ManagementState, fldPath.Child("managementState"))...)
+		allErrs = append(allErrs, u.PrometheusOperator.validate(fldPath)...)
+	}
+	if u.Prometheus != nil {
+		fldPath := field.NewPath("prometheus")
+		allErrs = append(allErrs, validateLogLevel(u.Prometheus.LogLevel, fldPath.Child("logLevel"))...)
+		allErrs = append(allErrs, validateDuration(u.Prometheus.Retention, fldPath.Child("retention"))...)
+		allErrs = append(allErrs, validateNodeSelector(u.Prometheus.NodeSelector, fldPath.Child("nodeSelector"))...)
+		allErrs = append(allErrs, validateTolerations(u.Prometheus.Tolerations, fldPath.Child("tolerations"))...)
+		allErrs = append(allErrs, validateExternalLabels(u.Prometheus.ExternalLabels, fldPath.Child("externalLabels"))...)
+		allErrs = append(allErrs, validateRemoteWrite(u.Prometheus.RemoteWrite, fldPath.Child("remoteWrite"))...)
+	}
+	if u.ThanosRuler != nil {
+		allErrs = append(allErrs, u.ThanosRuler.validate(field.NewPath("thanosRuler"))...)
+	}
+
+	return allErrs
+}
+
+func (c *PrometheusOperatorConfig) validate(fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	allErrs = append(allErrs, validateLogLevel(c.LogLevel, fldPath.Child("logLevel"))...)
+	allErrs = append(allErrs, validateNodeSelector(c.NodeSelector, fldPath.Child("nodeSelector"))...)
+	allErrs = append(allErrs, validateTolerations(c.Tolerations, fldPath.Child("tolerations"))...)
+	return allErrs
+}
+
+func (c *PrometheusK8sConfig) validate(fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	allErrs = append(allErrs, validateLogLevel(c.LogLevel, fldPath.Child("logLevel"))...)
+	allErrs = append(allErrs, validateDuration(c.Retention, fldPath.Child("retention"))...)
+	allErrs = append(allErrs, validateNodeSelector(c.NodeSelector, fldPath.Child("nodeSelector"))...)
+	allErrs = append(allErrs, validateTolerations(c.Tolerations, fldPath.Child("tolerations"))...)
+	allErrs = append(allErrs, validateExternalLabels(c.ExternalLabels, fldPath.Child("externalLabels"))...)
+	allErrs = append(allErrs, validateRemoteWrite(c.RemoteWrite, fldPath.Child("remoteWrite"))...)
+	return allErrs
+}
+
+func (c *ThanosRulerConfig) validate(fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	allErrs = append(allErrs, validateLogLevel(c.LogLevel, fldPath.Child("logLevel"))...)
+	allErrs = append(allErrs, validateNodeSelector(c.NodeSelector, fldPath.Child("nodeSelector"))...)
+	allErrs = append(allErrs, validateTolerations(c.Tolerations, fldPath.Child("tolerations"))...)
+	return allErrs
+}
+
+// validateManagementState checks the management state of a component. Only
+// components which can be deleted without breaking others are removable.
+func validateManagementState(state ManagementState, removable bool, fldPath *field.Path) field.ErrorList {
+	// Removed is the last supported state.
+	supported := supportedManagementStates[:len(supportedManagementStates)-1]
+	if removable {
+		supported = supportedManagementStates
+	}
+	if state == "" || sets.NewString(supported...).Has(string(state)) {
+		return nil
+	}
+	return field.ErrorList{field.NotSupported(fldPath, state, supported)}
+}
+
+// forbidManagementState rejects management states of components sharing a
+// configuration type with a component which has one.
+func forbidManagementState(state ManagementState, fldPath *field.Path) field.ErrorList {
+	if state == "" {
+		return nil
+	}
+	return field.ErrorList{field.Forbidden(fldPath, "management state is not supported for this component")}
+}
+
+func validateLogLevel(level string, fldPath *field.Path) field.ErrorList {
+	if level == "" || sets.NewString(supportedLogLevels...).Has(level) {
+		return nil
+	}
+	return field.ErrorList{field.NotSupported(fldPath, level, supportedLogLevels)}
+}
+
+func validateDuration(d string, fldPath *field.Path) field.ErrorList {
+	if d == "" {
+		return nil
+	}
+	if _, err := model.ParseDuration(d); err != nil {
+		return field.ErrorList{field.Invalid(fldPath, d, "must be a duration such as 24h or 15d")}
+	}
+	return nil
+}
+
+// sortedKeys returns the keys of m in order so that the errors found in
+// maps are always reported in the same order.
+func sortedKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func validateNodeSelector(selector map[string]string, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	for _, k := range sortedKeys(selector) {
+		v := selector[k]
+		for _, msg := range validation.IsQualifiedName(k) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Key(k), k, msg))
+		}
+		for _, msg := range validation.IsValidLabelValue(v) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Key(k), v, msg))
+		}
+	}
+	return allErrs
+}
+
+// validateTolerations mirrors the checks the API server applies to pod
+// tolerations so that invalid entries are caught before any object is
+// created.
+func validateTolerations(tolerations []v1.Toleration, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	for i, t := range tolerations {
+		idxPath := fldPath.Index(i)
+
+		if t.Key != "" {
+			for _, msg := range validation.IsQualifiedName(t.Key) {
+				allErrs = append(allErrs, field.Invalid(idxPath.Child("key"), t.Key, msg))
+			}
+		}
+
+		if t.Key == "" && t.Operator != v1.TolerationOpExists {
+			allErrs = append(allErrs, field.Invalid(idxPath.Child("operator"), t.Operator, "operator must be Exists when `key` is empty"))
+		}
+
+		if t.TolerationSeconds != nil && t.Effect != v1.TaintEffectNoExecute {
+			allErrs = append(allErrs, field.Invalid(idxPath.Child("effect"), t.Effect, "effect must be 'NoExecute' when `tolerationSeconds` is set"))
+		}
+
+		switch t.Operator {
+		case v1.TolerationOpEqual, "":
+			for _, msg := range validation.IsValidLabelValue(t.Value) {
+				allErrs = append(allErrs, field.Invalid(idxPath.Child("value"), t.Value, msg))
+			}
+		case v1.TolerationOpExists:
+			if t.Value != "" {
+				allErrs = append(allErrs, field.Invalid(idxPath.Child("value"), t.Value, "value must be empty when `operator` is 'Exists'"))
+			}
+		default:
+			allErrs = append(allErrs, field.NotSupported(idxPath.Child("operator"), t.Operator, supportedTolerationOperators))
+		}
+
+		if t.Effect != "" && !sets.NewString(supportedTaintEffects...).Has(string(t.Effect)) {
+			allErrs = append(allErrs, field.NotSupported(idxPath.Child("effect"), t.Effect, supportedTaintEffects))
+		}
+	}
+	return allErrs
+}
+
+func validateExternalLabels(labels map[string]string, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	for _, k := range sortedKeys(labels) {
+		if !model.LabelName(k).IsValid() {
+			allErrs = append(allErrs, field.Invalid(fldPath, k, "must be a valid Prometheus label name"))
+		}
+	}
+	return allErrs
+}
+
+func validateRemoteWrite(specs []monv1.RemoteWriteSpec, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	for i, rw := range specs {
+		urlPath := fldPath.Index(i).Child("url")
+		if rw.URL == "" {
+			allErrs = append(allErrs, field.Required(urlPath, ""))
+			continue
+		}
+		allErrs = append(allErrs, validateURL(rw.URL, urlPath)...)
+	}
+	return allErrs
+}
+
+func validateURL(value string, fldPath *field.Path) field.ErrorList {
+	if value == "" {
+		return nil
+	}
+	u, err := url.Parse(value)
+	if err != nil {
+		return field.ErrorList{field.Invalid(fldPath, value, err.Error())}
+	}
+	if u.Scheme == "" || u.Host == "" {
+		return field.ErrorList{field.Invalid(fldPath, value, "must be an absolute URL")}
+	}
+	return nil
+}
@@ -0,0 +1,304 @@
+// Copyright 2020 The Cluster Monitoring Operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package manifests
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestConfigValidation(t *testing.T) {
+	for _, tc := range []struct {
+		name   string
+		config string
+		errs   []string
+	}{
+		{
+			name: "valid",
+			config: `prometheusK8s:
+  retention: 15d
+  logLevel: debug
+  nodeSelector:
+    node-role.kubernetes.io/infra: ""
+  tolerations:
+  - key: node-role.kubernetes.io/infra
+    operator: Exists
+    effect: NoSchedule
+  externalLabels:
+    cluster: foo
+  resources:
+    requests:
+      memory: 2Gi
+telemeterClient:
+  telemeterServerURL: https://telemeter.example.com/
+`,
+		},
+		{
+			name:   "case-insensitive field names",
+			config: `{"PrometheusK8s": {"Retention": "1d"}}`,
+		},
+		{
+			name: "unknown fields",
+			config: `prometheusK8s:
+  retension: 1d
+grafana:
+  nodeSelektor:
+    foo: bar
+`,
+			errs: []string{
+				`grafana.nodeSelektor: Unsupported value: "nodeSelektor"`,
+				`prometheusK8s.retension: Unsupported value: "retension"`,
+			},
+		},
+		{
+			name: "removed fields",
+			config: `prometheusK8s:
+  baseImage: quay.io/prometheus/prometheus
+  externalUrl: https://example.com/prometheus
+ingress:
+  baseAddress: example.com
+etcd:
+  targets:
+    selector:
+      openshift.io/component: etcd
+`,
+		},
+		{
+			name: "misspelled removed fields",
+			config: `prometheusK8s:
+  baseImag: quay.io/prometheus/prometheus
+ingres:
+  baseAddress: example.com
+`,
+			errs: []string{
+				`ingres: Unsupported value: "ingres"`,
+				`prometheusK8s.baseImag: Unsupported value: "baseImag"`,
+			},
+		},
+		{
+			name: "unknown nested fields",
+			config: `alertmanagerMain:
+  tolerations:
+  - key: foo
+    operater: Exists
+  volumeClaimTemplate:
+    spec:
+      storageClass: fast
+`,
+			errs: []string{
+				`alertmanagerMain.tolerations[0].operater: Unsupported value: "operater"`,
+				`alertmanagerMain.volumeClaimTemplate.spec.storageClass: Unsupported value: "storageClass"`,
+			},
+		},
+		{
+			name: "invalid values",
+			config: `prometheusOperator:
+  logLevel: verbose
+prometheusK8s:
+  retention: 1month
+  externalLabels:
+    foo-bar: baz
+thanosRuler:
+  nodeSelector:
+    "foo bar": baz
+`,
+			errs: []string{
+				`prometheusOperator.logLevel: Unsupported value: "verbose"`,
+				`prometheusK8s.retention: Invalid value: "1month"`,
+				`prometheusK8s.externalLabels: Invalid value: "foo-bar"`,
+				`thanosRuler.nodeSelector[foo bar]: Invalid value: "foo bar"`,
+			},
+		},
+		{
+			name: "invalid tolerations",
+			config: `grafana:
+  tolerations:
+  - key: foo
+    operator: Exists
+    value: bar
+  - operator: Equal
+    effect: Never
+  - key: foo
+    effect: NoSchedule
+    tolerationSeconds: 10
+`,
+			errs: []string{
+				`grafana.tolerations[0].value: Invalid value: "bar"`,
+				`grafana.tolerations[1].operator: Invalid value: "Equal"`,
+				`grafana.tolerations[1].effect: Unsupported value: "Never"`,
+				`grafana.tolerations[2].effect: Invalid value: "NoSchedule"`,
+			},
+		},
+		{
+			name: "invalid urls",
+			config: `http:
+  httpProxy: proxy:3128
+prometheusK8s:
+  remoteWrite:
+  - url: ""
+`,
+			errs: []string{
+				`http.httpProxy: Invalid value: "proxy:3128"`,
+				`prometheusK8s.remoteWrite[0].url: Required value`,
+			},
+		},
+		{
+			name: "invalid gRPC CA secret",
+			config: `grpcTLS:
+  caSecret: Corporate_CA
+`,
+			errs: []string{
+				`grpcTLS.caSecret: Invalid value: "Corporate_CA"`,
+			},
+		},
+		{
+			name: "invalid secret rotation period",
+			config: `secretRotation:
+  period: 1 month
+`,
+			errs: []string{
+				`secretRotation.period: Invalid value: "1 month"`,
+			},
+		},
+		{
+			name: "management states",
+			config: `grafana:
+  managementState: Unmanaged
+telemeterClient:
+  managementState: Removed
+openshiftStateMetrics:
+  managementState: Removed
+`,
+		},
+		{
+			name: "invalid management states",
+			config: `alertmanagerMain:
+  managementState: Removed
+grafana:
+  managementState: Paused
+prometheusOperatorUserWorkload:
+  managementState: Unmanaged
+`,
+			errs: []string{
+				`alertmanagerMain.managementState: Unsupported value: "Removed": supported values: "Managed", "Unmanaged"`,
+				`grafana.managementState: Unsupported value: "Paused"`,
+				`prometheusOperatorUserWorkload.managementState: Forbidden`,
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := NewConfigFromString(tc.config)
+			checkValidationErrors(t, err, tc.errs)
+		})
+	}
+}
+
+func TestConfigValidationMessageIsStable(t *testing.T) {
+	const config = `prometheusK8s:
+  nodeSelector:
+    "d/in valid": ""
+    "b/in valid": ""
+    c: "in valid"
+    "a/in valid": ""
+  externalLabels:
+    z-label: foo
+    y-label: bar
+`
+
+	var expected string
+	for i := 0; i < 20; i++ {
+		_, err := NewConfigFromString(config)
+		if err == nil {
+			t.Fatal("expected an error")
+		}
+		if i == 0 {
+			expected = err.Error()
+			continue
+		}
+		if err.Error() != expected {
+			t.Fatalf("expected the same message for the same configuration, got %q and %q", expected, err.Error())
+		}
+	}
+
+	var last int
+	for _, s := range []string{`nodeSelector[a/in valid]`, `nodeSelector[b/in valid]`, `nodeSelector[c]`, `nodeSelector[d/in valid]`, `"y-label"`, `"z-label"`} {
+		i := strings.Index(expected, s)
+		if i < last {
+			t.Fatalf("expected the errors to be sorted by key, got %q", expected)
+		}
+		last = i
+	}
+}
+
+func TestUserConfigValidation(t *testing.T) {
+	for _, tc := range []struct {
+		name   string
+		config string
+		errs   []string
+	}{
+		{
+			name: "valid",
+			config: `prometheus:
+  retention: 24h
+  enforcedSampleLimit: 1000
+thanosRuler:
+  logLevel: warn
+`,
+		},
+		{
+			name: "unknown fields and invalid values",
+			config: `prometheus:
+  retention: forever
+  enforcedSampleLimits: 1000
+thanosRuler:
+  logLevel: trace
+prometheusOperator:
+  managementState: Unmanaged
+`,
+			errs: []string{
+				`prometheusOperator.managementState: Forbidden`,
+				`prometheus.enforcedSampleLimits: Unsupported value: "enforcedSampleLimits"`,
+				`prometheus.retention: Invalid value: "forever"`,
+				`thanosRuler.logLevel: Unsupported value: "trace"`,
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := NewUserConfigFromString(tc.config)
+			checkValidationErrors(t, err, tc.errs)
+		})
+	}
+}
+
+func checkValidationErrors(t *testing.T, err error, expected []string) {
+	t.Helper()
+
+	if len(expected) == 0 {
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		return
+	}
+
+	if err == nil {
+		t.Fatalf("expected errors %q, got none", expected)
+	}
+
+	for _, e := range expected {
+		if !strings.Contains(err.Error(), e) {
+			t.Errorf("expected error to contain %q, got %v", e, err)
+		}
+	}
+}
@@ -0,0 +1,100 @@
+// Copyright 2020 The Cluster Monitoring Operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package manifests
+
+import (
+	"github.com/pkg/errors"
+)
+
+// versionLabel is the label carrying the version of the workloads of some
+// assets.
+const versionLabel = "app.kubernetes.io/version"
+
+// OperandVersions returns the version of every operand rolled out by the
+// operator, keyed by operand name. The version is the tag of the configured
+// image or, for images referenced by digest, the version the assets declare.
+// Operands without either are left out.
+func (f *Factory) OperandVersions() (map[string]string, error) {
+	declared, err := assetVersions()
+	if err != nil {
+		return nil, err
+	}
+
+	images := f.config.Images
+	if images == nil {
+		images = &Images{}
+	}
+
+	versions := map[string]string{}
+	for operand, image := range map[string]string{
+		"prometheus":              images.Prometheus,
+		"alertmanager":            images.Alertmanager,
+		"thanos":                  images.Thanos,
+		"grafana":                 images.Grafana,
+		"node-exporter":           images.NodeExporter,
+		"kube-state-metrics":      images.KubeStateMetrics,
+		"openshift-state-metrics": images.OpenShiftStateMetrics,
+		"prometheus-operator":     images.PrometheusOperator,
+		"prometheus-adapter":      images.K8sPrometheusAdapter,
+		"telemeter-client":        images.TelemeterClient,
+	} {
+		v := imageTag(image)
+		if v == "" {
+			v = declared[operand]
+		}
+		if v != "" {
+			versions[operand] = v
+		}
+	}
+
+	return versions, nil
+}
+
+// assetVersions returns the operand versions declared by the assets.
+func assetVersions() (map[string]string, error) {
+	p, err := NewPrometheus(MustAssetReader(PrometheusK8s))
+	if err != nil {
+		return nil, errors.Wrap(err, "decoding Prometheus asset failed")
+	}
+	a, err := NewAlertmanager(MustAssetReader(AlertmanagerMain))
+	if err != nil {
+		return nil, errors.Wrap(err, "decoding Alertmanager asset failed")
+	}
+
+	versions := map[string]string{
+		"prometheus":   p.Spec.Version,
+		"alertmanager": a.Spec.Version,
+	}
+
+	for operand, asset := range map[string]string{
+		"thanos":              ThanosQuerierDeployment,
+		"kube-state-metrics":  KubeStateMetricsDeployment,
+		"prometheus-operator": PrometheusOperatorDeployment,
+	} {
+		d, err := NewDeployment(MustAssetReader(asset))
+		if err != nil {
+			return nil, errors.Wrapf(err, "decoding %s asset failed", asset)
+		}
+		versions[operand] = d.Labels[versionLabel]
+	}
+
+	ds, err := NewDaemonSet(MustAssetReader(NodeExporterDaemonSet))
+	if err != nil {
+		return nil, errors.Wrap(err, "decoding node-exporter asset failed")
+	}
+	versions["node-exporter"] = ds.Labels[versionLabel]
+
+	return versions, nil
+}
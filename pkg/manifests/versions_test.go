@@ -0,0 +1,60 @@
+// Copyright 2020 The Cluster Monitoring Operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package manifests
+
+import (
+	"testing"
+)
+
+func TestOperandVersions(t *testing.T) {
+	c := NewDefaultConfig()
+	c.SetImages(map[string]string{
+		"prometheus": "quay.io/openshift/origin-prometheus@sha256:0123456789abcdef",
+		"grafana":    "quay.io/openshift/origin-grafana:v7.0.3",
+		"thanos":     "quay.io/openshift/origin-thanos:latest",
+	})
+	f := NewFactory("openshift-monitoring", "openshift-user-workload-monitoring", c)
+
+	versions, err := f.OperandVersions()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	declared, err := assetVersions()
+	if err != nil {
+		t.Fatal(err)
+	}
+	for operand, v := range declared {
+		if v == "" {
+			t.Errorf("expected the assets to declare a version of %s", operand)
+		}
+	}
+
+	for operand, expected := range map[string]string{
+		// Digests and the latest tag fall back to the asset versions.
+		"prometheus": declared["prometheus"],
+		"thanos":     declared["thanos"],
+		"grafana":    "v7.0.3",
+	} {
+		if versions[operand] != expected {
+			t.Errorf("expected version %q for %s, got %q", expected, operand, versions[operand])
+		}
+	}
+
+	// Neither the image nor the assets carry a version.
+	if v, ok := versions["telemeter-client"]; ok {
+		t.Errorf("expected no version for telemeter-client, got %q", v)
+	}
+}
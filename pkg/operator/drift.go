@@ -0,0 +1,296 @@
+// Copyright 2020 The Cluster Monitoring Operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package operator
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"time"
+
+	monv1 "github.com/coreos/prometheus-operator/pkg/apis/monitoring/v1"
+	routev1 "github.com/openshift/api/route/v1"
+	"github.com/pkg/errors"
+	appsv1 "k8s.io/api/apps/v1"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/klog"
+
+	"github.com/openshift/cluster-monitoring-operator/pkg/client"
+	"github.com/openshift/cluster-monitoring-operator/pkg/tasks"
+)
+
+// taskKeyPrefix prefixes the queue keys of targeted reconciliations of a
+// single task.
+const taskKeyPrefix = "task:"
+
+// Kinds of the objects watched for out-of-band changes.
+const (
+	deploymentKind   = "Deployment"
+	daemonSetKind    = "DaemonSet"
+	serviceKind      = "Service"
+	routeKind        = "Route"
+	prometheusKind   = "Prometheus"
+	alertmanagerKind = "Alertmanager"
+	thanosRulerKind  = "ThanosRuler"
+)
+
+// ownedObject identifies an object reconciled by the operator.
+type ownedObject struct {
+	kind      string
+	namespace string
+	name      string
+}
+
+// ownedObjects returns the objects watched for out-of-band changes along
+// with the name of the task reconciling them.
+func ownedObjects(namespace, namespaceUserWorkload string) map[ownedObject]string {
+	return map[ownedObject]string{
+		{deploymentKind, namespace, "grafana"}:                         grafanaTask,
+		{deploymentKind, namespace, "kube-state-metrics"}:              kubeStateMetricsTask,
+		{deploymentKind, namespace, "openshift-state-metrics"}:         openShiftStateMetricsTask,
+		{deploymentKind, namespace, "prometheus-adapter"}:              prometheusAdapterTask,
+		{deploymentKind, namespace, "prometheus-operator"}:             prometheusOperatorTask,
+		{deploymentKind, namespace, "telemeter-client"}:                telemeterClientTask,
+		{deploymentKind, namespace, "thanos-querier"}:                  thanosQuerierTask,
+		{deploymentKind, namespaceUserWorkload, "prometheus-operator"}: prometheusOperatorUserWorkloadTask,
+
+		{daemonSetKind, namespace, "node-exporter"}: nodeExporterTask,
+
+		{serviceKind, namespace, "alertmanager-main"}:                    alertmanagerTask,
+		{serviceKind, namespace, "cluster-monitoring-operator"}:          clusterMonitoringOperatorTask,
+		{serviceKind, namespace, "grafana"}:                              grafanaTask,
+		{serviceKind, namespace, "kube-state-metrics"}:                   kubeStateMetricsTask,
+		{serviceKind, namespace, "node-exporter"}:                        nodeExporterTask,
+		{serviceKind, namespace, "openshift-state-metrics"}:              openShiftStateMetricsTask,
+		{serviceKind, namespace, "prometheus-adapter"}:                   prometheusAdapterTask,
+		{serviceKind, namespace, "prometheus-k8s"}:                       prometheusTask,
+		{serviceKind, namespace, "prometheus-operator"}:                  prometheusOperatorTask,
+		{serviceKind, namespace, "telemeter-client"}:                     telemeterClientTask,
+		{serviceKind, namespace, "thanos-querier"}:                       thanosQuerierTask,
+		{serviceKind, namespaceUserWorkload, "prometheus-operator"}:      prometheusOperatorUserWorkloadTask,
+		{serviceKind, namespaceUserWorkload, "prometheus-user-workload"}: prometheusUserWorkloadTask,
+		{serviceKind, namespaceUserWorkload, "thanos-ruler"}:             thanosRulerUserWorkloadTask,
+		{routeKind, namespace, "alertmanager-main"}:                      alertmanagerTask,
+		{routeKind, namespace, "grafana"}:                                grafanaTask,
+		{routeKind, namespace, "prometheus-k8s"}:                         prometheusTask,
+		{routeKind, namespace, "thanos-querier"}:                         thanosQuerierTask,
+		{routeKind, namespaceUserWorkload, "thanos-ruler"}:               thanosRulerUserWorkloadTask,
+		{prometheusKind, namespace, "k8s"}:                               prometheusTask,
+		{prometheusKind, namespaceUserWorkload, "user-workload"}:         prometheusUserWorkloadTask,
+		{alertmanagerKind, namespace, "main"}:                            alertmanagerTask,
+		{thanosRulerKind, namespaceUserWorkload, "user-workload"}:        thanosRulerUserWorkloadTask,
+	}
+}
+
+// driftWatch describes how objects of a kind are watched for out-of-band
+// changes.
+type driftWatch struct {
+	kind      string
+	listWatch func(ns string) *cache.ListWatch
+	obj       runtime.Object
+	// changed reports whether an update changed the desired state of the
+	// object, as opposed to e.g. its status.
+	changed func(oldObj, newObj interface{}) bool
+}
+
+func (o *Operator) driftWatches() []driftWatch {
+	return []driftWatch{
+		{deploymentKind, o.client.DeploymentListWatchForNamespace, &appsv1.Deployment{}, generationChanged},
+		{daemonSetKind, o.client.DaemonSetListWatchForNamespace, &appsv1.DaemonSet{}, generationChanged},
+		{prometheusKind, o.client.PrometheusListWatchForNamespace, &monv1.Prometheus{}, generationChanged},
+		{alertmanagerKind, o.client.AlertmanagerListWatchForNamespace, &monv1.Alertmanager{}, generationChanged},
+		{thanosRulerKind, o.client.ThanosRulerListWatchForNamespace, &monv1.ThanosRuler{}, generationChanged},
+		// Services and Routes don't maintain a generation.
+		{serviceKind, o.client.ServiceListWatchForNamespace, &v1.Service{}, func(oldObj, newObj interface{}) bool {
+			return !reflect.DeepEqual(oldObj.(*v1.Service).Spec, newObj.(*v1.Service).Spec)
+		}},
+		{routeKind, o.client.RouteListWatchForNamespace, &routev1.Route{}, func(oldObj, newObj interface{}) bool {
+			return !reflect.DeepEqual(oldObj.(*routev1.Route).Spec, newObj.(*routev1.Route).Spec)
+		}},
+	}
+}
+
+// addDriftInformers sets up the informers watching the owned objects for
+// out-of-band changes.
+func (o *Operator) addDriftInformers() {
+	for _, w := range o.driftWatches() {
+		namespaces := map[string]struct{}{}
+		for obj := range o.owners {
+			if obj.kind == w.kind {
+				namespaces[obj.namespace] = struct{}{}
+			}
+		}
+
+		kind, changed := w.kind, w.changed
+		for ns := range namespaces {
+			// The periodic resync of the configuration covers missed
+			// events, no need to resync these informers.
+			informer := cache.NewSharedIndexInformer(w.listWatch(ns), w.obj, 0, cache.Indexers{})
+			informer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+				UpdateFunc: func(oldObj, newObj interface{}) {
+					o.handleDriftUpdate(kind, changed, oldObj, newObj)
+				},
+				DeleteFunc: func(obj interface{}) {
+					o.handleDriftDelete(kind, obj)
+				},
+			})
+			o.informers = append(o.informers, informer)
+		}
+	}
+}
+
+// handleDriftUpdate handles the update of an owned object of the given kind.
+// Updates which didn't change the desired state or which the operator wrote
+// itself are ignored.
+func (o *Operator) handleDriftUpdate(kind string, changed func(oldObj, newObj interface{}) bool, oldObj, newObj interface{}) {
+	if !changed(oldObj, newObj) {
+		return
+	}
+	if m, err := meta.Accessor(newObj); err == nil && lastManager(m) == client.FieldManager {
+		// The operator wrote the change itself.
+		return
+	}
+	o.handleDrift(kind, newObj, true)
+}
+
+// handleDriftDelete handles the deletion of an owned object of the given
+// kind. Deletions don't tell who deleted the object. The operator deletes
+// objects only while reconciling, so deletions happening meanwhile aren't
+// counted as drift but the owning task still runs again.
+func (o *Operator) handleDriftDelete(kind string, obj interface{}) {
+	o.handleDrift(kind, obj, !o.syncInFlight())
+}
+
+// handleDrift queues the reconciliation of the task owning obj, if any. If
+// drifted is true, the correction is counted once the task succeeded.
+func (o *Operator) handleDrift(kind string, obj interface{}, drifted bool) {
+	if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+		obj = tombstone.Obj
+	}
+	m, err := meta.Accessor(obj)
+	if err != nil {
+		klog.Errorf("Getting metadata of %s object failed: %v", kind, err)
+		return
+	}
+
+	task, ok := o.owners[ownedObject{kind, m.GetNamespace(), m.GetName()}]
+	if !ok {
+		return
+	}
+
+	klog.Infof("Detected out-of-band change of %s %s/%s, triggering %q", kind, m.GetNamespace(), m.GetName(), task)
+	if drifted {
+		o.driftMtx.Lock()
+		if o.drifted[task] == nil {
+			o.drifted[task] = map[string]struct{}{}
+		}
+		o.drifted[task][kind] = struct{}{}
+		o.driftMtx.Unlock()
+	}
+	o.queue.Add(taskKeyPrefix + task)
+}
+
+// syncTask reconciles a single task. Its dependencies are left alone, they
+// were reconciled by the last full sync.
+func (o *Operator) syncTask(ctx context.Context, name string) error {
+	config, err := o.Config(ctx, o.namespace+"/"+o.configMapName)
+	if err != nil {
+		return err
+	}
+
+	var spec *tasks.TaskSpec
+	for _, ts := range o.taskSpecs(config) {
+		if ts.Name == name {
+			spec = &tasks.TaskSpec{Name: ts.Name, Task: ts.Task, Timeout: ts.Timeout}
+			break
+		}
+	}
+	if spec == nil {
+		return errors.Errorf("unknown task %q", name)
+	}
+	return o.runDriftedTask(ctx, spec)
+}
+
+// runDriftedTask runs the task reconciling drifted objects and counts their
+// correction once it succeeded.
+func (o *Operator) runDriftedTask(ctx context.Context, spec *tasks.TaskSpec) error {
+	name := spec.Name
+	if _, ok := spec.Task.(unmanagedTask); ok {
+		// Out-of-band changes are expected for unmanaged components.
+		o.driftMtx.Lock()
+		delete(o.drifted, name)
+		o.driftMtx.Unlock()
+		return nil
+	}
+
+	results, err := tasks.NewTaskRunner(o.client, []*tasks.TaskSpec{spec}).RunAll(ctx)
+	if ctx.Err() != nil {
+		return errors.Wrap(ctx.Err(), "running task cancelled")
+	}
+	o.recordTaskMetrics(results)
+	if err != nil {
+		return err
+	}
+
+	o.driftMtx.Lock()
+	for kind := range o.drifted[name] {
+		o.driftCorrections.WithLabelValues(kind).Inc()
+	}
+	delete(o.drifted, name)
+	o.driftMtx.Unlock()
+
+	return nil
+}
+
+// taskFromKey returns the task name of a targeted reconciliation key.
+func taskFromKey(key string) (string, bool) {
+	if !strings.HasPrefix(key, taskKeyPrefix) {
+		return "", false
+	}
+	return strings.TrimPrefix(key, taskKeyPrefix), true
+}
+
+func generationChanged(oldObj, newObj interface{}) bool {
+	oldMeta, err1 := meta.Accessor(oldObj)
+	newMeta, err2 := meta.Accessor(newObj)
+	if err1 != nil || err2 != nil {
+		return true
+	}
+	return oldMeta.GetGeneration() != newMeta.GetGeneration()
+}
+
+// lastManager returns the manager of the most recent write to obj as
+// recorded in its managed fields. The operator wins ties since timestamps
+// only have a precision of seconds.
+func lastManager(obj metav1.Object) string {
+	var (
+		manager string
+		last    time.Time
+	)
+	for _, f := range obj.GetManagedFields() {
+		if f.Time == nil {
+			continue
+		}
+		t := f.Time.Time
+		if manager == "" || t.After(last) || (t.Equal(last) && f.Manager == client.FieldManager) {
+			manager, last = f.Manager, t
+		}
+	}
+	return manager
+}
@@ -0,0 +1,310 @@
+// Copyright 2020 The Cluster Monitoring Operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package operator
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	routev1 "github.com/openshift/api/route/v1"
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	appsv1 "k8s.io/api/apps/v1"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/client-go/rest"
+
+	"github.com/openshift/cluster-monitoring-operator/pkg/client"
+	"github.com/openshift/cluster-monitoring-operator/pkg/tasks"
+)
+
+// newDriftOperator returns an operator which isn't connected to any API
+// server along with the registry of its metrics.
+func newDriftOperator(t *testing.T) (*Operator, *prometheus.Registry) {
+	t.Helper()
+
+	o, err := New(&rest.Config{Host: "https://api.drift.invalid"}, "", "openshift-monitoring", "openshift-user-workload-monitoring", "", "cluster-monitoring-config", "user-workload-monitoring-config", false, nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	reg := prometheus.NewRegistry()
+	o.RegisterMetrics(reg)
+	return o, reg
+}
+
+// driftCorrections returns the value of the drift corrections counter of
+// kind.
+func driftCorrections(t *testing.T, reg *prometheus.Registry, kind string) float64 {
+	t.Helper()
+
+	mfs, err := reg.Gather()
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, mf := range mfs {
+		if mf.GetName() != "cluster_monitoring_operator_drift_corrections_total" {
+			continue
+		}
+		for _, m := range mf.GetMetric() {
+			for _, l := range m.GetLabel() {
+				if l.GetName() == "kind" && l.GetValue() == kind {
+					return m.GetCounter().GetValue()
+				}
+			}
+		}
+	}
+	return 0
+}
+
+// changedFunc returns the function of the drift watch of kind telling
+// whether an update changed the desired state.
+func changedFunc(t *testing.T, o *Operator, kind string) func(oldObj, newObj interface{}) bool {
+	t.Helper()
+
+	for _, w := range o.driftWatches() {
+		if w.kind == kind {
+			return w.changed
+		}
+	}
+	t.Fatalf("no drift watch for kind %s", kind)
+	return nil
+}
+
+func managedFieldsEntry(manager string, t time.Time) metav1.ManagedFieldsEntry {
+	return metav1.ManagedFieldsEntry{Manager: manager, Operation: metav1.ManagedFieldsOperationUpdate, Time: &metav1.Time{Time: t}}
+}
+
+func TestLastManager(t *testing.T) {
+	now := time.Now().Truncate(time.Second)
+
+	for _, tc := range []struct {
+		name     string
+		fields   []metav1.ManagedFieldsEntry
+		expected string
+	}{
+		{
+			name: "most recent write",
+			fields: []metav1.ManagedFieldsEntry{
+				managedFieldsEntry(client.FieldManager, now.Add(-time.Second)),
+				managedFieldsEntry("kubectl", now),
+			},
+			expected: "kubectl",
+		},
+		{
+			name: "same second tie after the operator",
+			fields: []metav1.ManagedFieldsEntry{
+				managedFieldsEntry(client.FieldManager, now),
+				managedFieldsEntry("kubectl", now),
+			},
+			expected: client.FieldManager,
+		},
+		{
+			name: "same second tie before the operator",
+			fields: []metav1.ManagedFieldsEntry{
+				managedFieldsEntry("kubectl", now),
+				managedFieldsEntry(client.FieldManager, now),
+			},
+			expected: client.FieldManager,
+		},
+		{
+			name: "entries without time",
+			fields: []metav1.ManagedFieldsEntry{
+				managedFieldsEntry("kubectl", now),
+				{Manager: client.FieldManager, Operation: metav1.ManagedFieldsOperationUpdate},
+			},
+			expected: "kubectl",
+		},
+		{
+			name: "no managed fields",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			obj := &metav1.ObjectMeta{ManagedFields: tc.fields}
+			if got := lastManager(obj); got != tc.expected {
+				t.Fatalf("expected %q, got %q", tc.expected, got)
+			}
+		})
+	}
+}
+
+func TestHandleDriftUpdate(t *testing.T) {
+	o, _ := newDriftOperator(t)
+	kubectlWrite := []metav1.ManagedFieldsEntry{managedFieldsEntry("kubectl", time.Now())}
+	operatorWrite := []metav1.ManagedFieldsEntry{managedFieldsEntry(client.FieldManager, time.Now())}
+
+	deployment := func(generation int64, replicas int32, fields []metav1.ManagedFieldsEntry) *appsv1.Deployment {
+		return &appsv1.Deployment{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "openshift-monitoring", Name: "grafana", Generation: generation, ManagedFields: fields},
+			Status:     appsv1.DeploymentStatus{ReadyReplicas: replicas},
+		}
+	}
+	service := func(port int32, ingress string) *v1.Service {
+		s := &v1.Service{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "openshift-monitoring", Name: "prometheus-k8s", ManagedFields: kubectlWrite},
+			Spec:       v1.ServiceSpec{Ports: []v1.ServicePort{{Port: port, TargetPort: intstr.FromInt(int(port))}}},
+		}
+		if ingress != "" {
+			s.Status.LoadBalancer.Ingress = []v1.LoadBalancerIngress{{IP: ingress}}
+		}
+		return s
+	}
+	route := func(host, admitted string) *routev1.Route {
+		r := &routev1.Route{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "openshift-monitoring", Name: "thanos-querier", ManagedFields: kubectlWrite},
+			Spec:       routev1.RouteSpec{Host: host},
+		}
+		if admitted != "" {
+			r.Status.Ingress = []routev1.RouteIngress{{Host: host, RouterName: admitted}}
+		}
+		return r
+	}
+
+	for _, tc := range []struct {
+		name           string
+		kind           string
+		oldObj, newObj interface{}
+		task           string
+	}{
+		{
+			name:   "deployment status",
+			kind:   deploymentKind,
+			oldObj: deployment(1, 0, kubectlWrite),
+			newObj: deployment(1, 1, kubectlWrite),
+		},
+		{
+			name:   "deployment spec",
+			kind:   deploymentKind,
+			oldObj: deployment(1, 1, kubectlWrite),
+			newObj: deployment(2, 1, kubectlWrite),
+			task:   grafanaTask,
+		},
+		{
+			name:   "deployment spec written by the operator",
+			kind:   deploymentKind,
+			oldObj: deployment(1, 1, operatorWrite),
+			newObj: deployment(2, 1, operatorWrite),
+		},
+		{
+			name:   "service status",
+			kind:   serviceKind,
+			oldObj: service(9091, ""),
+			newObj: service(9091, "10.0.0.1"),
+		},
+		{
+			name:   "service spec",
+			kind:   serviceKind,
+			oldObj: service(9091, ""),
+			newObj: service(9092, ""),
+			task:   prometheusTask,
+		},
+		{
+			name:   "route status",
+			kind:   routeKind,
+			oldObj: route("thanos.example.com", ""),
+			newObj: route("thanos.example.com", "default"),
+		},
+		{
+			name:   "route spec",
+			kind:   routeKind,
+			oldObj: route("thanos.example.com", ""),
+			newObj: route("querier.example.com", ""),
+			task:   thanosQuerierTask,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			o.handleDriftUpdate(tc.kind, changedFunc(t, o, tc.kind), tc.oldObj, tc.newObj)
+
+			if tc.task == "" {
+				if n := o.queue.Len(); n != 0 {
+					t.Fatalf("expected the update to be ignored, got %d queued keys", n)
+				}
+				return
+			}
+
+			if n := o.queue.Len(); n != 1 {
+				t.Fatalf("expected 1 queued key, got %d", n)
+			}
+			key, _ := o.queue.Get()
+			o.queue.Done(key)
+			if expected := taskKeyPrefix + tc.task; key != expected {
+				t.Fatalf("expected key %q, got %q", expected, key)
+			}
+			if _, ok := o.drifted[tc.task][tc.kind]; !ok {
+				t.Fatalf("expected the change to be recorded as drift, got %v", o.drifted)
+			}
+			delete(o.drifted, tc.task)
+		})
+	}
+}
+
+func TestHandleDriftDelete(t *testing.T) {
+	o, _ := newDriftOperator(t)
+	dep := &appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Namespace: "openshift-monitoring", Name: "grafana"}}
+
+	// The operator may delete objects while syncing.
+	o.syncCancel = func() {}
+	o.handleDriftDelete(deploymentKind, dep)
+	if n := o.queue.Len(); n != 1 {
+		t.Fatalf("expected 1 queued key, got %d", n)
+	}
+	if len(o.drifted) != 0 {
+		t.Fatalf("expected a deletion during a sync not to be recorded as drift, got %v", o.drifted)
+	}
+
+	o.syncCancel = nil
+	o.handleDriftDelete(deploymentKind, dep)
+	if _, ok := o.drifted[grafanaTask][deploymentKind]; !ok {
+		t.Fatalf("expected a deletion outside of a sync to be recorded as drift, got %v", o.drifted)
+	}
+}
+
+func TestRunDriftedTask(t *testing.T) {
+	o, reg := newDriftOperator(t)
+	o.handleDrift(deploymentKind, &appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Namespace: "openshift-monitoring", Name: "grafana"}}, true)
+
+	failing := tasks.NewTaskSpec(grafanaTask, taskFunc(func(context.Context) error {
+		return errors.New("waiting for Grafana Deployment rollout failed")
+	}))
+	if err := o.runDriftedTask(context.Background(), failing); err == nil {
+		t.Fatal("expected the task to fail")
+	}
+	if v := driftCorrections(t, reg, deploymentKind); v != 0 {
+		t.Fatalf("expected no drift correction after a failed task, got %v", v)
+	}
+	if _, ok := o.drifted[grafanaTask]; !ok {
+		t.Fatal("expected the drift to be kept after a failed task")
+	}
+
+	succeeding := tasks.NewTaskSpec(grafanaTask, taskFunc(func(context.Context) error { return nil }))
+	if err := o.runDriftedTask(context.Background(), succeeding); err != nil {
+		t.Fatal(err)
+	}
+	if v := driftCorrections(t, reg, deploymentKind); v != 1 {
+		t.Fatalf("expected 1 drift correction after the task succeeded, got %v", v)
+	}
+	if len(o.drifted) != 0 {
+		t.Fatalf("expected the drift to be cleared, got %v", o.drifted)
+	}
+
+	// Running the task again without new drift doesn't count anything.
+	if err := o.runDriftedTask(context.Background(), succeeding); err != nil {
+		t.Fatal(err)
+	}
+	if v := driftCorrections(t, reg, deploymentKind); v != 1 {
+		t.Fatalf("expected 1 drift correction, got %v", v)
+	}
+}
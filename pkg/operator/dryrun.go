@@ -0,0 +1,86 @@
+// Copyright 2020 The Cluster Monitoring Operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package operator
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"k8s.io/client-go/tools/cache"
+
+	"github.com/openshift/cluster-monitoring-operator/pkg/client"
+	"github.com/openshift/cluster-monitoring-operator/pkg/manifests"
+	"github.com/openshift/cluster-monitoring-operator/pkg/tasks"
+)
+
+// DryRun runs every task once against the cluster with server-side dry-run
+// and returns the changes they would make, including the pruning of stale
+// objects. If config is nil, the configuration is read from the cluster like
+// Run does, otherwise only the cluster state is, along with the user workload
+// configuration if it is nil. The cluster operator status is left alone and
+// the operator must not be run afterwards.
+func (o *Operator) DryRun(ctx context.Context, config *manifests.Config) (*client.Plan, tasks.TaskResults, error) {
+	plan := o.client.EnableDryRun()
+
+	if config == nil {
+		go o.cmapInf.Run(ctx.Done())
+		if !cache.WaitForCacheSync(ctx.Done(), o.cmapInf.HasSynced) {
+			return nil, nil, errors.New("failed to sync informers")
+		}
+
+		var err error
+		config, err = o.Config(ctx, o.namespace+"/"+o.configMapName)
+		if err != nil {
+			return nil, nil, err
+		}
+	} else {
+		if config.UserWorkloadConfiguration == nil {
+			uwc := manifests.NewDefaultUserWorkloadMonitoringConfig()
+			if config.IsUserWorkloadEnabled() {
+				var err error
+				uwc, err = o.loadUserWorkloadConfig(ctx)
+				if err != nil {
+					return nil, nil, err
+				}
+			}
+			config.UserWorkloadConfiguration = uwc
+		}
+		o.loadClusterState(ctx, config)
+	}
+
+	o.client.ResetInventory()
+	results, err := tasks.NewTaskRunner(o.client, o.taskSpecs(config)).RunAll(ctx)
+	if err != nil {
+		return plan, results, err
+	}
+
+	err = o.client.PruneStaleObjects(ctx, unmanagedComponents(config))
+	return plan, results, errors.Wrap(err, "pruning stale objects failed")
+}
+
+// RenderTasks runs every task once with dry-run against an API server which
+// persists dry-run requests, such as the in-memory one of the render
+// package. The configuration is completed with the cluster state like
+// DryRun does. The tasks run one after the other so that the requests of
+// each task can be told apart, done is called with the name of every task
+// once it succeeded.
+func (o *Operator) RenderTasks(ctx context.Context, config *manifests.Config, done func(task string)) error {
+	o.client.EnableDryRun()
+	o.loadClusterState(ctx, config)
+
+	return tasks.NewTaskRunner(o.client, o.taskSpecs(config)).RunSerially(ctx, func(ts *tasks.TaskSpec) {
+		done(ts.Name)
+	})
+}
@@ -0,0 +1,87 @@
+// Copyright 2020 The Cluster Monitoring Operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package operator
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// SetStallTimeout sets how long the worker may make no progress before the
+// operator is reported unhealthy. The worker makes no progress if it
+// processes a single item for longer than d or doesn't pick up pending
+// items for longer than d. Zero disables the check.
+func (o *Operator) SetStallTimeout(d time.Duration) {
+	o.stallTimeout = d
+}
+
+// Healthy returns an error if the worker is wedged.
+func (o *Operator) Healthy() error {
+	if o.stallTimeout == 0 {
+		return nil
+	}
+
+	o.progressMtx.Lock()
+	busySince, idleSince := o.busySince, o.idleSince
+	o.progressMtx.Unlock()
+
+	if !busySince.IsZero() {
+		if d := time.Since(busySince); d > o.stallTimeout {
+			return errors.Errorf("worker busy with the same item for %s", d.Round(time.Second))
+		}
+		return nil
+	}
+
+	// idleSince is zero until the worker started, e.g. while waiting for
+	// the leader lease.
+	if n := o.queue.Len(); n > 0 && !idleSince.IsZero() {
+		if d := time.Since(idleSince); d > o.stallTimeout {
+			return errors.Errorf("worker didn't pick up %d pending items for %s", n, d.Round(time.Second))
+		}
+	}
+	return nil
+}
+
+// Ready returns an error if the informer caches haven't synced yet or the
+// API server isn't reachable.
+func (o *Operator) Ready(ctx context.Context) error {
+	if !o.cmapInf.HasSynced() {
+		return errors.New("informer caches not synced")
+	}
+	for _, inf := range o.informers {
+		if !inf.HasSynced() {
+			return errors.New("informer caches not synced")
+		}
+	}
+
+	err := o.client.KubernetesInterface().Discovery().RESTClient().Get().AbsPath("/readyz").Do(ctx).Error()
+	return errors.Wrap(err, "reaching the API server failed")
+}
+
+// setBusy records that the worker picked up an item if busy is true or
+// finished it otherwise.
+func (o *Operator) setBusy(busy bool) {
+	o.progressMtx.Lock()
+	defer o.progressMtx.Unlock()
+
+	now := time.Now()
+	if busy {
+		o.busySince, o.idleSince = now, time.Time{}
+		return
+	}
+	o.busySince, o.idleSince = time.Time{}, now
+}
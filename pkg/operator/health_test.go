@@ -0,0 +1,189 @@
+// Copyright 2020 The Cluster Monitoring Operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package operator
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/util/workqueue"
+)
+
+func TestHealthy(t *testing.T) {
+	const stallTimeout = time.Minute
+	longAgo := time.Now().Add(-2 * stallTimeout)
+	recently := time.Now().Add(-stallTimeout / 2)
+
+	for _, tc := range []struct {
+		name         string
+		stallTimeout time.Duration
+		busySince    time.Time
+		idleSince    time.Time
+		pending      int
+		healthy      bool
+	}{
+		{
+			name:         "busy",
+			stallTimeout: stallTimeout,
+			busySince:    recently,
+			pending:      1,
+			healthy:      true,
+		},
+		{
+			name:         "busy for too long",
+			stallTimeout: stallTimeout,
+			busySince:    longAgo,
+		},
+		{
+			name:         "idle",
+			stallTimeout: stallTimeout,
+			idleSince:    longAgo,
+			healthy:      true,
+		},
+		{
+			name:         "idle with pending items",
+			stallTimeout: stallTimeout,
+			idleSince:    recently,
+			pending:      2,
+			healthy:      true,
+		},
+		{
+			name:         "idle with pending items for too long",
+			stallTimeout: stallTimeout,
+			idleSince:    longAgo,
+			pending:      2,
+		},
+		{
+			name:      "zero timeout",
+			busySince: longAgo,
+			healthy:   true,
+		},
+		{
+			// The worker doesn't start until the leader lease is
+			// acquired.
+			name:         "not leader",
+			stallTimeout: stallTimeout,
+			pending:      1,
+			healthy:      true,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			o := &Operator{
+				stallTimeout: tc.stallTimeout,
+				busySince:    tc.busySince,
+				idleSince:    tc.idleSince,
+				queue:        workqueue.NewRateLimitingQueue(workqueue.DefaultControllerRateLimiter()),
+			}
+			defer o.queue.ShutDown()
+			for i := 0; i < tc.pending; i++ {
+				o.queue.Add(i)
+			}
+
+			err := o.Healthy()
+			if tc.healthy && err != nil {
+				t.Fatalf("expected the operator to be healthy, got %v", err)
+			}
+			if !tc.healthy && err == nil {
+				t.Fatal("expected the operator to be unhealthy")
+			}
+		})
+	}
+}
+
+// syncedInformer returns an informer of an empty list of ConfigMaps which
+// has synced once run.
+func syncedInformer() cache.SharedIndexInformer {
+	return cache.NewSharedIndexInformer(&cache.ListWatch{
+		ListFunc: func(metav1.ListOptions) (runtime.Object, error) {
+			return &v1.ConfigMapList{}, nil
+		},
+		WatchFunc: func(metav1.ListOptions) (watch.Interface, error) {
+			return watch.NewFake(), nil
+		},
+	}, &v1.ConfigMap{}, 0, cache.Indexers{})
+}
+
+func TestReady(t *testing.T) {
+	for _, tc := range []struct {
+		name       string
+		synced     bool
+		readyzCode int
+		ready      bool
+	}{
+		{
+			name:       "caches not synced",
+			readyzCode: http.StatusOK,
+		},
+		{
+			name:       "API server not ready",
+			synced:     true,
+			readyzCode: http.StatusInternalServerError,
+		},
+		{
+			// Standby replicas waiting for the leader lease have synced
+			// caches too.
+			name:       "ready",
+			synced:     true,
+			readyzCode: http.StatusOK,
+			ready:      true,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			apiServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+				if req.URL.Path != "/readyz" {
+					t.Errorf("unexpected request %s %s", req.Method, req.URL.Path)
+				}
+				w.WriteHeader(tc.readyzCode)
+			}))
+			defer apiServer.Close()
+
+			o, err := New(&rest.Config{Host: apiServer.URL}, "", "openshift-monitoring", "openshift-user-workload-monitoring", "", "cluster-monitoring-config", "user-workload-monitoring-config", false, nil, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			o.cmapInf = syncedInformer()
+			o.informers = []cache.SharedIndexInformer{syncedInformer(), syncedInformer()}
+
+			if tc.synced {
+				stopc := make(chan struct{})
+				defer close(stopc)
+				go o.cmapInf.Run(stopc)
+				for _, inf := range o.informers {
+					go inf.Run(stopc)
+				}
+				if !cache.WaitForCacheSync(stopc, o.cmapInf.HasSynced, o.informers[0].HasSynced, o.informers[1].HasSynced) {
+					t.Fatal("failed to sync informers")
+				}
+			}
+
+			err = o.Ready(context.Background())
+			if tc.ready && err != nil {
+				t.Fatalf("expected the operator to be ready, got %v", err)
+			}
+			if !tc.ready && err == nil {
+				t.Fatal("expected the operator not to be ready")
+			}
+		})
+	}
+}
@@ -0,0 +1,94 @@
+// Copyright 2020 The Cluster Monitoring Operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package operator
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/leaderelection"
+	"k8s.io/client-go/tools/leaderelection/resourcelock"
+	"k8s.io/klog"
+)
+
+// LeaderElectionConfig configures the lease-based leader election between
+// replicas of the operator.
+type LeaderElectionConfig struct {
+	// Namespace and Name of the Lease object used as lock.
+	Namespace string
+	Name      string
+	// Identity of this replica, must be unique among all replicas.
+	Identity string
+
+	LeaseDuration time.Duration
+	RenewDeadline time.Duration
+	RetryPeriod   time.Duration
+}
+
+// EnableLeaderElection makes the operator acquire the given lease before
+// reconciling. Replicas waiting for the lease keep their informer caches in
+// sync so that they can take over right away.
+func (o *Operator) EnableLeaderElection(c LeaderElectionConfig) {
+	o.leaderElection = &c
+}
+
+// newLeaderElector returns a leader elector for the configured lease. The
+// leading channel is closed once the lease is acquired and the stopped
+// channel once the elector stops, e.g. because the lease was lost.
+func (o *Operator) newLeaderElector(leading, stopped chan<- struct{}) (*leaderelection.LeaderElector, error) {
+	c := o.leaderElection
+	lock := &resourcelock.LeaseLock{
+		LeaseMeta: metav1.ObjectMeta{
+			Namespace: c.Namespace,
+			Name:      c.Name,
+		},
+		Client: o.client.KubernetesInterface().CoordinationV1(),
+		LockConfig: resourcelock.ResourceLockConfig{
+			Identity: c.Identity,
+		},
+	}
+
+	le, err := leaderelection.NewLeaderElector(leaderelection.LeaderElectionConfig{
+		Lock:          lock,
+		LeaseDuration: c.LeaseDuration,
+		RenewDeadline: c.RenewDeadline,
+		RetryPeriod:   c.RetryPeriod,
+		// Release the lease on shutdown so that a standby replica can take
+		// over without waiting for the lease to expire.
+		ReleaseOnCancel: true,
+		Callbacks: leaderelection.LeaderCallbacks{
+			OnStartedLeading: func(context.Context) {
+				klog.Infof("Acquired leader lease %s/%s as %s", c.Namespace, c.Name, c.Identity)
+				close(leading)
+			},
+			OnStoppedLeading: func() {
+				klog.Infof("Stopped leader election for lease %s/%s as %s", c.Namespace, c.Name, c.Identity)
+				close(stopped)
+			},
+			OnNewLeader: func(identity string) {
+				if identity != c.Identity {
+					klog.Infof("Lease %s/%s is held by %s", c.Namespace, c.Name, identity)
+				}
+			},
+		},
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "creating leader elector failed")
+	}
+
+	return le, nil
+}
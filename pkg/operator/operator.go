@@ -18,6 +18,7 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"sync"
 	"time"
 
 	configv1 "github.com/openshift/api/config/v1"
@@ -25,6 +26,7 @@ import (
 	"github.com/prometheus/client_golang/prometheus"
 	v1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
 	"k8s.io/client-go/rest"
@@ -50,6 +52,84 @@ const (
 	grpcTLS                       = "openshift-monitoring/grpc-tls"
 )
 
+// Names of the reconciliation tasks.
+const (
+	prometheusOperatorTask             = "Updating Prometheus Operator"
+	prometheusOperatorUserWorkloadTask = "Updating user workload Prometheus Operator"
+	clusterMonitoringOperatorTask      = "Updating Cluster Monitoring Operator"
+	grafanaTask                        = "Updating Grafana"
+	grafanaDatasourcesSecretTask       = "Updating Grafana datasources Secret"
+	grafanaDatasourcesTask             = "Updating Grafana datasources"
+	prometheusTask                     = "Updating Prometheus-k8s"
+	prometheusUserWorkloadTask         = "Updating Prometheus-user-workload"
+	alertmanagerTask                   = "Updating Alertmanager"
+	thanosQuerierTask                  = "Updating Thanos Querier"
+	nodeExporterTask                   = "Updating node-exporter"
+	kubeStateMetricsTask               = "Updating kube-state-metrics"
+	openShiftStateMetricsTask          = "Updating openshift-state-metrics"
+	prometheusAdapterTask              = "Updating prometheus-adapter"
+	telemeterClientTask                = "Updating Telemeter client"
+	configSharingTask                  = "Updating configuration sharing"
+	thanosRulerUserWorkloadTask        = "Updating User Workload Thanos Ruler"
+)
+
+// componentTasks maps the components whose management state can be set to
+// the name of their task.
+var componentTasks = map[string]string{
+	"prometheusOperator":    prometheusOperatorTask,
+	"prometheusK8s":         prometheusTask,
+	"alertmanagerMain":      alertmanagerTask,
+	"kubeStateMetrics":      kubeStateMetricsTask,
+	"openshiftStateMetrics": openShiftStateMetricsTask,
+	"grafana":               grafanaTask,
+	"telemeterClient":       telemeterClientTask,
+	"k8sPrometheusAdapter":  prometheusAdapterTask,
+	"thanosQuerier":         thanosQuerierTask,
+}
+
+// componentLabels maps the components whose management state can be set to
+// the value of the component label of their objects.
+var componentLabels = map[string]string{
+	"prometheusOperator":    "prometheus-operator",
+	"prometheusK8s":         "prometheus-k8s",
+	"alertmanagerMain":      "alertmanager",
+	"kubeStateMetrics":      "kube-state-metrics",
+	"openshiftStateMetrics": "openshift-state-metrics",
+	"grafana":               "grafana",
+	"telemeterClient":       "telemeter-client",
+	"k8sPrometheusAdapter":  "prometheus-adapter",
+	"thanosQuerier":         "thanos-querier",
+}
+
+// coreTasks are the tasks of the components without which the monitoring
+// stack isn't available. Failures of the other tasks only degrade it.
+var coreTasks = map[string]struct{}{
+	prometheusOperatorTask: {},
+	prometheusTask:         {},
+	alertmanagerTask:       {},
+	thanosQuerierTask:      {},
+}
+
+// operandTasks maps the operands whose version is reported to the name of
+// the task rolling them out.
+var operandTasks = map[string]string{
+	"prometheus":              prometheusTask,
+	"alertmanager":            alertmanagerTask,
+	"thanos":                  thanosQuerierTask,
+	"grafana":                 grafanaTask,
+	"node-exporter":           nodeExporterTask,
+	"kube-state-metrics":      kubeStateMetricsTask,
+	"openshift-state-metrics": openShiftStateMetricsTask,
+	"prometheus-operator":     prometheusOperatorTask,
+	"prometheus-adapter":      prometheusAdapterTask,
+	"telemeter-client":        telemeterClientTask,
+}
+
+// unmanagedTask replaces the task of a component which isn't managed.
+type unmanagedTask struct{}
+
+func (unmanagedTask) Run(context.Context) error { return nil }
+
 type Operator struct {
 	namespace, namespaceUserWorkload string
 
@@ -68,6 +148,41 @@ type Operator struct {
 
 	reconcileAttempts prometheus.Counter
 	reconcileErrors   prometheus.Counter
+
+	taskDuration    *prometheus.HistogramVec
+	taskErrors      *prometheus.CounterVec
+	taskLastSuccess *prometheus.GaugeVec
+
+	resultsMtx sync.RWMutex
+	results    tasks.TaskResults
+
+	leaderElection *LeaderElectionConfig
+	taskTimeout    time.Duration
+
+	// syncCancel cancels the in-flight sync, if any.
+	syncMtx    sync.Mutex
+	syncCancel context.CancelFunc
+
+	// owners maps the objects watched for out-of-band changes to their
+	// task, drifted records the kinds of the changed objects by task until
+	// the task reverted them.
+	owners           map[ownedObject]string
+	driftMtx         sync.Mutex
+	drifted          map[string]map[string]struct{}
+	driftCorrections *prometheus.CounterVec
+
+	// busySince and idleSince track the progress of the worker for the
+	// liveness check, at most one of them is set.
+	stallTimeout time.Duration
+	progressMtx  sync.Mutex
+	busySince    time.Time
+	idleSince    time.Time
+
+	// grpcCASecret is the key of the secret holding the user-provided CA
+	// of the gRPC certificates as of the last sync, changes to it trigger a
+	// sync.
+	grpcCASecretMtx sync.Mutex
+	grpcCASecret    string
 }
 
 func New(config *rest.Config, version, namespace, namespaceUserWorkload, namespaceSelector, configMapName, userWorkloadConfigMapName string, remoteWrite bool, images map[string]string, telemetryMatches []string) (*Operator, error) {
@@ -87,6 +202,8 @@ func New(config *rest.Config, version, namespace, namespaceUserWorkload, namespa
 		client:                    c,
 		queue:                     workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), "cluster-monitoring"),
 		informers:                 make([]cache.SharedIndexInformer, 0),
+		owners:                    ownedObjects(namespace, namespaceUserWorkload),
+		drifted:                   make(map[string]map[string]struct{}),
 	}
 
 	informer := cache.NewSharedIndexInformer(
@@ -94,7 +211,7 @@ func New(config *rest.Config, version, namespace, namespaceUserWorkload, namespa
 	)
 	informer.AddEventHandler(cache.ResourceEventHandlerFuncs{
 		AddFunc:    o.handleEvent,
-		UpdateFunc: func(_, newObj interface{}) { o.handleEvent(newObj) },
+		UpdateFunc: o.handleUpdate,
 		DeleteFunc: o.handleEvent,
 	})
 	o.informers = append(o.informers, informer)
@@ -104,7 +221,7 @@ func New(config *rest.Config, version, namespace, namespaceUserWorkload, namespa
 	)
 	o.cmapInf.AddEventHandler(cache.ResourceEventHandlerFuncs{
 		AddFunc:    o.handleEvent,
-		UpdateFunc: func(_, newObj interface{}) { o.handleEvent(newObj) },
+		UpdateFunc: o.handleUpdate,
 		DeleteFunc: o.handleEvent,
 	})
 
@@ -113,7 +230,7 @@ func New(config *rest.Config, version, namespace, namespaceUserWorkload, namespa
 	)
 	informer.AddEventHandler(cache.ResourceEventHandlerFuncs{
 		AddFunc:    o.handleEvent,
-		UpdateFunc: func(_, newObj interface{}) { o.handleEvent(newObj) },
+		UpdateFunc: o.handleUpdate,
 		DeleteFunc: o.handleEvent,
 	})
 	o.informers = append(o.informers, informer)
@@ -123,7 +240,7 @@ func New(config *rest.Config, version, namespace, namespaceUserWorkload, namespa
 		&v1.ConfigMap{}, resyncPeriod, cache.Indexers{},
 	)
 	informer.AddEventHandler(cache.ResourceEventHandlerFuncs{
-		UpdateFunc: func(_, newObj interface{}) { o.handleEvent(newObj) },
+		UpdateFunc: o.handleUpdate,
 	})
 	o.informers = append(o.informers, informer)
 
@@ -132,7 +249,7 @@ func New(config *rest.Config, version, namespace, namespaceUserWorkload, namespa
 		&v1.ConfigMap{}, resyncPeriod, cache.Indexers{},
 	)
 	informer.AddEventHandler(cache.ResourceEventHandlerFuncs{
-		UpdateFunc: func(_, newObj interface{}) { o.handleEvent(newObj) },
+		UpdateFunc: o.handleUpdate,
 	})
 	o.informers = append(o.informers, informer)
 
@@ -141,13 +258,31 @@ func New(config *rest.Config, version, namespace, namespaceUserWorkload, namespa
 		&v1.ConfigMap{}, resyncPeriod, cache.Indexers{},
 	)
 	informer.AddEventHandler(cache.ResourceEventHandlerFuncs{
-		UpdateFunc: func(_, newObj interface{}) { o.handleEvent(newObj) },
+		UpdateFunc: o.handleUpdate,
 	})
 	o.informers = append(o.informers, informer)
 
+	o.addDriftInformers()
+
 	return o, nil
 }
 
+// SetTaskTimeout bounds the duration of each task run. Zero means no
+// timeout.
+func (o *Operator) SetTaskTimeout(d time.Duration) {
+	o.taskTimeout = d
+}
+
+// SetFailureDampening sets how many consecutive failed syncs, lasting at
+// least gracePeriod, are tolerated before the ClusterOperator reports
+// Degraded.
+func (o *Operator) SetFailureDampening(threshold int, gracePeriod time.Duration) {
+	o.client.SetFailureDampening(client.FailureDampening{
+		Threshold:   threshold,
+		GracePeriod: gracePeriod,
+	})
+}
+
 // RegisterMetrics registers the operator's metrics with the given registerer.
 func (o *Operator) RegisterMetrics(r prometheus.Registerer) {
 	o.reconcileAttempts = prometheus.NewCounter(prometheus.CounterOpts{
@@ -160,15 +295,60 @@ func (o *Operator) RegisterMetrics(r prometheus.Registerer) {
 		Help: "Number of errors that occurred while reconciling the operator configuration",
 	})
 
+	o.taskDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "cluster_monitoring_operator_task_duration_seconds",
+		Help:    "Duration of the reconciliation tasks by task",
+		Buckets: []float64{1, 5, 10, 30, 60, 120, 300, 600},
+	}, []string{"task"})
+
+	o.taskErrors = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "cluster_monitoring_operator_task_errors_total",
+		Help: "Number of errors that occurred while running a reconciliation task by task",
+	}, []string{"task"})
+
+	o.taskLastSuccess = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "cluster_monitoring_operator_task_last_success_timestamp_seconds",
+		Help: "Timestamp of the last successful run of a reconciliation task by task",
+	}, []string{"task"})
+
+	o.driftCorrections = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "cluster_monitoring_operator_drift_corrections_total",
+		Help: "Number of out-of-band changes of managed objects reverted by the operator by kind",
+	}, []string{"kind"})
+
 	r.MustRegister(
 		o.reconcileAttempts,
 		o.reconcileErrors,
+		o.taskDuration,
+		o.taskErrors,
+		o.taskLastSuccess,
+		o.driftCorrections,
 	)
+	o.client.RegisterMetrics(r)
+}
+
+// recordTaskMetrics updates the task metrics with the results of a run.
+// Skipped tasks didn't run and are not recorded.
+func (o *Operator) recordTaskMetrics(results tasks.TaskResults) {
+	for _, res := range results {
+		if res.Skipped {
+			continue
+		}
+
+		o.taskDuration.WithLabelValues(res.Name).Observe(res.Duration.Seconds())
+		if res.Err != nil {
+			o.taskErrors.WithLabelValues(res.Name).Inc()
+			continue
+		}
+		o.taskLastSuccess.WithLabelValues(res.Name).SetToCurrentTime()
+	}
 }
 
-// Run the controller.
-func (o *Operator) Run(stopc <-chan struct{}) error {
+// Run the controller until ctx is done. An in-flight sync is cancelled on
+// return.
+func (o *Operator) Run(ctx context.Context) error {
 	defer o.queue.ShutDown()
+	stopc := ctx.Done()
 
 	errChan := make(chan error)
 	go func() {
@@ -205,7 +385,56 @@ func (o *Operator) Run(stopc <-chan struct{}) error {
 	}
 	klog.V(4).Info("Initial cache sync done.")
 
-	go o.worker()
+	var (
+		leading = make(chan struct{})
+		// lost stays nil and blocks forever without leader election.
+		lost chan struct{}
+	)
+	if o.leaderElection == nil {
+		close(leading)
+	} else {
+		lost = make(chan struct{})
+		le, err := o.newLeaderElector(leading, lost)
+		if err != nil {
+			return err
+		}
+
+		leCtx, cancel := context.WithCancel(context.Background())
+		leDone := make(chan struct{})
+		go func() {
+			defer close(leDone)
+			le.Run(leCtx)
+		}()
+		// Release the lease only after the worker stopped.
+		defer func() {
+			cancel()
+			<-leDone
+		}()
+
+		klog.Infof("Waiting to acquire leader lease %s/%s", o.leaderElection.Namespace, o.leaderElection.Name)
+	}
+
+	select {
+	case <-leading:
+	case <-lost:
+		return errors.New("leader election stopped before acquiring the lease")
+	case <-stopc:
+		return nil
+	}
+
+	workerCtx, cancelWorker := context.WithCancel(ctx)
+	workerDone := make(chan struct{})
+	o.setBusy(false)
+	go func() {
+		defer close(workerDone)
+		o.worker(workerCtx)
+	}()
+	// Cancel an in-flight sync and wait for it to return.
+	defer func() {
+		cancelWorker()
+		o.queue.ShutDown()
+		<-workerDone
+	}()
 
 	ticker := time.NewTicker(5 * time.Minute)
 	defer ticker.Stop()
@@ -221,6 +450,8 @@ func (o *Operator) Run(stopc <-chan struct{}) error {
 		select {
 		case <-stopc:
 			return nil
+		case <-lost:
+			return errors.New("leader lease lost")
 		case <-ticker.C:
 			_, exists, _ := o.cmapInf.GetStore().GetByKey(key)
 			if !exists {
@@ -241,6 +472,23 @@ func (o *Operator) keyFunc(obj interface{}) (string, bool) {
 }
 
 func (o *Operator) handleEvent(obj interface{}) {
+	o.handleChange(obj, true)
+}
+
+// handleUpdate handles updates of watched objects. Periodic resyncs deliver
+// updates without changes, these trigger a sync but never cancel an in-flight
+// one.
+func (o *Operator) handleUpdate(oldObj, newObj interface{}) {
+	changed := true
+	oldMeta, err1 := meta.Accessor(oldObj)
+	newMeta, err2 := meta.Accessor(newObj)
+	if err1 == nil && err2 == nil {
+		changed = oldMeta.GetResourceVersion() != newMeta.GetResourceVersion()
+	}
+	o.handleChange(newObj, changed)
+}
+
+func (o *Operator) handleChange(obj interface{}, changed bool) {
 	key, ok := o.keyFunc(obj)
 	if !ok {
 		return
@@ -260,6 +508,7 @@ func (o *Operator) handleEvent(obj interface{}) {
 	case telemeterCABundleConfigMap:
 	case alertmanagerCABundleConfigMap:
 	case grpcTLS:
+	case o.grpcCASecretKey():
 	case uwmConfigMap:
 	default:
 		klog.V(5).Infof("ConfigMap or Secret (%s) not triggering an update.", key)
@@ -268,30 +517,64 @@ func (o *Operator) handleEvent(obj interface{}) {
 
 	klog.Infof("Triggering an update due to ConfigMap or Secret: %s", key)
 
+	// A configuration change makes the in-flight sync stale, cancel it so
+	// that the new configuration is picked up right away. Other objects are
+	// partly written by the operator itself and don't cancel the sync.
+	if changed && (key == cmoConfigMap || key == uwmConfigMap) {
+		o.cancelSync()
+	}
+
 	// Always enqueue the cluster monitoring operator configmap.
 	// That way we reuse the same synchronization logic for all triggering object changes.
 	o.enqueue(cmoConfigMap)
 }
 
-func (o *Operator) worker() {
-	for o.processNextWorkItem() {
+func (o *Operator) worker(ctx context.Context) {
+	for o.processNextWorkItem(ctx) {
 	}
 }
 
-func (o *Operator) processNextWorkItem() bool {
+func (o *Operator) processNextWorkItem(ctx context.Context) bool {
 	key, quit := o.queue.Get()
 	if quit {
 		return false
 	}
 	defer o.queue.Done(key)
+	o.setBusy(true)
+	defer o.setBusy(false)
+
+	syncCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	o.syncMtx.Lock()
+	o.syncCancel = cancel
+	o.syncMtx.Unlock()
+	defer func() {
+		o.syncMtx.Lock()
+		o.syncCancel = nil
+		o.syncMtx.Unlock()
+	}()
 
 	o.reconcileAttempts.Inc()
-	err := o.sync(key.(string))
+	var err error
+	if task, ok := taskFromKey(key.(string)); ok {
+		err = o.syncTask(syncCtx, task)
+	} else {
+		err = o.sync(syncCtx, key.(string))
+	}
 	if err == nil {
 		o.queue.Forget(key)
 		return true
 	}
 
+	if syncCtx.Err() != nil {
+		// The sync was cancelled either on shutdown or because a newer
+		// change was queued, in which case the key is processed again once
+		// marked as done.
+		klog.Infof("Syncing %q cancelled", key)
+		o.queue.Forget(key)
+		return true
+	}
+
 	o.reconcileErrors.Inc()
 	klog.Errorf("Syncing %q failed", key)
 	utilruntime.HandleError(errors.Wrapf(err, "sync %q failed", key))
@@ -300,6 +583,46 @@ func (o *Operator) processNextWorkItem() bool {
 	return true
 }
 
+// cancelSync cancels the in-flight sync, if any.
+func (o *Operator) cancelSync() {
+	o.syncMtx.Lock()
+	defer o.syncMtx.Unlock()
+
+	if o.syncCancel != nil {
+		klog.Info("Cancelling in-flight sync in favor of a newer configuration.")
+		o.syncCancel()
+	}
+}
+
+// setGRPCCASecret records the name of the secret holding the user-provided
+// CA of the gRPC certificates, empty if there is none.
+func (o *Operator) setGRPCCASecret(name string) {
+	o.grpcCASecretMtx.Lock()
+	defer o.grpcCASecretMtx.Unlock()
+
+	o.grpcCASecret = ""
+	if name != "" {
+		o.grpcCASecret = o.namespace + "/" + name
+	}
+}
+
+// grpcCASecretKey returns the key of the secret holding the user-provided
+// CA of the gRPC certificates, empty if there is none.
+func (o *Operator) grpcCASecretKey() string {
+	o.grpcCASecretMtx.Lock()
+	defer o.grpcCASecretMtx.Unlock()
+
+	return o.grpcCASecret
+}
+
+// syncInFlight returns whether a sync is running.
+func (o *Operator) syncInFlight() bool {
+	o.syncMtx.Lock()
+	defer o.syncMtx.Unlock()
+
+	return o.syncCancel != nil
+}
+
 func (o *Operator) enqueue(obj interface{}) {
 	if obj == nil {
 		return
@@ -316,62 +639,65 @@ func (o *Operator) enqueue(obj interface{}) {
 	o.queue.Add(key)
 }
 
-func (o *Operator) sync(key string) error {
-	config, err := o.Config(key)
+func (o *Operator) sync(ctx context.Context, key string) error {
+	config, err := o.Config(ctx, key)
 	if err != nil {
+		if ctx.Err() != nil {
+			return err
+		}
 		klog.Infof("Updating ClusterOperator status to failed. Err: %v", err)
-		reportErr := o.client.StatusReporter().SetFailed(err, "InvalidConfiguration")
+		reportErr := o.client.StatusReporter().SetFailed(ctx, err, client.InvalidConfigurationReason)
 		if reportErr != nil {
 			klog.Errorf("error occurred while setting status to failed: %v", reportErr)
 		}
 		return err
 	}
-	config.SetImages(o.images)
-	config.SetTelemetryMatches(o.telemetryMatches)
-	config.SetRemoteWrite(o.remoteWrite)
-
-	factory := manifests.NewFactory(o.namespace, o.namespaceUserWorkload, config)
-
-	tl := tasks.NewTaskRunner(
-		o.client,
-		[]*tasks.TaskSpec{
-			tasks.NewTaskSpec("Updating Prometheus Operator", tasks.NewPrometheusOperatorTask(o.client, factory)),
-			tasks.NewTaskSpec("Updating user workload Prometheus Operator", tasks.NewPrometheusOperatorUserWorkloadTask(o.client, factory, config)),
-			tasks.NewTaskSpec("Updating Cluster Monitoring Operator", tasks.NewClusterMonitoringOperatorTask(o.client, factory)),
-			tasks.NewTaskSpec("Updating Grafana", tasks.NewGrafanaTask(o.client, factory)),
-			tasks.NewTaskSpec("Updating Prometheus-k8s", tasks.NewPrometheusTask(o.client, factory, config)),
-			tasks.NewTaskSpec("Updating Prometheus-user-workload", tasks.NewPrometheusUserWorkloadTask(o.client, factory, config)),
-			tasks.NewTaskSpec("Updating Alertmanager", tasks.NewAlertmanagerTask(o.client, factory)),
-			tasks.NewTaskSpec("Updating node-exporter", tasks.NewNodeExporterTask(o.client, factory)),
-			tasks.NewTaskSpec("Updating kube-state-metrics", tasks.NewKubeStateMetricsTask(o.client, factory)),
-			tasks.NewTaskSpec("Updating openshift-state-metrics", tasks.NewOpenShiftStateMetricsTask(o.client, factory)),
-			tasks.NewTaskSpec("Updating prometheus-adapter", tasks.NewPrometheusAdapterTaks(o.namespace, o.client, factory)),
-			tasks.NewTaskSpec("Updating Telemeter client", tasks.NewTelemeterClientTask(o.client, factory, config)),
-			tasks.NewTaskSpec("Updating configuration sharing", tasks.NewConfigSharingTask(o.client, factory)),
-			tasks.NewTaskSpec("Updating Thanos Querier", tasks.NewThanosQuerierTask(o.client, factory, config)),
-			tasks.NewTaskSpec("Updating User Workload Thanos Ruler", tasks.NewThanosRulerUserWorkloadTask(o.client, factory, config)),
-		},
-	)
+	o.setGRPCCASecret(config.ClusterMonitoringConfiguration.GRPCTLSConfig.CASecret)
+	tl := tasks.NewTaskRunner(o.client, o.taskSpecs(config))
+
+	reporter := o.client.StatusReporter()
+	notManaged := map[string]string{}
+	for component, state := range config.ClusterMonitoringConfiguration.ManagementStates() {
+		if state != manifests.Managed {
+			notManaged[component] = string(state)
+		}
+	}
+	reporter.SetManagementStates(notManaged)
+	reporter.SetUpgradeBlockers(config.UpgradeBlockers(manifests.UpgradeRules))
+	reporter.SetUpgradeAdvisories(config.UpgradeBlockers(manifests.UpgradeAdvisoryRules))
 
 	klog.Info("Updating ClusterOperator status to in progress.")
-	err = o.client.StatusReporter().SetInProgress()
+	err = reporter.SetInProgress(ctx)
 	if err != nil {
 		klog.Errorf("error occurred while setting status to in progress: %v", err)
 	}
 
-	taskName, err := tl.RunAll()
+	o.client.ResetInventory()
+	results, err := tl.RunAll(ctx)
+	if ctx.Err() != nil {
+		// Don't report the results of a cancelled run, they are stale.
+		return errors.Wrap(ctx.Err(), "running tasks cancelled")
+	}
+	o.emitTaskEvents(ctx, o.TaskResults(), results)
+	o.setTaskResults(results)
+	o.recordTaskMetrics(results)
+	reporter.SetOperandVersions(o.operandVersions(config, results))
 	if err != nil {
 		klog.Infof("Updating ClusterOperator status to failed. Err: %v", err)
-		failedTaskReason := strings.Join(strings.Fields(taskName+"Failed"), "")
-		reportErr := o.client.StatusReporter().SetFailed(err, failedTaskReason)
+		reportErr := reporter.SetComponentsFailed(ctx, err, failedTasksReason(results), failedComponents(results))
 		if reportErr != nil {
 			klog.Errorf("error occurred while setting status to failed: %v", reportErr)
 		}
 		return err
 	}
 
+	err = o.client.PruneStaleObjects(ctx, unmanagedComponents(config))
+	if err != nil {
+		klog.Errorf("error occurred while pruning stale objects: %v", err)
+	}
+
 	klog.Info("Updating ClusterOperator status to done.")
-	err = o.client.StatusReporter().SetDone()
+	err = reporter.SetDone(ctx)
 	if err != nil {
 		klog.Errorf("error occurred while setting status to done: %v", err)
 	}
@@ -379,10 +705,220 @@ func (o *Operator) sync(key string) error {
 	return nil
 }
 
-func (o *Operator) loadUserWorkloadConfig() (*manifests.UserWorkloadConfiguration, error) {
+// taskSpecs returns the reconciliation tasks for the given configuration.
+func (o *Operator) taskSpecs(config *manifests.Config) []*tasks.TaskSpec {
+	config.SetImages(o.images)
+	config.SetTelemetryMatches(o.telemetryMatches)
+	config.SetRemoteWrite(o.remoteWrite)
+
+	factory := manifests.NewFactory(o.namespace, o.namespaceUserWorkload, config)
+
+	var (
+		prometheusOperator             = tasks.NewTaskSpec(prometheusOperatorTask, tasks.NewPrometheusOperatorTask(o.client, factory))
+		prometheusOperatorUserWorkload = tasks.NewTaskSpec(prometheusOperatorUserWorkloadTask, tasks.NewPrometheusOperatorUserWorkloadTask(o.client, factory, config))
+		clusterMonitoringOperator      = tasks.NewTaskSpec(clusterMonitoringOperatorTask, tasks.NewClusterMonitoringOperatorTask(o.client, factory, config))
+		grafanaDatasourcesSecret       = tasks.NewTaskSpec(grafanaDatasourcesSecretTask, tasks.NewGrafanaDatasourcesSecretTask(o.client, factory))
+		grafana                        = tasks.NewTaskSpec(grafanaTask, tasks.NewGrafanaTask(o.client, factory), grafanaDatasourcesSecret)
+		// Prometheus and Thanos Querier consume the Grafana datasources
+		// secret for their htpasswd secrets and the GRPC secret managed by
+		// the Cluster Monitoring Operator task. They don't depend on the
+		// Grafana task, a failure of optional Grafana mustn't hold back
+		// the core components.
+		prometheus             = tasks.NewTaskSpec(prometheusTask, tasks.NewPrometheusTask(o.client, factory, config), prometheusOperator, clusterMonitoringOperator, grafanaDatasourcesSecret)
+		prometheusUserWorkload = tasks.NewTaskSpec(prometheusUserWorkloadTask, tasks.NewPrometheusUserWorkloadTask(o.client, factory, config), prometheusOperatorUserWorkload, clusterMonitoringOperator)
+		alertmanager           = tasks.NewTaskSpec(alertmanagerTask, tasks.NewAlertmanagerTask(o.client, factory), prometheusOperator)
+		thanosQuerier          = tasks.NewTaskSpec(thanosQuerierTask, tasks.NewThanosQuerierTask(o.client, factory, config), clusterMonitoringOperator, grafanaDatasourcesSecret)
+		// The staged Grafana credential is promoted once the htpasswd
+		// secrets of Prometheus and Thanos Querier accept it. The previous
+		// one is dropped once Grafana has been rolled out with it.
+		grafanaDatasources = tasks.NewTaskSpec(grafanaDatasourcesTask, tasks.NewGrafanaDatasourcesTask(o.client, factory), grafana, prometheus, thanosQuerier)
+	)
+
+	specs := []*tasks.TaskSpec{
+		prometheusOperator,
+		prometheusOperatorUserWorkload,
+		clusterMonitoringOperator,
+		grafanaDatasourcesSecret,
+		grafana,
+		prometheus,
+		prometheusUserWorkload,
+		alertmanager,
+		tasks.NewTaskSpec(nodeExporterTask, tasks.NewNodeExporterTask(o.client, factory)),
+		tasks.NewTaskSpec(kubeStateMetricsTask, tasks.NewKubeStateMetricsTask(o.client, factory)),
+		tasks.NewTaskSpec(openShiftStateMetricsTask, tasks.NewOpenShiftStateMetricsTask(o.client, factory, config)),
+		tasks.NewTaskSpec(prometheusAdapterTask, tasks.NewPrometheusAdapterTaks(o.namespace, o.client, factory)),
+		tasks.NewTaskSpec(telemeterClientTask, tasks.NewTelemeterClientTask(o.client, factory, config)),
+		// The configuration sharing task publishes the URLs of the routes
+		// created by the tasks it depends on.
+		tasks.NewTaskSpec(configSharingTask, tasks.NewConfigSharingTask(o.client, factory), prometheus, alertmanager, grafana, thanosQuerier),
+		thanosQuerier,
+		grafanaDatasources,
+		tasks.NewTaskSpec(thanosRulerUserWorkloadTask, tasks.NewThanosRulerUserWorkloadTask(o.client, factory, config), prometheusOperatorUserWorkload, clusterMonitoringOperator, thanosQuerier),
+	}
+	states := config.ClusterMonitoringConfiguration.ManagementStates()
+	unmanaged := map[string]struct{}{}
+	for component, task := range componentTasks {
+		if states[component] == manifests.Unmanaged {
+			unmanaged[task] = struct{}{}
+		}
+	}
+	// The datasources secret belongs to Grafana, which wouldn't switch to
+	// a promoted credential either.
+	if _, ok := unmanaged[grafanaTask]; ok {
+		unmanaged[grafanaDatasourcesSecretTask] = struct{}{}
+		unmanaged[grafanaDatasourcesTask] = struct{}{}
+	}
+
+	for _, ts := range specs {
+		ts.Timeout = o.taskTimeout
+		if _, ok := unmanaged[ts.Name]; ok {
+			klog.V(4).Infof("Skipping %q, the component is unmanaged", ts.Name)
+			ts.Task = unmanagedTask{}
+		}
+	}
+
+	return specs
+}
+
+// operandVersions returns the versions of the operands whose task succeeded
+// and thus finished their rollout. Operands which aren't deployed are mapped
+// to an empty version, the ones of unmanaged or failed tasks are left out.
+func (o *Operator) operandVersions(config *manifests.Config, results tasks.TaskResults) map[string]string {
+	factory := manifests.NewFactory(o.namespace, o.namespaceUserWorkload, config)
+	available, err := factory.OperandVersions()
+	if err != nil {
+		klog.Errorf("error occurred while determining the operand versions: %v", err)
+		return nil
+	}
+
+	succeeded := map[string]struct{}{}
+	for _, r := range results {
+		if r.Err == nil {
+			succeeded[r.Name] = struct{}{}
+		}
+	}
+
+	states := config.ClusterMonitoringConfiguration.ManagementStates()
+	unmanaged := map[string]struct{}{}
+	for component, state := range states {
+		if state == manifests.Unmanaged {
+			unmanaged[componentTasks[component]] = struct{}{}
+		}
+	}
+
+	notDeployed := map[string]bool{
+		"openshift-state-metrics": states["openshiftStateMetrics"] == manifests.Removed,
+		"telemeter-client":        !config.ClusterMonitoringConfiguration.TelemeterClientConfig.IsEnabled() || config.RemoteWrite,
+	}
+
+	versions := map[string]string{}
+	for operand, task := range operandTasks {
+		if _, ok := unmanaged[task]; ok {
+			continue
+		}
+		if _, ok := succeeded[task]; !ok {
+			continue
+		}
+		if notDeployed[operand] {
+			versions[operand] = ""
+			continue
+		}
+		if v, ok := available[operand]; ok {
+			versions[operand] = v
+		}
+	}
+
+	return versions
+}
+
+// unmanagedComponents returns the component labels of the objects of the
+// unmanaged components, which must not be pruned.
+func unmanagedComponents(config *manifests.Config) map[string]struct{} {
+	unmanaged := map[string]struct{}{}
+	for component, state := range config.ClusterMonitoringConfiguration.ManagementStates() {
+		if state == manifests.Unmanaged {
+			unmanaged[componentLabels[component]] = struct{}{}
+		}
+	}
+	return unmanaged
+}
+
+// TaskResults returns the result of every task of the last sync. It is empty
+// until the first sync ran its tasks.
+func (o *Operator) TaskResults() tasks.TaskResults {
+	o.resultsMtx.RLock()
+	defer o.resultsMtx.RUnlock()
+	return o.results
+}
+
+func (o *Operator) setTaskResults(results tasks.TaskResults) {
+	o.resultsMtx.Lock()
+	defer o.resultsMtx.Unlock()
+	o.results = results
+}
+
+// emitTaskEvents emits events on the ClusterOperator for the tasks which
+// failed and for the ones which succeeded after failing in the previous
+// sync.
+func (o *Operator) emitTaskEvents(ctx context.Context, previous, results tasks.TaskResults) {
+	failedBefore := map[string]struct{}{}
+	for _, r := range previous.Failed() {
+		failedBefore[r.Name] = struct{}{}
+	}
+
+	for _, r := range results {
+		_, failed := failedBefore[r.Name]
+		switch {
+		case r.Err != nil && !r.Skipped:
+			o.client.ClusterOperatorEventf(ctx, v1.EventTypeWarning, "TaskFailed", "%s failed: %v", r.Name, r.Err)
+		case r.Err == nil && failed:
+			o.client.ClusterOperatorEventf(ctx, v1.EventTypeNormal, "TaskRecovered", "%s succeeded after failing", r.Name)
+		}
+	}
+}
+
+// failedComponents returns the components of the failed tasks by
+// criticality.
+func failedComponents(results tasks.TaskResults) client.FailedComponents {
+	var failed client.FailedComponents
+	for _, r := range results {
+		if r.Err == nil {
+			continue
+		}
+
+		_, core := coreTasks[r.Name]
+		component := strings.TrimPrefix(r.Name, "Updating ")
+		switch {
+		case core && r.Skipped:
+			failed.CoreSkipped = true
+		case core:
+			failed.Core = append(failed.Core, component)
+		case !r.Skipped:
+			failed.Optional = append(failed.Optional, component)
+		}
+	}
+	return failed
+}
+
+// failedTasksReason returns the reason reported when tasks failed. It names
+// the task if only a single one failed.
+func failedTasksReason(results tasks.TaskResults) string {
+	failed := results.Failed()
+	switch len(failed) {
+	case 0:
+		// The task graph itself is invalid.
+		return "InvalidTaskGraph"
+	case 1:
+		return strings.Join(strings.Fields(failed[0].Name+"Failed"), "")
+	default:
+		return "MultipleTasksFailed"
+	}
+}
+
+func (o *Operator) loadUserWorkloadConfig(ctx context.Context) (*manifests.UserWorkloadConfiguration, error) {
 	cmKey := fmt.Sprintf("%s/%s", o.namespaceUserWorkload, o.userWorkloadConfigMapName)
 
-	userCM, err := o.client.GetConfigmap(o.namespaceUserWorkload, o.userWorkloadConfigMapName)
+	userCM, err := o.client.GetConfigmap(ctx, o.namespaceUserWorkload, o.userWorkloadConfigMapName)
 	if err != nil {
 		if apierrors.IsNotFound(err) {
 			klog.Warningf("User Workload Monitoring %q ConfigMap not found. Using defaults.", cmKey)
@@ -402,12 +938,13 @@ func (o *Operator) loadUserWorkloadConfig() (*manifests.UserWorkloadConfiguratio
 	uwc, err := manifests.NewUserConfigFromString(configContent)
 	if err != nil {
 		klog.Warningf("Error creating User Workload Configuration from %q key in the %q ConfigMap. Error: %v", configKey, cmKey, err)
+		o.client.ConfigMapEventf(ctx, userCM, v1.EventTypeWarning, "InvalidConfiguration", "The %q key could not be parsed: %v", configKey, err)
 		return nil, errors.Wrapf(err, "the User Workload Configuration from %q key in the %q ConfigMap could not be parsed", configKey, cmKey)
 	}
 	return uwc, nil
 }
 
-func (o *Operator) loadConfig(key string) (*manifests.Config, error) {
+func (o *Operator) loadConfig(ctx context.Context, key string) (*manifests.Config, error) {
 	obj, found, err := o.cmapInf.GetStore().GetByKey(key)
 	if err != nil {
 		return nil, errors.Wrap(err, "an error occurred when retrieving the Cluster Monitoring ConfigMap")
@@ -422,19 +959,21 @@ func (o *Operator) loadConfig(key string) (*manifests.Config, error) {
 	configContent, found := cmap.Data["config.yaml"]
 
 	if !found {
+		o.client.ConfigMapEventf(ctx, cmap, v1.EventTypeWarning, "InvalidConfiguration", "The ConfigMap doesn't contain a 'config.yaml' key")
 		return nil, errors.New("the Cluster Monitoring ConfigMap doesn't contain a 'config.yaml' key")
 	}
 
 	cParsed, err := manifests.NewConfigFromString(configContent)
 	if err != nil {
+		o.client.ConfigMapEventf(ctx, cmap, v1.EventTypeWarning, "InvalidConfiguration", "The 'config.yaml' key could not be parsed: %v", err)
 		return nil, errors.Wrap(err, "the Cluster Monitoring ConfigMap could not be parsed")
 	}
 
 	return cParsed, nil
 }
 
-func (o *Operator) Config(key string) (*manifests.Config, error) {
-	c, err := o.loadConfig(key)
+func (o *Operator) Config(ctx context.Context, key string) (*manifests.Config, error) {
+	c, err := o.loadConfig(ctx, key)
 	if err != nil {
 		return nil, err
 	}
@@ -444,7 +983,7 @@ func (o *Operator) Config(key string) (*manifests.Config, error) {
 	// loadConfig() already initializes the structs with nil values for
 	// UserWorkloadConfiguration struct.
 	if *c.ClusterMonitoringConfiguration.UserWorkloadEnabled {
-		c.UserWorkloadConfiguration, err = o.loadUserWorkloadConfig()
+		c.UserWorkloadConfiguration, err = o.loadUserWorkloadConfig(ctx)
 		if err != nil {
 			return nil, err
 		}
@@ -452,10 +991,17 @@ func (o *Operator) Config(key string) (*manifests.Config, error) {
 		klog.Warningf("User Workload Monitoring enabled via the deprecated 'techPreviewUserWorkload' setting in %q configmap. Use the 'enableUserWorkload' setting instead.", key)
 	}
 
+	o.loadClusterState(ctx, c)
+	return c, nil
+}
+
+// loadClusterState completes the configuration with the state read from the
+// cluster. Missing state is logged and the configuration left as is.
+func (o *Operator) loadClusterState(ctx context.Context, c *manifests.Config) {
 	// Only fetch the token and cluster ID if they have not been specified in the config.
 	if c.ClusterMonitoringConfiguration.TelemeterClientConfig.ClusterID == "" || c.ClusterMonitoringConfiguration.TelemeterClientConfig.Token == "" {
 		err := c.LoadClusterID(func() (*configv1.ClusterVersion, error) {
-			return o.client.GetClusterVersion("version")
+			return o.client.GetClusterVersion(ctx, "version")
 		})
 
 		if err != nil {
@@ -463,7 +1009,7 @@ func (o *Operator) Config(key string) (*manifests.Config, error) {
 		}
 
 		err = c.LoadToken(func() (*v1.Secret, error) {
-			return o.client.KubernetesInterface().CoreV1().Secrets("openshift-config").Get(context.TODO(), "pull-secret", metav1.GetOptions{})
+			return o.client.KubernetesInterface().CoreV1().Secrets("openshift-config").Get(ctx, "pull-secret", metav1.GetOptions{})
 		})
 
 		if err != nil {
@@ -471,30 +1017,30 @@ func (o *Operator) Config(key string) (*manifests.Config, error) {
 		}
 	}
 
-	err = c.LoadProxy(func() (*configv1.Proxy, error) {
-		return o.client.GetProxy("cluster")
+	err := c.LoadProxy(func() (*configv1.Proxy, error) {
+		return o.client.GetProxy(ctx, "cluster")
 	})
 	if err != nil {
 		klog.Warningf("Could not load proxy configuration from API. This is expected and message can be ignored when proxy configuration doesn't exist. Proceeding without it: %v", err)
 	}
 
 	err = c.LoadPlatform(func() (*configv1.Infrastructure, error) {
-		return o.client.GetInfrastructure("cluster")
+		return o.client.GetInfrastructure(ctx, "cluster")
 	})
 	if err != nil {
 		klog.Warningf("Could not load platform from infrastructure resource: %v. This may result in alerts that are not appropriate for the platform.", err)
 	}
 
-	cm, err := o.client.GetConfigmap("openshift-config", "etcd-metric-serving-ca")
+	cm, err := o.client.GetConfigmap(ctx, "openshift-config", "etcd-metric-serving-ca")
 	if err != nil {
 		klog.Warningf("Error loading etcd CA certificates for Prometheus. Proceeding with etcd disabled. Error: %v", err)
-		return c, nil
+		return
 	}
 
-	s, err := o.client.GetSecret("openshift-config", "etcd-metric-client")
+	s, err := o.client.GetSecret(ctx, "openshift-config", "etcd-metric-client")
 	if err != nil {
 		klog.Warningf("Error loading etcd client secrets for Prometheus. Proceeding with etcd disabled. Error: %v", err)
-		return c, nil
+		return
 	}
 
 	caContent, caFound := cm.Data["ca-bundle.crt"]
@@ -508,6 +1054,4 @@ func (o *Operator) Config(key string) (*manifests.Config, error) {
 		trueBool := true
 		c.ClusterMonitoringConfiguration.EtcdConfig.Enabled = &trueBool
 	}
-
-	return c, nil
 }
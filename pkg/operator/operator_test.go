@@ -0,0 +1,122 @@
+// Copyright 2020 The Cluster Monitoring Operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package operator
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	configv1 "github.com/openshift/api/config/v1"
+	clientv1 "github.com/openshift/client-go/config/clientset/versioned/typed/config/v1"
+	"github.com/pkg/errors"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/openshift/cluster-monitoring-operator/pkg/client"
+	"github.com/openshift/cluster-monitoring-operator/pkg/manifests"
+	"github.com/openshift/cluster-monitoring-operator/pkg/tasks"
+)
+
+// clusterOperatorStub stores the ClusterOperator written by a status
+// reporter.
+type clusterOperatorStub struct {
+	clientv1.ClusterOperatorInterface
+	co *configv1.ClusterOperator
+}
+
+func (s *clusterOperatorStub) Get(_ context.Context, name string, _ metav1.GetOptions) (*configv1.ClusterOperator, error) {
+	if s.co == nil {
+		return nil, apierrors.NewNotFound(schema.GroupResource{Group: "config.openshift.io", Resource: "clusteroperators"}, name)
+	}
+	return s.co.DeepCopy(), nil
+}
+
+func (s *clusterOperatorStub) Create(_ context.Context, co *configv1.ClusterOperator, _ metav1.CreateOptions) (*configv1.ClusterOperator, error) {
+	s.co = co.DeepCopy()
+	return co, nil
+}
+
+func (s *clusterOperatorStub) UpdateStatus(_ context.Context, co *configv1.ClusterOperator, _ metav1.UpdateOptions) (*configv1.ClusterOperator, error) {
+	s.co = co.DeepCopy()
+	return co, nil
+}
+
+func (s *clusterOperatorStub) condition(t configv1.ClusterStatusConditionType) configv1.ClusterOperatorStatusCondition {
+	for _, c := range s.co.Status.Conditions {
+		if c.Type == t {
+			return c
+		}
+	}
+	return configv1.ClusterOperatorStatusCondition{}
+}
+
+type taskFunc func(context.Context) error
+
+func (f taskFunc) Run(ctx context.Context) error { return f(ctx) }
+
+func TestOptionalGrafanaFailure(t *testing.T) {
+	o := &Operator{
+		namespace:             "openshift-monitoring",
+		namespaceUserWorkload: "openshift-user-workload-monitoring",
+		taskTimeout:           time.Minute,
+	}
+
+	// Run the task graph of the default configuration with a failing
+	// Grafana rollout.
+	specs := o.taskSpecs(manifests.NewDefaultConfig())
+	for _, ts := range specs {
+		ts.Task = taskFunc(func(context.Context) error { return nil })
+		if ts.Name == grafanaTask {
+			ts.Task = taskFunc(func(context.Context) error {
+				return errors.New("waiting for Grafana Deployment rollout failed")
+			})
+		}
+	}
+
+	results, err := tasks.NewTaskRunner(nil, specs).RunAll(context.Background())
+	if err == nil {
+		t.Fatal("expected the run to fail")
+	}
+	for _, r := range results {
+		if _, ok := coreTasks[r.Name]; ok && r.Err != nil {
+			t.Fatalf("expected core task %q to succeed, got %v", r.Name, r.Err)
+		}
+	}
+
+	failed := failedComponents(results)
+	if len(failed.Core) > 0 || failed.CoreSkipped {
+		t.Fatalf("expected no failed core component, got %+v", failed)
+	}
+	if len(failed.Optional) != 1 || failed.Optional[0] != "Grafana" {
+		t.Fatalf("expected Grafana to be the only failed component, got %v", failed.Optional)
+	}
+
+	stub := &clusterOperatorStub{}
+	reporter := client.NewStatusReporter(stub, "monitoring", "openshift-monitoring", "")
+	if err := reporter.SetComponentsFailed(context.Background(), err, failedTasksReason(results), failed); err != nil {
+		t.Fatal(err)
+	}
+
+	if c := stub.condition(configv1.OperatorAvailable); c.Status != configv1.ConditionTrue {
+		t.Fatalf("expected Available to be true, got %q", c.Status)
+	}
+	c := stub.condition(configv1.OperatorDegraded)
+	if c.Status != configv1.ConditionTrue || !strings.Contains(c.Message, "Grafana") {
+		t.Fatalf("expected Degraded to be true and to name Grafana, got %q: %q", c.Status, c.Message)
+	}
+}
@@ -15,7 +15,9 @@
 package promqlgen
 
 import (
-	"sort"
+	"fmt"
+	"regexp"
+	"strconv"
 	"strings"
 
 	monv1 "github.com/coreos/prometheus-operator/pkg/apis/monitoring/v1"
@@ -24,86 +26,175 @@ import (
 	promql "github.com/prometheus/prometheus/promql/parser"
 )
 
-func LabelSelectorsToRelabelConfig(matches []string) (*monv1.RelabelConfig, error) {
+const (
+	// matchLabel holds the state of the selector being evaluated by the
+	// relabel configs of LabelSelectorsToRelabelConfigs.
+	matchLabel = "__tmp_selector_match"
+	// selectedLabel is set on the series selected by any of the selectors.
+	selectedLabel = "__tmp_selected"
+	// mismatch is the state of a selector with a failed negative matcher.
+	mismatch = "x"
+	// nameLabel holds the metric name of the series selected by the
+	// expression of GroupLabelSelectors.
+	nameLabel = "__tmp_name"
+)
+
+// LabelSelectorsToRelabelConfigs returns the relabel configs keeping the
+// series selected by any of the PromQL selectors in matches, with the
+// semantics of their =, !=, =~ and !~ matchers. Missing labels are handled
+// as empty labels, as in PromQL.
+//
+// Selectors with a single positive matcher are evaluated together, per
+// label. The other selectors are evaluated in turn with a counter in a
+// temporary label: each positive matcher increments it if the label of the
+// series matches and each negative matcher turns it into a mismatch if the
+// label matches the negated value, so that the counter ends at the number of
+// positive matchers if the selector matches.
+func LabelSelectorsToRelabelConfigs(matches []string) ([]monv1.RelabelConfig, error) {
 	labelSets, err := parseMetricSelectorFromArray(matches)
 	if err != nil {
 		return nil, errors.Wrap(err, "could not parse metric selectors from matches array")
 	}
 
-	labelPositions := map[string]int{}
-	sourceLabels := []string{}
-	i := 0
+	// Regexps of the selectors with a single positive matcher by label, in
+	// the order of the labels' first appearance.
+	var (
+		names   []string
+		regexps = map[string][]string{}
+		others  [][]*labels.Matcher
+	)
 	for _, ls := range labelSets {
-		for _, lm := range ls {
-			_, exists := labelPositions[lm.Name]
-			if exists {
-				continue
-			}
-
-			sourceLabels = append(sourceLabels, lm.Name)
-			labelPositions[lm.Name] = i
-			i++
+		if len(ls) != 1 || !positive(ls[0]) {
+			others = append(others, ls)
+			continue
+		}
+		lm := ls[0]
+		if _, ok := regexps[lm.Name]; !ok {
+			names = append(names, lm.Name)
 		}
+		regexps[lm.Name] = append(regexps[lm.Name], matcherRegexp(lm))
 	}
 
-	regexParts := []string{}
-	for _, ls := range labelSets {
-		labelValues := make([]string, len(sourceLabels))
+	// A single keep config is enough if all selectors match the same label.
+	if len(others) == 0 && len(names) == 1 {
+		return []monv1.RelabelConfig{{
+			Action:       "keep",
+			SourceLabels: []string{names[0]},
+			Regex:        strings.Join(regexps[names[0]], "|"),
+		}}, nil
+	}
+
+	var rcs []monv1.RelabelConfig
+	for _, name := range names {
+		rcs = append(rcs, monv1.RelabelConfig{
+			Action:       "replace",
+			SourceLabels: []string{name},
+			Regex:        strings.Join(regexps[name], "|"),
+			TargetLabel:  selectedLabel,
+			Replacement:  "1",
+		})
+	}
+
+	for _, ls := range others {
+		rcs = append(rcs, monv1.RelabelConfig{
+			Action:      "replace",
+			TargetLabel: matchLabel,
+			Replacement: "0",
+		})
+
+		n := 0
 		for _, lm := range ls {
-			labelValues[labelPositions[lm.Name]] = lm.Value
+			if positive(lm) {
+				// The state never contains the separator, the
+				// concatenation is unambiguous.
+				rcs = append(rcs, monv1.RelabelConfig{
+					Action:       "replace",
+					SourceLabels: []string{matchLabel, lm.Name},
+					Regex:        strconv.Itoa(n) + ";" + matcherRegexp(lm),
+					TargetLabel:  matchLabel,
+					Replacement:  strconv.Itoa(n + 1),
+				})
+				n++
+				continue
+			}
+			rcs = append(rcs, monv1.RelabelConfig{
+				Action:       "replace",
+				SourceLabels: []string{lm.Name},
+				Regex:        matcherRegexp(lm),
+				TargetLabel:  matchLabel,
+				Replacement:  mismatch,
+			})
 		}
-		regexParts = append(regexParts, strings.Join(labelValues, ";"))
+
+		rcs = append(rcs, monv1.RelabelConfig{
+			Action:       "replace",
+			SourceLabels: []string{matchLabel},
+			Regex:        strconv.Itoa(n),
+			TargetLabel:  selectedLabel,
+			Replacement:  "1",
+		})
 	}
-	regex := "(" + strings.Join(regexParts, "|") + ")"
 
-	return &monv1.RelabelConfig{
-		Action:       "keep",
-		SourceLabels: sourceLabels,
-		Regex:        regex,
-	}, nil
+	return append(rcs,
+		monv1.RelabelConfig{
+			Action:       "keep",
+			SourceLabels: []string{selectedLabel},
+			Regex:        "1",
+		},
+		monv1.RelabelConfig{
+			Action: "labeldrop",
+			Regex:  selectedLabel + "|" + matchLabel,
+		},
+	), nil
+}
+
+// positive tells whether lm selects the series whose label matches its
+// value, as opposed to the series whose label doesn't.
+func positive(lm *labels.Matcher) bool {
+	return lm.Type == labels.MatchEqual || lm.Type == labels.MatchRegexp
+}
+
+// matcherRegexp returns the relabeling regexp matching the label values
+// matched by lm, ignoring negation. Relabeling regexps are anchored like the
+// ones of PromQL matchers. An empty regexp would default to (.*) instead.
+func matcherRegexp(lm *labels.Matcher) string {
+	if (lm.Type == labels.MatchEqual || lm.Type == labels.MatchNotEqual) && lm.Value != "" {
+		return regexp.QuoteMeta(lm.Value)
+	}
+	return "(?:" + lm.Value + ")"
 }
 
+// GroupLabelSelectors returns a PromQL expression selecting the union of the
+// series selected by the PromQL selectors in matches, keeping the type of
+// every matcher. The union is built with the or operator, which ignores the
+// metric name when comparing series, so the metric name of every selected
+// series is copied to nameLabel first. A series selected by several matches
+// is returned once.
 func GroupLabelSelectors(matches []string) (string, error) {
 	labelSets, err := parseMetricSelectorFromArray(matches)
 	if err != nil {
 		return "", errors.Wrap(err, "could not parse metric selectors from matches array")
 	}
-	newLabelSet := map[string][]string{}
-	for _, ls := range labelSets {
-		for _, lm := range ls {
-			_, exists := newLabelSet[lm.Name]
-			if exists {
-				newLabelSet[lm.Name] = append(newLabelSet[lm.Name], lm.Value)
-			} else {
-				newLabelSet[lm.Name] = []string{lm.Value}
-			}
-		}
-
+	if len(labelSets) == 0 {
+		return "", errors.New("no metric selectors in matches array")
 	}
 
-	keys := make([]string, 0, len(newLabelSet))
-	for k := range newLabelSet {
-		keys = append(keys, k)
-	}
-	sort.Strings(keys)
-
-	res := "{"
-	i := 0
-	for _, k := range keys {
-		res += k + `=~"`
-		res += strings.Join(newLabelSet[k], "|")
-		i++
-		if k != "__name__" {
-			res += `|`
+	selectors := make([]string, len(labelSets))
+	for i, ls := range labelSets {
+		matchers := make([]string, len(ls))
+		for j, lm := range ls {
+			matchers[j] = lm.String()
 		}
-		if i == len(newLabelSet) {
-			res += `"`
-			continue
-		}
-		res += `",`
+		selectors[i] = "{" + strings.Join(matchers, ",") + "}"
+	}
+	if len(selectors) == 1 {
+		return selectors[0], nil
 	}
 
-	return res + "}", nil
+	for i, sel := range selectors {
+		selectors[i] = fmt.Sprintf(`label_replace(%s, %q, "$1", %q, "(.+)")`, sel, nameLabel, labels.MetricName)
+	}
+	return strings.Join(selectors, " or "), nil
 }
 
 func parseMetricSelectorFromArray(matches []string) ([][]*labels.Matcher, error) {
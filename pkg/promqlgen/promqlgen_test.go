@@ -15,55 +15,248 @@
 package promqlgen
 
 import (
+	"fmt"
+	"math/rand"
 	"reflect"
+	"strings"
 	"testing"
 
 	monv1 "github.com/coreos/prometheus-operator/pkg/apis/monitoring/v1"
+	"github.com/prometheus/prometheus/pkg/labels"
+	promql "github.com/prometheus/prometheus/promql/parser"
 )
 
-func TestLabelSelectorsToRelabelConfig(t *testing.T) {
+func TestLabelSelectorsToRelabelConfigs(t *testing.T) {
 	matches := []string{
 		`{__name__="metric1"}`,
-		`{alertstate="firing",__name__="ALERTS"}`,
-		`{__name__="metric2"}`,
+		`{__name__=~"metric2|metric3"}`,
+		`{__name__="metric.4"}`,
 	}
-	r, err := LabelSelectorsToRelabelConfig(matches)
+	r, err := LabelSelectorsToRelabelConfigs(matches)
 	if err != nil {
 		t.Fatal(err)
 	}
 
-	expected := &monv1.RelabelConfig{
+	// Selectors with a single positive matcher on the same label need a
+	// single keep config.
+	expected := []monv1.RelabelConfig{{
 		Action:       "keep",
-		SourceLabels: []string{"__name__", "alertstate"},
-		Regex:        "(metric1;|ALERTS;firing|metric2;)",
-	}
+		SourceLabels: []string{"__name__"},
+		Regex:        `metric1|(?:metric2|metric3)|metric\.4`,
+	}}
 	if !reflect.DeepEqual(expected, r) {
-		t.Fatal("unexpected result")
+		t.Fatalf("expected %v, got %v", expected, r)
 	}
 }
 
-func TestGenerateTelemeterWhitelistRec(t *testing.T) {
-	cases := [][]string{
-		[]string{
-			`{alertstate="firing",__name__="ALERTS"}`,
-			`{__name__="node_uname_info"}`,
-			`{__name__="csv_abnormal"}`,
+func TestLabelSelectorsToRelabelConfigsMatchers(t *testing.T) {
+	for _, tc := range []struct {
+		name    string
+		matches []string
+		series  []map[string]string
+		kept    []bool
+	}{
+		{
+			name:    "negative matcher",
+			matches: []string{`{__name__="foo",job!="bar"}`},
+			series: []map[string]string{
+				{"__name__": "foo", "job": "baz"},
+				{"__name__": "foo", "job": "bar"},
+				{"__name__": "foo"},
+				{"__name__": "bar", "job": "baz"},
+			},
+			kept: []bool{true, false, true, false},
+		},
+		{
+			name:    "regexp matcher",
+			matches: []string{`{__name__=~"a|b"}`, `{__name__="c",job=~"x.*"}`},
+			series: []map[string]string{
+				{"__name__": "a"},
+				{"__name__": "b"},
+				{"__name__": "a|b"},
+				{"__name__": "c", "job": "xyz"},
+				{"__name__": "c", "job": "y"},
+			},
+			kept: []bool{true, true, false, true, false},
+		},
+		{
+			name:    "negative regexp matcher",
+			matches: []string{`{__name__="ALERTS",alertstate!~"pending|"}`},
+			series: []map[string]string{
+				{"__name__": "ALERTS", "alertstate": "firing"},
+				{"__name__": "ALERTS", "alertstate": "pending"},
+				{"__name__": "ALERTS"},
+			},
+			kept: []bool{true, false, false},
 		},
-		[]string{
-			`{__name__="ALERTS",alertstate="firing"}`,
-			`{__name__="node_uname_info"}`,
-			`{__name__="csv_abnormal"}`,
+		{
+			name:    "separator in label values",
+			matches: []string{`{__name__=~".*",job="b"}`},
+			series: []map[string]string{
+				{"__name__": "a", "job": "x;b"},
+				{"__name__": "a;x", "job": "b"},
+			},
+			kept: []bool{false, true},
 		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			rcs, err := LabelSelectorsToRelabelConfigs(tc.matches)
+			if err != nil {
+				t.Fatal(err)
+			}
+			for i, s := range tc.series {
+				if kept := relabel(t, s, rcs) != nil; kept != tc.kept[i] {
+					t.Errorf("series %v: expected kept to be %v, got %v", s, tc.kept[i], kept)
+				}
+			}
+		})
+	}
+}
+
+// TestLabelSelectorsToRelabelConfigsSemantics checks that the relabel
+// configs keep the same series as the PromQL selectors for random selectors
+// and series.
+func TestLabelSelectorsToRelabelConfigsSemantics(t *testing.T) {
+	var (
+		rnd      = rand.New(rand.NewSource(1))
+		names    = []string{"__name__", "job", "instance"}
+		values   = []string{"", "foo", "bar", "foo;bar", "f.o", "foo|bar", "fxo"}
+		regexps  = []string{"", "foo", "fo.*", ".*", ".+", "foo|bar", `f\.o`, ".*;.*", "(b)ar|"}
+		matchOps = []string{"=", "!=", "=~", "!~"}
+	)
+
+	randomSeries := func() map[string]string {
+		s := map[string]string{}
+		for _, n := range names {
+			if v := values[rnd.Intn(len(values))]; v != "" {
+				s[n] = v
+			}
+		}
+		return s
+	}
+	randomSelector := func() string {
+		var matchers []string
+		for i := 0; i <= rnd.Intn(3); i++ {
+			op := matchOps[rnd.Intn(len(matchOps))]
+			v := values[rnd.Intn(len(values))]
+			if strings.HasSuffix(op, "~") {
+				v = regexps[rnd.Intn(len(regexps))]
+			}
+			matchers = append(matchers, fmt.Sprintf("%s%s%q", names[rnd.Intn(len(names))], op, v))
+		}
+		return "{" + strings.Join(matchers, ",") + "}"
 	}
-	expected := `{__name__=~"ALERTS|node_uname_info|csv_abnormal",alertstate=~"firing|"}`
-	for _, i := range cases {
 
-		expr, err := GroupLabelSelectors(i)
+	for i := 0; i < 500; i++ {
+		var matches []string
+		for n := 1 + rnd.Intn(4); len(matches) < n; {
+			m := randomSelector()
+			// Selectors whose matchers all match empty labels are invalid.
+			if _, err := promql.ParseMetricSelector(m); err != nil {
+				continue
+			}
+			matches = append(matches, m)
+		}
+
+		selectors, err := parseMetricSelectorFromArray(matches)
+		if err != nil {
+			t.Fatal(err)
+		}
+		rcs, err := LabelSelectorsToRelabelConfigs(matches)
 		if err != nil {
 			t.Fatal(err)
 		}
-		if expr != expected {
-			t.Errorf("failed to generate: expected: %s got: %s", expected, expr)
+
+		for j := 0; j < 50; j++ {
+			s := randomSeries()
+			selected := false
+			for _, ls := range selectors {
+				if matchesAll(ls, s) {
+					selected = true
+					break
+				}
+			}
+
+			got := relabel(t, s, rcs)
+			if selected != (got != nil) {
+				t.Fatalf("matches %v: expected series %v to be kept: %v, got %v", matches, s, selected, got != nil)
+			}
+			if got != nil && !reflect.DeepEqual(got, s) {
+				t.Fatalf("matches %v: expected series %v to be kept as is, got %v", matches, s, got)
+			}
+		}
+	}
+}
+
+func matchesAll(ls []*labels.Matcher, s map[string]string) bool {
+	for _, lm := range ls {
+		if !lm.Matches(s[lm.Name]) {
+			return false
+		}
+	}
+	return true
+}
+
+// relabel applies rcs to a series with Relabel. It returns nil if the series
+// is dropped.
+func relabel(t *testing.T, series map[string]string, rcs []monv1.RelabelConfig) map[string]string {
+	t.Helper()
+
+	ls, err := Relabel(labels.FromMap(series), rcs)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ls == nil {
+		return nil
+	}
+	return ls.Map()
+}
+
+func TestGroupLabelSelectors(t *testing.T) {
+	for _, tc := range []struct {
+		name     string
+		matches  []string
+		expected string
+	}{
+		{
+			name:     "single selector",
+			matches:  []string{`{alertstate="firing",__name__="ALERTS"}`},
+			expected: `{alertstate="firing",__name__="ALERTS"}`,
+		},
+		{
+			name: "matcher types",
+			matches: []string{
+				`{__name__="ALERTS",alertstate!="pending"}`,
+				`{__name__=~"node_uname_info|csv_abnormal"}`,
+				`{__name__="up",job!~"a|b"}`,
+			},
+			expected: `label_replace({__name__="ALERTS",alertstate!="pending"}, "__tmp_name", "$1", "__name__", "(.+)")` +
+				` or label_replace({__name__=~"node_uname_info|csv_abnormal"}, "__tmp_name", "$1", "__name__", "(.+)")` +
+				` or label_replace({__name__="up",job!~"a|b"}, "__tmp_name", "$1", "__name__", "(.+)")`,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			expr, err := GroupLabelSelectors(tc.matches)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if expr != tc.expected {
+				t.Fatalf("expected %s, got %s", tc.expected, expr)
+			}
+			if _, err := promql.ParseExpr(expr); err != nil {
+				t.Fatalf("expected a valid expression, got %v", err)
+			}
+		})
+	}
+}
+
+func TestGroupLabelSelectorsError(t *testing.T) {
+	for _, matches := range [][]string{
+		{`{__name__="ALERTS"}`, `{__name__=`},
+		nil,
+	} {
+		if _, err := GroupLabelSelectors(matches); err == nil {
+			t.Errorf("expected an error for matches %v", matches)
 		}
 	}
 }
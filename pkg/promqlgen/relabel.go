@@ -0,0 +1,101 @@
+// Copyright 2020 The Cluster Monitoring Operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package promqlgen
+
+import (
+	"regexp"
+	"strings"
+
+	monv1 "github.com/coreos/prometheus-operator/pkg/apis/monitoring/v1"
+	"github.com/pkg/errors"
+	"github.com/prometheus/prometheus/pkg/labels"
+)
+
+// Relabel applies the relabel configs to a series with the semantics of
+// Prometheus relabeling and returns the resulting labels, or nil if the
+// series is dropped. Empty fields get the defaults of Prometheus since the
+// Prometheus Operator omits them. Only the actions used by
+// LabelSelectorsToRelabelConfigs are supported.
+func Relabel(series labels.Labels, rcs []monv1.RelabelConfig) (labels.Labels, error) {
+	s := make(map[string]string, len(series))
+	for _, l := range series {
+		s[l.Name] = l.Value
+	}
+
+	for _, rc := range rcs {
+		regex, separator, replacement, action := rc.Regex, rc.Separator, rc.Replacement, rc.Action
+		if regex == "" {
+			regex = "(.*)"
+		}
+		if separator == "" {
+			separator = ";"
+		}
+		if replacement == "" {
+			replacement = "$1"
+		}
+		if action == "" {
+			action = "replace"
+		}
+		re, err := regexp.Compile("^(?:" + regex + ")$")
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid relabel regexp %q", regex)
+		}
+
+		values := make([]string, len(rc.SourceLabels))
+		for i, l := range rc.SourceLabels {
+			values[i] = s[l]
+		}
+		val := strings.Join(values, separator)
+
+		switch action {
+		case "keep":
+			if !re.MatchString(val) {
+				return nil, nil
+			}
+		case "drop":
+			if re.MatchString(val) {
+				return nil, nil
+			}
+		case "replace":
+			idx := re.FindStringSubmatchIndex(val)
+			if idx == nil {
+				continue
+			}
+			target := string(re.ExpandString(nil, rc.TargetLabel, val, idx))
+			res := re.ExpandString(nil, replacement, val, idx)
+			if len(res) == 0 {
+				delete(s, target)
+				continue
+			}
+			s[target] = string(res)
+		case "labeldrop":
+			for k := range s {
+				if re.MatchString(k) {
+					delete(s, k)
+				}
+			}
+		default:
+			return nil, errors.Errorf("unsupported relabel action %q", action)
+		}
+
+		// Empty labels don't exist.
+		for k, v := range s {
+			if v == "" {
+				delete(s, k)
+			}
+		}
+	}
+	return labels.FromMap(s), nil
+}
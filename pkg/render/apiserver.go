@@ -0,0 +1,521 @@
+// Copyright 2020 The Cluster Monitoring Operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package render
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/ghodss/yaml"
+	"github.com/pkg/errors"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/openshift/cluster-monitoring-operator/pkg/manifests"
+)
+
+// Annotations and labels of the objects the OpenShift service CA operator
+// and the cluster network operator act on.
+var (
+	servingCertSecretAnnotations = []string{
+		"service.alpha.openshift.io/serving-cert-secret-name",
+		"service.beta.openshift.io/serving-cert-secret-name",
+	}
+	injectCABundleAnnotations = []string{
+		"service.alpha.openshift.io/inject-cabundle",
+		"service.beta.openshift.io/inject-cabundle",
+	}
+	injectTrustedCABundleLabel = "config.openshift.io/inject-trusted-cabundle"
+)
+
+// objectPath addresses an object, or a collection if name is empty, in the
+// API.
+type objectPath struct {
+	// prefix is the API group version path, e.g. /apis/apps/v1.
+	prefix      string
+	namespace   string
+	resource    string
+	name        string
+	subresource string
+}
+
+// parsePath returns the object addressed by a request path such as
+// /apis/apps/v1/namespaces/foo/deployments/bar.
+func parsePath(path string) (objectPath, bool) {
+	var p objectPath
+	parts := strings.Split(strings.Trim(path, "/"), "/")
+	switch {
+	case len(parts) >= 2 && parts[0] == "api":
+		p.prefix, parts = "/"+strings.Join(parts[:2], "/"), parts[2:]
+	case len(parts) >= 3 && parts[0] == "apis":
+		p.prefix, parts = "/"+strings.Join(parts[:3], "/"), parts[3:]
+	default:
+		return p, false
+	}
+
+	if len(parts) >= 3 && parts[0] == "namespaces" {
+		p.namespace, parts = parts[1], parts[2:]
+	}
+	switch len(parts) {
+	case 3:
+		p.subresource = parts[2]
+		fallthrough
+	case 2:
+		p.name = parts[1]
+		fallthrough
+	case 1:
+		p.resource = parts[0]
+	default:
+		return p, false
+	}
+	return p, true
+}
+
+func (p objectPath) collection() string {
+	if p.namespace == "" {
+		return p.prefix + "/" + p.resource
+	}
+	return p.prefix + "/namespaces/" + p.namespace + "/" + p.resource
+}
+
+func (p objectPath) key() string {
+	return p.collection() + "/" + p.name
+}
+
+func (p objectPath) groupResource() schema.GroupResource {
+	gr := schema.GroupResource{Resource: p.resource}
+	if parts := strings.Split(p.prefix, "/"); len(parts) == 4 {
+		gr.Group = parts[2]
+	}
+	return gr
+}
+
+type storedObject struct {
+	path objectPath
+	obj  map[string]interface{}
+}
+
+// apiServer is an in-memory API server the operator tasks run against. It
+// persists all writes including dry-run requests and stands in for the
+// cluster components the tasks rely on: route hosts are defaulted, CA
+// bundles are injected and serving certificate secrets are created, all
+// with placeholders.
+type apiServer struct {
+	appsDomain string
+
+	mtx             sync.Mutex
+	objects         map[string]storedObject
+	resourceVersion int
+
+	// written holds the keys of the objects written by the client in
+	// order of their first write since the last call of takeWritten.
+	// rendered holds the objects as written by the client, without the
+	// changes of the emulated cluster components.
+	written  []string
+	rendered map[string]map[string]interface{}
+}
+
+// newAPIServer returns an API server holding the objects of the cluster
+// context.
+func newAPIServer(cluster *ClusterContext) (*apiServer, error) {
+	s := &apiServer{
+		appsDomain: cluster.AppsDomain,
+		objects:    map[string]storedObject{},
+		rendered:   map[string]map[string]interface{}{},
+	}
+
+	objs := cluster.objects()
+	for _, obj := range objs {
+		if err := s.seed(obj); err != nil {
+			return nil, err
+		}
+	}
+	return s, nil
+}
+
+// seed stores obj as an object existing in the cluster.
+func (s *apiServer) seed(obj runtime.Object) error {
+	if err := setGroupVersionKind(obj); err != nil {
+		return err
+	}
+	gvk := obj.GetObjectKind().GroupVersionKind()
+	m, err := meta.Accessor(obj)
+	if err != nil {
+		return err
+	}
+
+	p := objectPath{prefix: "/apis/" + gvk.GroupVersion().String(), namespace: m.GetNamespace(), name: m.GetName()}
+	if gvk.Group == "" {
+		p.prefix = "/api/" + gvk.Version
+	}
+	plural, _ := meta.UnsafeGuessKindToResource(gvk)
+	p.resource = plural.Resource
+
+	b, err := json.Marshal(obj)
+	if err != nil {
+		return err
+	}
+	var decoded map[string]interface{}
+	if err := json.Unmarshal(b, &decoded); err != nil {
+		return err
+	}
+	s.store(p, decoded)
+	return nil
+}
+
+// takeWritten returns the keys of the objects written since the last call.
+func (s *apiServer) takeWritten() []string {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	written := s.written
+	s.written = nil
+	return written
+}
+
+// renderedObject returns the object as last written by the client. It
+// returns false if the object was deleted since.
+func (s *apiServer) renderedObject(key string) (map[string]interface{}, bool) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	if _, ok := s.objects[key]; !ok {
+		return nil, false
+	}
+	obj, ok := s.rendered[key]
+	return obj, ok
+}
+
+func (s *apiServer) RoundTrip(req *http.Request) (*http.Response, error) {
+	var body []byte
+	if req.Body != nil {
+		var err error
+		body, err = ioutil.ReadAll(req.Body)
+		req.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	p, ok := parsePath(req.URL.Path)
+	if !ok {
+		return errorResponse(req, apierrors.NewNotFound(schema.GroupResource{}, req.URL.Path))
+	}
+
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	code, obj, err := s.serve(req, p, body)
+	if err != nil {
+		return errorResponse(req, err)
+	}
+	return response(req, code, obj)
+}
+
+func (s *apiServer) serve(req *http.Request, p objectPath, body []byte) (int, interface{}, error) {
+	existing, exists := s.objects[p.key()]
+	if p.name == "" {
+		switch req.Method {
+		case http.MethodGet:
+			return s.list(p, req.URL.Query().Get("labelSelector"))
+		case http.MethodPost:
+		default:
+			return 0, nil, apierrors.NewMethodNotSupported(p.groupResource(), req.Method)
+		}
+	} else if !exists && req.Method != http.MethodPatch {
+		return 0, nil, apierrors.NewNotFound(p.groupResource(), p.name)
+	}
+
+	switch req.Method {
+	case http.MethodGet:
+		return http.StatusOK, existing.obj, nil
+
+	case http.MethodPost:
+		obj, err := decode(body)
+		if err != nil {
+			return 0, nil, apierrors.NewBadRequest(err.Error())
+		}
+		p.name, _, _ = unstructured.NestedString(obj, "metadata", "name")
+		if _, ok := s.objects[p.key()]; ok {
+			return 0, nil, apierrors.NewAlreadyExists(p.groupResource(), p.name)
+		}
+		return http.StatusCreated, s.write(p, obj), nil
+
+	case http.MethodPut:
+		obj, err := decode(body)
+		if err != nil {
+			return 0, nil, apierrors.NewBadRequest(err.Error())
+		}
+		if p.subresource == "status" {
+			status := obj["status"]
+			obj = copyObject(existing.obj)
+			obj["status"] = status
+		}
+		return http.StatusOK, s.write(p, obj), nil
+
+	case http.MethodPatch:
+		patch, err := decode(body)
+		if err != nil {
+			return 0, nil, apierrors.NewBadRequest(err.Error())
+		}
+
+		switch req.Header.Get("Content-Type") {
+		case "application/apply-patch+yaml":
+			// Every task applies complete objects, the applied object thus
+			// replaces the fields set by earlier applies.
+			if status, ok := existing.obj["status"]; exists && ok {
+				patch["status"] = status
+			}
+			if !exists {
+				return http.StatusCreated, s.write(p, patch), nil
+			}
+			return http.StatusOK, s.write(p, patch), nil
+		case "application/merge-patch+json":
+			if !exists {
+				return 0, nil, apierrors.NewNotFound(p.groupResource(), p.name)
+			}
+			obj, _ := mergePatch(copyObject(existing.obj), patch).(map[string]interface{})
+			return http.StatusOK, s.write(p, obj), nil
+		default:
+			return 0, nil, apierrors.NewBadRequest(fmt.Sprintf("unsupported patch type %q", req.Header.Get("Content-Type")))
+		}
+
+	case http.MethodDelete:
+		delete(s.objects, p.key())
+		return http.StatusOK, &metav1.Status{
+			TypeMeta: metav1.TypeMeta{Kind: "Status", APIVersion: "v1"},
+			Status:   metav1.StatusSuccess,
+		}, nil
+	}
+
+	return 0, nil, apierrors.NewMethodNotSupported(p.groupResource(), req.Method)
+}
+
+// list returns the objects of a collection matching the label selector.
+func (s *apiServer) list(p objectPath, selector string) (int, interface{}, error) {
+	sel, err := labels.Parse(selector)
+	if err != nil {
+		return 0, nil, apierrors.NewBadRequest(err.Error())
+	}
+
+	var keys []string
+	for k, o := range s.objects {
+		if o.path.collection() != p.collection() {
+			continue
+		}
+		ls, _, _ := unstructured.NestedStringMap(o.obj, "metadata", "labels")
+		if sel.Matches(labels.Set(ls)) {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+
+	items := make([]interface{}, 0, len(keys))
+	for _, k := range keys {
+		items = append(items, s.objects[k].obj)
+	}
+	// The kind is left out, clients fall back to the kind of the list
+	// they decode into.
+	return http.StatusOK, map[string]interface{}{
+		"apiVersion": strings.TrimPrefix(strings.TrimPrefix(p.prefix, "/apis/"), "/api/"),
+		"metadata":   map[string]interface{}{"resourceVersion": strconv.Itoa(s.resourceVersion)},
+		"items":      items,
+	}, nil
+}
+
+// write stores an object written by the client and returns the stored
+// object.
+func (s *apiServer) write(p objectPath, obj map[string]interface{}) map[string]interface{} {
+	md := metadata(obj)
+	md["name"] = p.name
+	if p.namespace != "" {
+		md["namespace"] = p.namespace
+	}
+	delete(md, "resourceVersion")
+
+	key := p.key()
+	if _, ok := s.rendered[key]; !ok {
+		s.written = append(s.written, key)
+	}
+	s.rendered[key] = copyObject(obj)
+
+	s.store(p, obj)
+	s.reconcile(p, obj)
+	return obj
+}
+
+// store stores obj at p without recording it as written by the client.
+func (s *apiServer) store(p objectPath, obj map[string]interface{}) {
+	s.resourceVersion++
+	metadata(obj)["resourceVersion"] = strconv.Itoa(s.resourceVersion)
+	s.objects[p.key()] = storedObject{path: p, obj: obj}
+}
+
+// reconcile changes the stored object and creates the objects derived from
+// it like the cluster components would.
+func (s *apiServer) reconcile(p objectPath, obj map[string]interface{}) {
+	md := metadata(obj)
+	annotations, _, _ := unstructured.NestedStringMap(obj, "metadata", "annotations")
+	ls, _, _ := unstructured.NestedStringMap(obj, "metadata", "labels")
+
+	switch p.prefix + "/" + p.resource {
+	case "/apis/route.openshift.io/v1/routes":
+		spec, _ := obj["spec"].(map[string]interface{})
+		if spec == nil {
+			spec = map[string]interface{}{}
+			obj["spec"] = spec
+		}
+		if host, _ := spec["host"].(string); host == "" {
+			spec["host"] = fmt.Sprintf("%s-%s.%s", md["name"], p.namespace, s.appsDomain)
+		}
+
+	case "/api/v1/configmaps":
+		data, _ := obj["data"].(map[string]interface{})
+		if data == nil {
+			data = map[string]interface{}{}
+		}
+		if ls[injectTrustedCABundleLabel] == "true" {
+			data[manifests.TrustedCABundleKey] = placeholder
+		}
+		for _, a := range injectCABundleAnnotations {
+			if annotations[a] == "true" {
+				data["service-ca.crt"] = placeholder
+			}
+		}
+		if len(data) > 0 {
+			obj["data"] = data
+		}
+
+	case "/api/v1/services":
+		for _, a := range servingCertSecretAnnotations {
+			name := annotations[a]
+			if name == "" {
+				continue
+			}
+			sp := objectPath{prefix: "/api/v1", namespace: p.namespace, resource: "secrets", name: name}
+			if _, ok := s.objects[sp.key()]; ok {
+				continue
+			}
+			s.store(sp, map[string]interface{}{
+				"apiVersion": "v1",
+				"kind":       "Secret",
+				"metadata":   map[string]interface{}{"name": name, "namespace": p.namespace},
+				"type":       "kubernetes.io/tls",
+				"data": map[string]interface{}{
+					"tls.crt": []byte(placeholder),
+					"tls.key": []byte(placeholder),
+				},
+			})
+		}
+	}
+}
+
+// decode decodes a JSON or YAML request body.
+func decode(body []byte) (map[string]interface{}, error) {
+	b, err := yaml.YAMLToJSON(body)
+	if err != nil {
+		return nil, errors.Wrap(err, "decoding request body failed")
+	}
+	var obj map[string]interface{}
+	if err := json.Unmarshal(b, &obj); err != nil {
+		return nil, errors.Wrap(err, "decoding request body failed")
+	}
+	if obj == nil {
+		return nil, errors.New("empty request body")
+	}
+	return obj, nil
+}
+
+// mergePatch applies a JSON merge patch as defined by RFC 7386.
+func mergePatch(target, patch interface{}) interface{} {
+	p, ok := patch.(map[string]interface{})
+	if !ok {
+		return patch
+	}
+	t, ok := target.(map[string]interface{})
+	if !ok {
+		t = map[string]interface{}{}
+	}
+	for k, v := range p {
+		if v == nil {
+			delete(t, k)
+			continue
+		}
+		t[k] = mergePatch(t[k], v)
+	}
+	return t
+}
+
+// copyObject returns a deep copy of a decoded JSON object.
+func copyObject(obj map[string]interface{}) map[string]interface{} {
+	b, err := json.Marshal(obj)
+	if err != nil {
+		panic(err)
+	}
+	var c map[string]interface{}
+	if err := json.Unmarshal(b, &c); err != nil {
+		panic(err)
+	}
+	return c
+}
+
+// metadata returns the metadata of obj, adding it if it is missing.
+func metadata(obj map[string]interface{}) map[string]interface{} {
+	md, ok := obj["metadata"].(map[string]interface{})
+	if !ok {
+		md = map[string]interface{}{}
+		obj["metadata"] = md
+	}
+	return md
+}
+
+func errorResponse(req *http.Request, err error) (*http.Response, error) {
+	status := apierrors.NewInternalError(err).ErrStatus
+	if se, ok := err.(apierrors.APIStatus); ok {
+		status = se.Status()
+	}
+	status.TypeMeta = metav1.TypeMeta{Kind: "Status", APIVersion: "v1"}
+	return response(req, int(status.Code), &status)
+}
+
+func response(req *http.Request, code int, obj interface{}) (*http.Response, error) {
+	b, err := json.Marshal(obj)
+	if err != nil {
+		return nil, err
+	}
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", code, http.StatusText(code)),
+		StatusCode:    code,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        http.Header{"Content-Type": []string{"application/json"}},
+		Body:          ioutil.NopCloser(bytes.NewReader(b)),
+		ContentLength: int64(len(b)),
+		Request:       req,
+	}, nil
+}
@@ -0,0 +1,138 @@
+// Copyright 2020 The Cluster Monitoring Operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package render
+
+import (
+	"encoding/base64"
+	"fmt"
+	"hash/fnv"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/openshift/cluster-monitoring-operator/pkg/manifests"
+)
+
+const (
+	// redacted replaces the content of secrets and the values derived from
+	// it, which the operator generates anew on every run.
+	redacted = "<redacted>"
+
+	hashLabel = "monitoring.openshift.io/hash"
+)
+
+// redactSecrets makes the rendered objects safe to write and the same for
+// the same input. The data of secrets is replaced with placeholders and
+// private keys are left out. The names of hashed secrets are derived from
+// the placeholders and the annotations holding the hash or the generation
+// time of secrets are replaced.
+func redactSecrets(objs []map[string]interface{}) {
+	hashes := map[string]string{}
+	for _, obj := range objs {
+		if obj["apiVersion"] != "v1" || obj["kind"] != "Secret" {
+			continue
+		}
+
+		for _, field := range []string{"data", "stringData"} {
+			data, _ := obj[field].(map[string]interface{})
+			for k, v := range data {
+				if isPrivateKey(k, decodeValue(field, v)) {
+					delete(data, k)
+					continue
+				}
+				data[k] = redacted
+				if field == "data" {
+					data[k] = base64.StdEncoding.EncodeToString([]byte(redacted))
+				}
+			}
+		}
+
+		md, _ := obj["metadata"].(map[string]interface{})
+		if annotations, ok := md["annotations"].(map[string]interface{}); ok {
+			if _, ok := annotations[manifests.GeneratedAtAnnotation]; ok {
+				annotations[manifests.GeneratedAtAnnotation] = redacted
+			}
+		}
+		if labels, ok := md["labels"].(map[string]interface{}); ok {
+			if hash, ok := labels[hashLabel].(string); ok && hash != "" {
+				hashes[hash] = redactedHash(obj)
+			}
+		}
+	}
+
+	for i, obj := range objs {
+		objs[i] = redactValue(obj, hashes).(map[string]interface{})
+	}
+}
+
+// redactValue replaces the hashes of hashed secrets in every string of v and
+// the secrets hash annotations.
+func redactValue(v interface{}, hashes map[string]string) interface{} {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		for k, e := range v {
+			if k == manifests.SecretsHashAnnotation {
+				v[k] = redacted
+				continue
+			}
+			v[k] = redactValue(e, hashes)
+		}
+	case []interface{}:
+		for i, e := range v {
+			v[i] = redactValue(e, hashes)
+		}
+	case string:
+		for old, hash := range hashes {
+			v = strings.Replace(v, old, hash, -1)
+		}
+		return v
+	}
+	return v
+}
+
+// redactedHash hashes the keys of the redacted secret obj like
+// manifests.Factory.HashSecret hashes the values.
+func redactedHash(obj map[string]interface{}) string {
+	data, _ := obj["data"].(map[string]interface{})
+	keys := make([]string, 0, len(data))
+	for k := range data {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	h := fnv.New64()
+	for _, k := range keys {
+		fmt.Fprintf(h, "%s:%s\n", k, redacted)
+	}
+	return strconv.FormatUint(h.Sum64(), 32)
+}
+
+// decodeValue returns the content of a value of the data or stringData
+// field of a secret.
+func decodeValue(field string, v interface{}) []byte {
+	s, _ := v.(string)
+	if field == "data" {
+		b, err := base64.StdEncoding.DecodeString(s)
+		if err == nil {
+			return b
+		}
+	}
+	return []byte(s)
+}
+
+// isPrivateKey tells whether the secret key k holds a private key.
+func isPrivateKey(k string, v []byte) bool {
+	return strings.HasSuffix(k, ".key") || strings.Contains(string(v), "PRIVATE KEY")
+}
@@ -0,0 +1,382 @@
+// Copyright 2020 The Cluster Monitoring Operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package render generates the objects managed by the operator without
+// talking to the API server. The tasks of the operator run against an
+// in-memory API server which holds the state of a stubbed ClusterContext.
+package render
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	monv1 "github.com/coreos/prometheus-operator/pkg/apis/monitoring/v1"
+	"github.com/ghodss/yaml"
+	configv1 "github.com/openshift/api/config/v1"
+	routev1 "github.com/openshift/api/route/v1"
+	securityv1 "github.com/openshift/api/security/v1"
+	"github.com/pkg/errors"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/serializer"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	yamlutil "k8s.io/apimachinery/pkg/util/yaml"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/rest"
+	apiregistrationv1beta1 "k8s.io/kube-aggregator/pkg/apis/apiregistration/v1beta1"
+
+	"github.com/openshift/cluster-monitoring-operator/pkg/manifests"
+	"github.com/openshift/cluster-monitoring-operator/pkg/operator"
+)
+
+const (
+	placeholder = "<injected at runtime>"
+
+	// namespaceSelector selects the namespaces to monitor.
+	namespaceSelector = "openshift.io/cluster-monitoring=true"
+)
+
+// scheme knows about all types created by the operator. It is used to fill in
+// the type information of objects which aren't decoded from assets.
+var (
+	scheme = runtime.NewScheme()
+	codecs = serializer.NewCodecFactory(scheme)
+)
+
+func init() {
+	utilruntime.Must(clientgoscheme.AddToScheme(scheme))
+	utilruntime.Must(monv1.AddToScheme(scheme))
+	utilruntime.Must(routev1.Install(scheme))
+	utilruntime.Must(securityv1.Install(scheme))
+	utilruntime.Must(apiregistrationv1beta1.AddToScheme(scheme))
+	utilruntime.Must(configv1.Install(scheme))
+}
+
+// ClusterContext holds the cluster state that the operator reads from the
+// API server during a sync. The in-memory API server serves it as the
+// objects the state is read from.
+type ClusterContext struct {
+	Platform            configv1.PlatformType
+	HTTPProxy           string
+	HTTPSProxy          string
+	NoProxy             string
+	ClusterID           string
+	TelemeterToken      string
+	AppsDomain          string
+	NamespacesToMonitor []string
+	// Objects are further objects existing in the cluster, e.g. the secret
+	// referenced by grpcTLS.caSecret or the objects of unmanaged
+	// components.
+	Objects []runtime.Object
+}
+
+// objects returns the objects holding the cluster context along with the
+// objects existing in every cluster which the tasks read, followed by the
+// given objects.
+func (cc *ClusterContext) objects() []runtime.Object {
+	objs := []runtime.Object{
+		&configv1.ClusterVersion{
+			ObjectMeta: metav1.ObjectMeta{Name: "version"},
+			Spec:       configv1.ClusterVersionSpec{ClusterID: configv1.ClusterID(cc.ClusterID)},
+		},
+		&configv1.Proxy{
+			ObjectMeta: metav1.ObjectMeta{Name: "cluster"},
+			Status: configv1.ProxyStatus{
+				HTTPProxy:  cc.HTTPProxy,
+				HTTPSProxy: cc.HTTPSProxy,
+				NoProxy:    cc.NoProxy,
+			},
+		},
+		&configv1.Infrastructure{
+			ObjectMeta: metav1.ObjectMeta{Name: "cluster"},
+			Status:     configv1.InfrastructureStatus{Platform: cc.Platform},
+		},
+		&v1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: "extension-apiserver-authentication", Namespace: "kube-system"},
+			Data: map[string]string{
+				"client-ca-file":                     placeholder,
+				"requestheader-client-ca-file":       placeholder,
+				"requestheader-allowed-names":        "[]",
+				"requestheader-extra-headers-prefix": "[]",
+				"requestheader-group-headers":        "[]",
+				"requestheader-username-headers":     "[]",
+			},
+		},
+		&v1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: "kubelet-serving-ca", Namespace: "openshift-config-managed"},
+			Data:       map[string]string{"ca-bundle.crt": placeholder},
+		},
+		&v1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: "etcd-metric-serving-ca", Namespace: "openshift-config"},
+			Data:       map[string]string{"ca-bundle.crt": placeholder},
+		},
+		&v1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "etcd-metric-client", Namespace: "openshift-config"},
+			Data: map[string][]byte{
+				"tls.crt": []byte(placeholder),
+				"tls.key": []byte(placeholder),
+			},
+		},
+	}
+
+	if cc.TelemeterToken != "" {
+		auths := map[string]interface{}{
+			"auths": map[string]interface{}{
+				"cloud.openshift.com": map[string]string{"auth": cc.TelemeterToken},
+			},
+		}
+		b, _ := json.Marshal(auths)
+		objs = append(objs, &v1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "pull-secret", Namespace: "openshift-config"},
+			Type:       v1.SecretTypeDockerConfigJson,
+			Data:       map[string][]byte{v1.DockerConfigJsonKey: b},
+		})
+	}
+
+	selector, _ := labels.ConvertSelectorToLabelsMap(namespaceSelector)
+	for _, ns := range cc.NamespacesToMonitor {
+		objs = append(objs, &v1.Namespace{
+			ObjectMeta: metav1.ObjectMeta{Name: ns, Labels: selector},
+		})
+	}
+
+	return append(objs, cc.Objects...)
+}
+
+// ReadObjects decodes a stream of YAML or JSON documents holding one object
+// each.
+func ReadObjects(r io.Reader) ([]runtime.Object, error) {
+	var objs []runtime.Object
+	d := yamlutil.NewYAMLOrJSONDecoder(r, 4096)
+	for {
+		u := &unstructured.Unstructured{}
+		err := d.Decode(&u.Object)
+		if err == io.EOF {
+			return objs, nil
+		}
+		if err != nil {
+			return nil, errors.Wrap(err, "decoding objects failed")
+		}
+		if len(u.Object) == 0 {
+			continue
+		}
+		objs = append(objs, u)
+	}
+}
+
+// Renderer produces the objects of every task run by the operator.
+type Renderer struct {
+	namespace, namespaceUserWorkload string
+
+	config           *manifests.Config
+	cluster          *ClusterContext
+	images           map[string]string
+	telemetryMatches []string
+	remoteWrite      bool
+}
+
+// New returns a renderer for the given configuration. The cluster context,
+// images, telemetry matches and remote write setting are loaded into the
+// configuration like the operator does.
+func New(namespace, namespaceUserWorkload string, config *manifests.Config, cluster *ClusterContext, images map[string]string, telemetryMatches []string, remoteWrite bool) *Renderer {
+	return &Renderer{
+		namespace:             namespace,
+		namespaceUserWorkload: namespaceUserWorkload,
+		config:                config,
+		cluster:               cluster,
+		images:                images,
+		telemetryMatches:      telemetryMatches,
+		remoteWrite:           remoteWrite,
+	}
+}
+
+// Task is the rendered output of a single operator task.
+type Task struct {
+	// Name is the directory name of the task.
+	Name    string
+	Objects []runtime.Object
+}
+
+// Render runs the tasks of the operator against an in-memory API server
+// holding the cluster context and returns the objects written by every
+// task in the order the tasks ran. Objects are returned with the content of
+// their last write, objects deleted by a later task are left out. The
+// content of secrets is redacted so that the same input renders the same
+// objects. Tasks
+// which would only delete objects with the given configuration and tasks of
+// components which aren't managed are returned without objects.
+func (r *Renderer) Render() ([]Task, error) {
+	api, err := newAPIServer(r.cluster)
+	if err != nil {
+		return nil, errors.Wrap(err, "loading the cluster context failed")
+	}
+
+	// Requests to the in-memory API server aren't rate limited.
+	o, err := operator.New(
+		&rest.Config{Host: "https://api.render.invalid", Transport: api, QPS: -1}, "",
+		r.namespace, r.namespaceUserWorkload, namespaceSelector, "", "",
+		r.remoteWrite, r.images, r.telemetryMatches,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		names   []string
+		written [][]string
+	)
+	err = o.RenderTasks(context.Background(), r.config, func(task string) {
+		names = append(names, task)
+		written = append(written, api.takeWritten())
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "rendering tasks failed")
+	}
+
+	var (
+		keys    []string
+		objs    []map[string]interface{}
+		offsets = make([]int, len(names)+1)
+	)
+	for i := range names {
+		for _, key := range written[i] {
+			u, ok := api.renderedObject(key)
+			if !ok {
+				continue
+			}
+			keys = append(keys, key)
+			objs = append(objs, runtime.DeepCopyJSON(u))
+		}
+		offsets[i+1] = len(objs)
+	}
+	redactSecrets(objs)
+
+	tasks := make([]Task, len(names))
+	for i, name := range names {
+		tasks[i].Name = taskDir(name)
+		for j := offsets[i]; j < offsets[i+1]; j++ {
+			obj, err := decodeObject(objs[j])
+			if err != nil {
+				return nil, errors.Wrapf(err, "decoding %s failed", keys[j])
+			}
+			tasks[i].Objects = append(tasks[i].Objects, obj)
+		}
+	}
+
+	return tasks, nil
+}
+
+// taskDir returns the directory name of a task, e.g. prometheus-k8s for
+// "Updating Prometheus-k8s".
+func taskDir(task string) string {
+	task = strings.TrimPrefix(task, "Updating ")
+	return strings.ReplaceAll(strings.ToLower(task), " ", "-")
+}
+
+// decodeObject converts a decoded JSON object into its API type, objects of
+// unknown types are returned as unstructured objects.
+func decodeObject(obj map[string]interface{}) (runtime.Object, error) {
+	b, err := json.Marshal(obj)
+	if err != nil {
+		return nil, err
+	}
+
+	decoded, err := runtime.Decode(codecs.UniversalDeserializer(), b)
+	if runtime.IsNotRegisteredError(err) {
+		return &unstructured.Unstructured{Object: obj}, nil
+	}
+	return decoded, err
+}
+
+// Write writes the objects of every task as YAML files into a directory per
+// task below dir.
+func Write(dir string, tasks []Task) error {
+	for _, t := range tasks {
+		taskDir := filepath.Join(dir, t.Name)
+		if err := os.MkdirAll(taskDir, 0755); err != nil {
+			return errors.Wrapf(err, "creating directory %s failed", taskDir)
+		}
+
+		for i, obj := range t.Objects {
+			b, name, err := marshal(obj)
+			if err != nil {
+				return errors.Wrapf(err, "marshalling object of task %s failed", t.Name)
+			}
+
+			// Secrets are only readable by the owner even though their
+			// content is redacted.
+			perm := os.FileMode(0644)
+			if _, ok := obj.(*v1.Secret); ok {
+				perm = 0600
+			}
+
+			p := filepath.Join(taskDir, fmt.Sprintf("%02d-%s.yaml", i, name))
+			if err := ioutil.WriteFile(p, b, perm); err != nil {
+				return errors.Wrapf(err, "writing %s failed", p)
+			}
+		}
+	}
+	return nil
+}
+
+// marshal returns the YAML encoding of the object and a file name derived
+// from its kind, namespace and name.
+func marshal(obj runtime.Object) ([]byte, string, error) {
+	if err := setGroupVersionKind(obj); err != nil {
+		return nil, "", err
+	}
+
+	accessor, err := meta.Accessor(obj)
+	if err != nil {
+		return nil, "", err
+	}
+
+	parts := []string{strings.ToLower(obj.GetObjectKind().GroupVersionKind().Kind)}
+	if ns := accessor.GetNamespace(); ns != "" {
+		parts = append(parts, ns)
+	}
+	parts = append(parts, accessor.GetName())
+
+	b, err := yaml.Marshal(obj)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return b, strings.Join(parts, "_"), nil
+}
+
+// setGroupVersionKind sets the type information of objects which were not
+// decoded from an asset and therefore lack it.
+func setGroupVersionKind(obj runtime.Object) error {
+	if !obj.GetObjectKind().GroupVersionKind().Empty() {
+		return nil
+	}
+
+	gvks, _, err := scheme.ObjectKinds(obj)
+	if err != nil {
+		return err
+	}
+	obj.GetObjectKind().SetGroupVersionKind(gvks[0])
+	return nil
+}
@@ -0,0 +1,418 @@
+// Copyright 2020 The Cluster Monitoring Operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package render
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"io/ioutil"
+	"math/big"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	monv1 "github.com/coreos/prometheus-operator/pkg/apis/monitoring/v1"
+	routev1 "github.com/openshift/api/route/v1"
+	appsv1 "k8s.io/api/apps/v1"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/rest"
+
+	"github.com/openshift/cluster-monitoring-operator/pkg/manifests"
+	"github.com/openshift/cluster-monitoring-operator/pkg/operator"
+)
+
+func renderTasks(t *testing.T, config string, objs ...runtime.Object) map[string]Task {
+	t.Helper()
+
+	c, err := manifests.NewConfigFromString(config)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cluster := &ClusterContext{
+		ClusterID:  "123",
+		AppsDomain: "apps.example.com",
+		Objects:    objs,
+	}
+
+	tasks, err := New("openshift-monitoring", "openshift-user-workload-monitoring", c, cluster, nil, nil, false).Render()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	res := make(map[string]Task, len(tasks))
+	for _, task := range tasks {
+		res[task.Name] = task
+	}
+	return res
+}
+
+func TestRenderDefaults(t *testing.T) {
+	tasks := renderTasks(t, "")
+
+	if len(tasks) != 17 {
+		t.Fatalf("expected 17 tasks, got %d", len(tasks))
+	}
+
+	for _, name := range []string{
+		"user-workload-prometheus-operator",
+		"prometheus-user-workload",
+		"user-workload-thanos-ruler",
+	} {
+		if n := len(tasks[name].Objects); n != 0 {
+			t.Errorf("expected no objects for disabled task %q, got %d", name, n)
+		}
+	}
+
+	var prometheus *monv1.Prometheus
+	for _, obj := range tasks["prometheus-k8s"].Objects {
+		if p, ok := obj.(*monv1.Prometheus); ok {
+			prometheus = p
+		}
+	}
+	if prometheus == nil {
+		t.Fatal("expected a Prometheus object")
+	}
+	if prometheus.Spec.ExternalURL != "https://prometheus-k8s-openshift-monitoring.apps.example.com/" {
+		t.Errorf("unexpected external URL %q", prometheus.Spec.ExternalURL)
+	}
+}
+
+func TestRenderUserWorkload(t *testing.T) {
+	tasks := renderTasks(t, "enableUserWorkload: true")
+
+	for _, name := range []string{
+		"user-workload-prometheus-operator",
+		"prometheus-user-workload",
+		"user-workload-thanos-ruler",
+	} {
+		if len(tasks[name].Objects) == 0 {
+			t.Errorf("expected objects for enabled task %q", name)
+		}
+	}
+}
+
+func TestRenderManagementStates(t *testing.T) {
+	// The objects of unmanaged components exist already.
+	tasks := renderTasks(t, `grafana:
+  managementState: Unmanaged
+openshiftStateMetrics:
+  managementState: Removed
+`, &routev1.Route{ObjectMeta: metav1.ObjectMeta{Name: "grafana", Namespace: "openshift-monitoring"}})
+
+	for _, name := range []string{"grafana", "grafana-datasources-secret", "openshift-state-metrics"} {
+		if n := len(tasks[name].Objects); n != 0 {
+			t.Errorf("expected no objects for task %q, got %d", name, n)
+		}
+	}
+	if len(tasks["alertmanager"].Objects) == 0 {
+		t.Error("expected objects for managed task \"alertmanager\"")
+	}
+}
+
+func TestWrite(t *testing.T) {
+	tasks := renderTasks(t, "")
+
+	dir, err := ioutil.TempDir("", "render")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	if err := Write(dir, []Task{tasks["node-exporter"]}); err != nil {
+		t.Fatal(err)
+	}
+
+	files, err := ioutil.ReadDir(filepath.Join(dir, "node-exporter"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(files) != len(tasks["node-exporter"].Objects) {
+		t.Fatalf("expected %d files, got %d", len(tasks["node-exporter"].Objects), len(files))
+	}
+
+	b, err := ioutil.ReadFile(filepath.Join(dir, "node-exporter", files[0].Name()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(b), "kind: SecurityContextConstraints") {
+		t.Errorf("expected kind in %s, got:\n%s", files[0].Name(), b)
+	}
+
+	if err := Write(dir, []Task{tasks["grafana"]}); err != nil {
+		t.Fatal(err)
+	}
+	files, err = ioutil.ReadDir(filepath.Join(dir, "grafana"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, f := range files {
+		if strings.Contains(f.Name(), "-secret_") && f.Mode().Perm() != 0600 {
+			t.Errorf("expected secret %s to be only readable by the owner, got %v", f.Name(), f.Mode().Perm())
+		}
+	}
+}
+
+// objectID identifies an object by kind, namespace and name. The hash of
+// hashed objects is left out as it differs between runs for generated
+// secrets.
+func objectID(t *testing.T, obj runtime.Object) string {
+	t.Helper()
+
+	if err := setGroupVersionKind(obj); err != nil {
+		t.Fatal(err)
+	}
+	m, err := meta.Accessor(obj)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	name := m.GetName()
+	if hash := m.GetLabels()["monitoring.openshift.io/hash"]; hash != "" {
+		name = strings.TrimSuffix(name, hash) + "<hash>"
+	}
+	return strings.Join([]string{obj.GetObjectKind().GroupVersionKind().Kind, m.GetNamespace(), name}, "/")
+}
+
+// TestRenderMatchesTasks compares the rendered objects with the objects the
+// operator applies when it runs its task graph in dry-run mode.
+func TestRenderMatchesTasks(t *testing.T) {
+	const config = "enableUserWorkload: true"
+
+	var rendered []string
+	for _, task := range renderTasks(t, config) {
+		for _, obj := range task.Objects {
+			rendered = append(rendered, objectID(t, obj))
+		}
+	}
+	sort.Strings(rendered)
+
+	c, err := manifests.NewConfigFromString(config)
+	if err != nil {
+		t.Fatal(err)
+	}
+	api, err := newAPIServer(&ClusterContext{ClusterID: "123", AppsDomain: "apps.example.com"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	o, err := operator.New(
+		&rest.Config{Host: "https://api.render.invalid", Transport: api, QPS: -1}, "",
+		"openshift-monitoring", "openshift-user-workload-monitoring", namespaceSelector,
+		"cluster-monitoring-config", "user-workload-monitoring-config", false, nil, nil,
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, _, err := o.DryRun(context.Background(), c); err != nil {
+		t.Fatal(err)
+	}
+
+	var applied []string
+	for _, key := range api.takeWritten() {
+		if obj, ok := api.renderedObject(key); ok {
+			applied = append(applied, objectID(t, &unstructured.Unstructured{Object: obj}))
+		}
+	}
+	sort.Strings(applied)
+
+	if strings.Join(rendered, "\n") != strings.Join(applied, "\n") {
+		t.Fatalf("expected the rendered objects to match the applied ones\nrendered:\n%s\napplied:\n%s", strings.Join(rendered, "\n"), strings.Join(applied, "\n"))
+	}
+}
+
+func TestRenderSecretsHash(t *testing.T) {
+	tasks := renderTasks(t, "")
+
+	for _, tc := range []struct {
+		task, name string
+	}{
+		{task: "prometheus-k8s", name: "k8s"},
+		{task: "alertmanager", name: "main"},
+		{task: "thanos-querier", name: "thanos-querier"},
+		{task: "grafana", name: "grafana"},
+	} {
+		var annotations map[string]string
+		for _, obj := range tasks[tc.task].Objects {
+			switch o := obj.(type) {
+			case *monv1.Prometheus:
+				if o.Name == tc.name && o.Spec.PodMetadata != nil {
+					annotations = o.Spec.PodMetadata.Annotations
+				}
+			case *monv1.Alertmanager:
+				if o.Name == tc.name && o.Spec.PodMetadata != nil {
+					annotations = o.Spec.PodMetadata.Annotations
+				}
+			case *appsv1.Deployment:
+				if o.Name == tc.name {
+					annotations = o.Spec.Template.Annotations
+				}
+			}
+		}
+		if annotations[manifests.SecretsHashAnnotation] != redacted {
+			t.Errorf("expected the pods of %s/%s to be annotated with the redacted hash of the generated secrets, got %v", tc.task, tc.name, annotations)
+		}
+	}
+}
+
+// grpcCASecret returns a kubernetes.io/tls secret holding a CA valid from
+// notBefore to notAfter.
+func grpcCASecret(t *testing.T, notBefore, notAfter time.Time) *v1.Secret {
+	t.Helper()
+
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "render-test"},
+		NotBefore:             notBefore,
+		NotAfter:              notAfter,
+		KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageDigitalSignature,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return &v1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "grpc-ca", Namespace: "openshift-monitoring"},
+		Type:       v1.SecretTypeTLS,
+		Data: map[string][]byte{
+			v1.TLSCertKey:       pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}),
+			v1.TLSPrivateKeyKey: pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(key)}),
+		},
+	}
+}
+
+func TestRenderGRPCCASecret(t *testing.T) {
+	now := time.Now()
+
+	for _, tc := range []struct {
+		name string
+		ca   *v1.Secret
+	}{
+		{
+			name: "valid CA",
+			ca:   grpcCASecret(t, now.Add(-time.Hour), now.Add(24*365*time.Hour)),
+		},
+		{
+			// The certificates are still issued and the tasks depending
+			// on them run.
+			name: "expiring CA",
+			ca:   grpcCASecret(t, now.Add(-9*time.Hour), now.Add(time.Hour)),
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			tasks := renderTasks(t, `grpcTLS:
+  caSecret: grpc-ca
+`, tc.ca)
+
+			var found bool
+			for _, obj := range tasks["cluster-monitoring-operator"].Objects {
+				s, ok := obj.(*v1.Secret)
+				if !ok || s.Name != "grpc-tls" {
+					continue
+				}
+				found = true
+				if string(s.Data["ca.crt"]) != redacted {
+					t.Fatalf("expected the CA bundle to be redacted, got:\n%s", s.Data["ca.crt"])
+				}
+			}
+			if !found {
+				t.Fatal("expected the grpc-tls secret")
+			}
+
+			for _, task := range []string{"prometheus-k8s", "thanos-querier"} {
+				if len(tasks[task].Objects) == 0 {
+					t.Errorf("expected task %s to be rendered", task)
+				}
+			}
+		})
+	}
+}
+
+// marshalTasks returns the YAML encoding of the objects of every task.
+func marshalTasks(t *testing.T, tasks map[string]Task) string {
+	t.Helper()
+
+	names := make([]string, 0, len(tasks))
+	for name := range tasks {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var buf bytes.Buffer
+	for _, name := range names {
+		for _, obj := range tasks[name].Objects {
+			b, _, err := marshal(obj)
+			if err != nil {
+				t.Fatal(err)
+			}
+			buf.WriteString("---\n")
+			buf.Write(b)
+		}
+	}
+	return buf.String()
+}
+
+func TestRenderDeterministic(t *testing.T) {
+	const config = "enableUserWorkload: true"
+
+	first := marshalTasks(t, renderTasks(t, config))
+	second := marshalTasks(t, renderTasks(t, config))
+	if first != second {
+		a, b := strings.Split(first, "\n"), strings.Split(second, "\n")
+		for i := range a {
+			if i >= len(b) || a[i] != b[i] {
+				t.Fatalf("expected the same output for the same input, line %d differs:\n%s", i+1, a[i])
+			}
+		}
+		t.Fatal("expected the same output for the same input")
+	}
+}
+
+func TestRenderRedactsSecrets(t *testing.T) {
+	for _, task := range renderTasks(t, "enableUserWorkload: true") {
+		for _, obj := range task.Objects {
+			s, ok := obj.(*v1.Secret)
+			if !ok {
+				continue
+			}
+			for k, v := range s.Data {
+				if strings.HasSuffix(k, ".key") {
+					t.Errorf("expected the private key %s of secret %s/%s to be left out", k, s.Namespace, s.Name)
+				}
+				if string(v) != redacted {
+					t.Errorf("expected key %s of secret %s/%s to be redacted, got %q", k, s.Namespace, s.Name, v)
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,191 @@
+// Copyright 2020 The Cluster Monitoring Operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/cache"
+	authenticationclient "k8s.io/client-go/kubernetes/typed/authentication/v1"
+	authorizationclient "k8s.io/client-go/kubernetes/typed/authorization/v1"
+	"k8s.io/klog"
+)
+
+// maxCacheEntries bounds the number of cached reviews of each kind. The least
+// recently used entries are evicted once it is reached, even if they haven't
+// expired yet.
+const maxCacheEntries = 1024
+
+// delegatingAuth authenticates requests with a TokenReview of their bearer
+// token and authorizes them with a SubjectAccessReview of their path and
+// verb, both issued against the API server. Review results are cached.
+type delegatingAuth struct {
+	tokenReviews authenticationclient.TokenReviewInterface
+	sars         authorizationclient.SubjectAccessReviewInterface
+	ttl          time.Duration
+
+	// authn caches the *authenticationv1.UserInfo of token hashes, nil for
+	// invalid tokens, and authz the bool decisions of authorization keys.
+	authn *cache.LRUExpireCache
+	authz *cache.LRUExpireCache
+}
+
+func newDelegatingAuth(tokenReviews authenticationclient.TokenReviewInterface, sars authorizationclient.SubjectAccessReviewInterface, ttl time.Duration) *delegatingAuth {
+	return &delegatingAuth{
+		tokenReviews: tokenReviews,
+		sars:         sars,
+		ttl:          ttl,
+		authn:        cache.NewLRUExpireCache(maxCacheEntries),
+		authz:        cache.NewLRUExpireCache(maxCacheEntries),
+	}
+}
+
+// wrap returns a handler passing authorized requests on to next.
+func (a *delegatingAuth) wrap(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		token := bearerToken(req)
+		if token == "" {
+			http.Error(w, "Unauthorized", http.StatusUnauthorized)
+			return
+		}
+
+		user, err := a.authenticate(req.Context(), token)
+		if err != nil {
+			klog.Errorf("Authenticating request failed: %v", err)
+			http.Error(w, "Internal Server Error", http.StatusInternalServerError)
+			return
+		}
+		if user == nil {
+			http.Error(w, "Unauthorized", http.StatusUnauthorized)
+			return
+		}
+
+		verb := strings.ToLower(req.Method)
+		allowed, err := a.authorize(req.Context(), user, verb, req.URL.Path)
+		if err != nil {
+			klog.Errorf("Authorizing request failed: %v", err)
+			http.Error(w, "Internal Server Error", http.StatusInternalServerError)
+			return
+		}
+		if !allowed {
+			klog.V(4).Infof("Forbidden %s %s for user %q", verb, req.URL.Path, user.Username)
+			http.Error(w, "Forbidden", http.StatusForbidden)
+			return
+		}
+
+		next.ServeHTTP(w, req)
+	})
+}
+
+// authenticate returns the user owning token or nil if the token isn't
+// valid.
+func (a *delegatingAuth) authenticate(ctx context.Context, token string) (*authenticationv1.UserInfo, error) {
+	sum := sha256.Sum256([]byte(token))
+	key := hex.EncodeToString(sum[:])
+
+	if user, ok := a.authn.Get(key); ok {
+		return user.(*authenticationv1.UserInfo), nil
+	}
+
+	tr, err := a.tokenReviews.Create(ctx, &authenticationv1.TokenReview{
+		Spec: authenticationv1.TokenReviewSpec{Token: token},
+	}, metav1.CreateOptions{})
+	if err != nil {
+		return nil, errors.Wrap(err, "creating TokenReview failed")
+	}
+
+	var user *authenticationv1.UserInfo
+	if tr.Status.Authenticated {
+		user = &tr.Status.User
+	}
+
+	a.authn.Add(key, user, a.ttl)
+
+	return user, nil
+}
+
+// authorize returns whether user may access the non-resource path with the
+// given verb.
+func (a *delegatingAuth) authorize(ctx context.Context, user *authenticationv1.UserInfo, verb, path string) (bool, error) {
+	key := authzKey(user, verb, path)
+	if allowed, ok := a.authz.Get(key); ok {
+		return allowed.(bool), nil
+	}
+
+	extra := make(map[string]authorizationv1.ExtraValue, len(user.Extra))
+	for k, v := range user.Extra {
+		extra[k] = authorizationv1.ExtraValue(v)
+	}
+	sar, err := a.sars.Create(ctx, &authorizationv1.SubjectAccessReview{
+		Spec: authorizationv1.SubjectAccessReviewSpec{
+			User:   user.Username,
+			UID:    user.UID,
+			Groups: user.Groups,
+			Extra:  extra,
+			NonResourceAttributes: &authorizationv1.NonResourceAttributes{
+				Path: path,
+				Verb: verb,
+			},
+		},
+	}, metav1.CreateOptions{})
+	if err != nil {
+		return false, errors.Wrap(err, "creating SubjectAccessReview failed")
+	}
+
+	a.authz.Add(key, sar.Status.Allowed, a.ttl)
+
+	return sar.Status.Allowed, nil
+}
+
+// authzKey returns the key of the authorization cache. It holds every
+// attribute of the SubjectAccessReview, e.g. the scopes of OAuth tokens are
+// passed as extra attributes of the user.
+func authzKey(user *authenticationv1.UserInfo, verb, path string) string {
+	groups := append([]string(nil), user.Groups...)
+	sort.Strings(groups)
+
+	extraKeys := make([]string, 0, len(user.Extra))
+	for k := range user.Extra {
+		extraKeys = append(extraKeys, k)
+	}
+	sort.Strings(extraKeys)
+	extra := make([]string, 0, len(extraKeys))
+	for _, k := range extraKeys {
+		values := append([]string(nil), user.Extra[k]...)
+		sort.Strings(values)
+		extra = append(extra, k+"="+strings.Join(values, "\x02"))
+	}
+
+	return strings.Join([]string{user.Username, user.UID, strings.Join(groups, "\x01"), strings.Join(extra, "\x01"), verb, path}, "\x00")
+}
+
+// bearerToken returns the bearer token of the Authorization header of req.
+func bearerToken(req *http.Request) string {
+	parts := strings.SplitN(req.Header.Get("Authorization"), " ", 2)
+	if len(parts) != 2 || !strings.EqualFold(parts[0], "bearer") {
+		return ""
+	}
+	return strings.TrimSpace(parts[1])
+}
@@ -0,0 +1,220 @@
+// Copyright 2020 The Cluster Monitoring Operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	authenticationv1 "k8s.io/api/authentication/v1"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+)
+
+// fakeAPIServer answers TokenReviews for the "valid" token of user
+// "prometheus", the "scoped" token of the same user limited to the
+// "user:info" scope, and SubjectAccessReviews allowing the unscoped user to
+// get /metrics. It counts the reviews it received.
+func fakeAPIServer(t *testing.T, reviews *int) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		*reviews++
+		w.Header().Set("Content-Type", "application/json")
+
+		switch req.URL.Path {
+		case "/apis/authentication.k8s.io/v1/tokenreviews":
+			var tr authenticationv1.TokenReview
+			if err := json.NewDecoder(req.Body).Decode(&tr); err != nil {
+				t.Error(err)
+				return
+			}
+			switch tr.Spec.Token {
+			case "valid":
+				tr.Status.Authenticated = true
+				tr.Status.User = authenticationv1.UserInfo{Username: "prometheus", Groups: []string{"b", "a"}}
+			case "scoped":
+				tr.Status.Authenticated = true
+				tr.Status.User = authenticationv1.UserInfo{
+					Username: "prometheus",
+					Groups:   []string{"b", "a"},
+					Extra:    map[string]authenticationv1.ExtraValue{"scopes.authorization.openshift.io": {"user:info"}},
+				}
+			}
+			json.NewEncoder(w).Encode(tr)
+
+		case "/apis/authorization.k8s.io/v1/subjectaccessreviews":
+			var sar authorizationv1.SubjectAccessReview
+			if err := json.NewDecoder(req.Body).Decode(&sar); err != nil {
+				t.Error(err)
+				return
+			}
+			attrs := sar.Spec.NonResourceAttributes
+			sar.Status.Allowed = sar.Spec.User == "prometheus" && len(sar.Spec.Extra) == 0 && attrs != nil && attrs.Path == "/metrics" && attrs.Verb == "get"
+			json.NewEncoder(w).Encode(sar)
+
+		default:
+			t.Errorf("unexpected request %s %s", req.Method, req.URL.Path)
+		}
+	}))
+}
+
+func TestDelegatingAuth(t *testing.T) {
+	var reviews int
+	apiServer := fakeAPIServer(t, &reviews)
+	defer apiServer.Close()
+
+	kclient, err := kubernetes.NewForConfig(&rest.Config{Host: apiServer.URL})
+	if err != nil {
+		t.Fatal(err)
+	}
+	auth := newDelegatingAuth(kclient.AuthenticationV1().TokenReviews(), kclient.AuthorizationV1().SubjectAccessReviews(), time.Minute)
+	handler := auth.wrap(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {}))
+
+	for _, tc := range []struct {
+		name          string
+		authorization string
+		path          string
+		code          int
+		reviews       int
+	}{
+		{name: "no token", path: "/metrics", code: http.StatusUnauthorized},
+		{name: "basic auth", authorization: "Basic Zm9vOmJhcg==", path: "/metrics", code: http.StatusUnauthorized},
+		{name: "invalid token", authorization: "Bearer invalid", path: "/metrics", code: http.StatusUnauthorized, reviews: 1},
+		{name: "forbidden path", authorization: "Bearer valid", path: "/debug/pprof/", code: http.StatusForbidden, reviews: 2},
+		{name: "allowed", authorization: "Bearer valid", path: "/metrics", code: http.StatusOK, reviews: 1},
+		{name: "cached", authorization: "Bearer valid", path: "/metrics", code: http.StatusOK},
+		{name: "cached invalid token", authorization: "Bearer invalid", path: "/metrics", code: http.StatusUnauthorized},
+		{name: "scoped token", authorization: "Bearer scoped", path: "/metrics", code: http.StatusForbidden, reviews: 2},
+		{name: "cached scoped token", authorization: "Bearer scoped", path: "/metrics", code: http.StatusForbidden},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			reviews = 0
+			req := httptest.NewRequest(http.MethodGet, tc.path, nil)
+			if tc.authorization != "" {
+				req.Header.Set("Authorization", tc.authorization)
+			}
+
+			rec := httptest.NewRecorder()
+			handler.ServeHTTP(rec, req)
+
+			if rec.Code != tc.code {
+				t.Fatalf("expected status code %d, got %d", tc.code, rec.Code)
+			}
+			if reviews != tc.reviews {
+				t.Fatalf("expected %d reviews, got %d", tc.reviews, reviews)
+			}
+		})
+	}
+}
+
+func TestDelegatingAuthCacheSize(t *testing.T) {
+	var reviews int
+	apiServer := fakeAPIServer(t, &reviews)
+	defer apiServer.Close()
+
+	kclient, err := kubernetes.NewForConfig(&rest.Config{Host: apiServer.URL, QPS: -1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	auth := newDelegatingAuth(kclient.AuthenticationV1().TokenReviews(), kclient.AuthorizationV1().SubjectAccessReviews(), time.Hour)
+	handler := auth.wrap(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {}))
+
+	request := func(token string) {
+		req := httptest.NewRequest(http.MethodGet, "/metrics", nil)
+		req.Header.Set("Authorization", "Bearer "+token)
+		handler.ServeHTTP(httptest.NewRecorder(), req)
+	}
+
+	// None of the cached reviews expire during the test.
+	for i := 0; i < maxCacheEntries+10; i++ {
+		request(fmt.Sprintf("invalid-%d", i))
+	}
+	if n := len(auth.authn.Keys()); n != maxCacheEntries {
+		t.Fatalf("expected %d cached reviews, got %d", maxCacheEntries, n)
+	}
+
+	reviews = 0
+	request(fmt.Sprintf("invalid-%d", maxCacheEntries+9))
+	if reviews != 0 {
+		t.Fatalf("expected the most recent token to be cached, got %d reviews", reviews)
+	}
+	request("invalid-0")
+	if reviews != 1 {
+		t.Fatalf("expected the least recent token to be evicted, got %d reviews", reviews)
+	}
+}
+
+func TestDelegatingAuthError(t *testing.T) {
+	apiServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		http.Error(w, "unavailable", http.StatusServiceUnavailable)
+	}))
+	defer apiServer.Close()
+
+	kclient, err := kubernetes.NewForConfig(&rest.Config{Host: apiServer.URL})
+	if err != nil {
+		t.Fatal(err)
+	}
+	auth := newDelegatingAuth(kclient.AuthenticationV1().TokenReviews(), kclient.AuthorizationV1().SubjectAccessReviews(), time.Minute)
+
+	req := httptest.NewRequest(http.MethodGet, "/metrics", nil)
+	req.Header.Set("Authorization", "Bearer valid")
+	rec := httptest.NewRecorder()
+	auth.wrap(http.NotFoundHandler()).ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusInternalServerError {
+		t.Fatalf("expected status code %d, got %d", http.StatusInternalServerError, rec.Code)
+	}
+}
+
+func TestAuthzKey(t *testing.T) {
+	user := func(groups []string, extra map[string]authenticationv1.ExtraValue) *authenticationv1.UserInfo {
+		return &authenticationv1.UserInfo{Username: "prometheus", Groups: groups, Extra: extra}
+	}
+	key := authzKey(user([]string{"a", "b"}, map[string]authenticationv1.ExtraValue{"x": {"1", "2"}, "y": {"3"}}), "get", "/metrics")
+
+	for _, tc := range []struct {
+		name  string
+		user  *authenticationv1.UserInfo
+		equal bool
+	}{
+		{
+			name:  "reordered",
+			user:  user([]string{"b", "a"}, map[string]authenticationv1.ExtraValue{"y": {"3"}, "x": {"2", "1"}}),
+			equal: true,
+		},
+		{
+			name: "no extra",
+			user: user([]string{"a", "b"}, nil),
+		},
+		{
+			name: "other extra value",
+			user: user([]string{"a", "b"}, map[string]authenticationv1.ExtraValue{"x": {"1", "2"}, "y": {"4"}}),
+		},
+		{
+			name: "extra values moved to another key",
+			user: user([]string{"a", "b"}, map[string]authenticationv1.ExtraValue{"x": {"1"}, "y": {"2", "3"}}),
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := authzKey(tc.user, "get", "/metrics"); (got == key) != tc.equal {
+				t.Fatalf("expected the keys to be equal: %v, got %q and %q", tc.equal, key, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,109 @@
+// Copyright 2020 The Cluster Monitoring Operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"io/ioutil"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"k8s.io/klog"
+)
+
+// certReloader serves a certificate read from files and reloads it when the
+// files change, e.g. when the serving-cert Secret they are mounted from is
+// rotated.
+type certReloader struct {
+	certFile, keyFile string
+
+	mtx             sync.RWMutex
+	cert            *tls.Certificate
+	certPEM, keyPEM []byte
+}
+
+func newCertReloader(certFile, keyFile string) *certReloader {
+	return &certReloader{certFile: certFile, keyFile: keyFile}
+}
+
+// load reads the certificate and key files and returns whether they
+// changed since the last call. The previous certificate is kept on errors.
+func (r *certReloader) load() (bool, error) {
+	certPEM, err := ioutil.ReadFile(r.certFile)
+	if err != nil {
+		return false, errors.Wrap(err, "reading certificate failed")
+	}
+	keyPEM, err := ioutil.ReadFile(r.keyFile)
+	if err != nil {
+		return false, errors.Wrap(err, "reading private key failed")
+	}
+
+	r.mtx.RLock()
+	unchanged := bytes.Equal(certPEM, r.certPEM) && bytes.Equal(keyPEM, r.keyPEM)
+	r.mtx.RUnlock()
+	if unchanged {
+		return false, nil
+	}
+
+	// The files are updated one after the other, they don't match while
+	// the rotation is in progress.
+	cert, err := tls.X509KeyPair(certPEM, keyPEM)
+	if err != nil {
+		return false, errors.Wrap(err, "parsing certificate and key failed")
+	}
+
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+	r.cert, r.certPEM, r.keyPEM = &cert, certPEM, keyPEM
+
+	return true, nil
+}
+
+// run reloads the certificate every interval until ctx is done.
+func (r *certReloader) run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		changed, err := r.load()
+		if err != nil {
+			klog.Warningf("Reloading serving certificate failed: %v", err)
+			continue
+		}
+		if changed {
+			klog.Infof("Reloaded serving certificate from %s", r.certFile)
+		}
+	}
+}
+
+// GetCertificate implements tls.Config.GetCertificate.
+func (r *certReloader) GetCertificate(*tls.ClientHelloInfo) (*tls.Certificate, error) {
+	r.mtx.RLock()
+	defer r.mtx.RUnlock()
+
+	if r.cert == nil {
+		return nil, errors.New("no serving certificate loaded")
+	}
+	return r.cert, nil
+}
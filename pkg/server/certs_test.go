@@ -0,0 +1,126 @@
+// Copyright 2020 The Cluster Monitoring Operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"io/ioutil"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+// writeCert writes a self-signed certificate for cn and its key to dir.
+func writeCert(t *testing.T, dir, cn string) (string, string) {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: cn},
+		NotBefore:    time.Now(),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	certFile, keyFile := filepath.Join(dir, "tls.crt"), filepath.Join(dir, "tls.key")
+	if err := ioutil.WriteFile(certFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if err := ioutil.WriteFile(keyFile, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}), 0600); err != nil {
+		t.Fatal(err)
+	}
+	return certFile, keyFile
+}
+
+func commonName(t *testing.T, r *certReloader) string {
+	t.Helper()
+
+	cert, err := r.GetCertificate(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	parsed, err := x509.ParseCertificate(cert.Certificate[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+	return parsed.Subject.CommonName
+}
+
+func TestCertReloader(t *testing.T) {
+	dir, err := ioutil.TempDir("", "certs")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	r := newCertReloader(filepath.Join(dir, "tls.crt"), filepath.Join(dir, "tls.key"))
+	if _, err := r.load(); err == nil {
+		t.Fatal("expected an error for missing files")
+	}
+	if _, err := r.GetCertificate(nil); err == nil {
+		t.Fatal("expected an error before the certificate was loaded")
+	}
+
+	writeCert(t, dir, "first")
+	if changed, err := r.load(); err != nil || !changed {
+		t.Fatalf("expected the certificate to be loaded, got changed %v and error %v", changed, err)
+	}
+	if changed, err := r.load(); err != nil || changed {
+		t.Fatalf("expected the certificate to be unchanged, got changed %v and error %v", changed, err)
+	}
+
+	// A certificate not matching the key is rejected and the previous one
+	// kept.
+	keyPEM, err := ioutil.ReadFile(filepath.Join(dir, "tls.key"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	writeCert(t, dir, "second")
+	if err := ioutil.WriteFile(filepath.Join(dir, "tls.key"), keyPEM, 0600); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := r.load(); err == nil {
+		t.Fatal("expected an error for a mismatching key")
+	}
+	if cn := commonName(t, r); cn != "first" {
+		t.Fatalf("expected the first certificate to be kept, got %q", cn)
+	}
+
+	writeCert(t, dir, "third")
+	if changed, err := r.load(); err != nil || !changed {
+		t.Fatalf("expected the certificate to be reloaded, got changed %v and error %v", changed, err)
+	}
+	if cn := commonName(t, r); cn != "third" {
+		t.Fatalf("expected the third certificate, got %q", cn)
+	}
+}
@@ -0,0 +1,129 @@
+// Copyright 2020 The Cluster Monitoring Operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package server serves HTTP endpoints of the operator over TLS to clients
+// authenticated and authorized by the API server.
+package server
+
+import (
+	"context"
+	"crypto/tls"
+	"net"
+	"net/http"
+	"time"
+
+	"github.com/pkg/errors"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/klog"
+)
+
+const (
+	// certReloadInterval is how often the certificate files are checked for
+	// changes.
+	certReloadInterval = 30 * time.Second
+	// reviewCacheTTL is how long TokenReview and SubjectAccessReview
+	// results are cached.
+	reviewCacheTTL = 10 * time.Second
+)
+
+// Config configures a Server.
+type Config struct {
+	ListenAddress string
+	CertFile      string
+	KeyFile       string
+	// CipherSuites are the names of the TLS 1.2 cipher suites to accept,
+	// Go's defaults are used if empty.
+	CipherSuites []string
+}
+
+// Server serves a handler over TLS. Every request must carry a bearer token
+// of a user allowed to access the request path as a non-resource URL.
+type Server struct {
+	config  Config
+	tls     *tls.Config
+	certs   *certReloader
+	handler http.Handler
+}
+
+// New returns a server for handler. The certificate doesn't have to exist
+// yet, connections fail until it is loaded.
+func New(config Config, kclient kubernetes.Interface, handler http.Handler) (*Server, error) {
+	suites, err := cipherSuites(config.CipherSuites)
+	if err != nil {
+		return nil, err
+	}
+
+	certs := newCertReloader(config.CertFile, config.KeyFile)
+	if _, err := certs.load(); err != nil {
+		klog.Warningf("Loading serving certificate failed, retrying every %s: %v", certReloadInterval, err)
+	}
+
+	auth := newDelegatingAuth(kclient.AuthenticationV1().TokenReviews(), kclient.AuthorizationV1().SubjectAccessReviews(), reviewCacheTTL)
+
+	return &Server{
+		config: config,
+		tls: &tls.Config{
+			MinVersion:     tls.VersionTLS12,
+			CipherSuites:   suites,
+			GetCertificate: certs.GetCertificate,
+		},
+		certs:   certs,
+		handler: auth.wrap(handler),
+	}, nil
+}
+
+// Run serves requests until ctx is done.
+func (s *Server) Run(ctx context.Context) error {
+	l, err := net.Listen("tcp", s.config.ListenAddress)
+	if err != nil {
+		return errors.Wrapf(err, "listening on %s failed", s.config.ListenAddress)
+	}
+
+	go s.certs.run(ctx, certReloadInterval)
+
+	srv := &http.Server{Handler: s.handler}
+	errc := make(chan error, 1)
+	go func() { errc <- srv.Serve(tls.NewListener(l, s.tls)) }()
+
+	select {
+	case err := <-errc:
+		return errors.Wrapf(err, "serving on %s failed", s.config.ListenAddress)
+	case <-ctx.Done():
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		return srv.Shutdown(shutdownCtx)
+	}
+}
+
+// cipherSuites returns the IDs of the named cipher suites.
+func cipherSuites(names []string) ([]uint16, error) {
+	if len(names) == 0 {
+		return nil, nil
+	}
+
+	ids := map[string]uint16{}
+	for _, s := range tls.CipherSuites() {
+		ids[s.Name] = s.ID
+	}
+
+	suites := make([]uint16, 0, len(names))
+	for _, n := range names {
+		id, ok := ids[n]
+		if !ok {
+			return nil, errors.Errorf("unsupported cipher suite %q", n)
+		}
+		suites = append(suites, id)
+	}
+	return suites, nil
+}
@@ -15,9 +15,12 @@
 package tasks
 
 import (
+	"context"
+
 	"github.com/openshift/cluster-monitoring-operator/pkg/client"
 	"github.com/openshift/cluster-monitoring-operator/pkg/manifests"
 	"github.com/pkg/errors"
+	v1 "k8s.io/api/core/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/klog"
@@ -26,40 +29,47 @@ import (
 type ClusterMonitoringOperatorTask struct {
 	client  *client.Client
 	factory *manifests.Factory
+	config  *manifests.Config
 }
 
-func NewClusterMonitoringOperatorTask(client *client.Client, factory *manifests.Factory) *ClusterMonitoringOperatorTask {
+func NewClusterMonitoringOperatorTask(client *client.Client, factory *manifests.Factory, config *manifests.Config) *ClusterMonitoringOperatorTask {
 	return &ClusterMonitoringOperatorTask{
 		client:  client,
 		factory: factory,
+		config:  config,
 	}
 }
 
-func (t *ClusterMonitoringOperatorTask) Run() error {
+func (t *ClusterMonitoringOperatorTask) Run(ctx context.Context) error {
 	svc, err := t.factory.ClusterMonitoringOperatorService()
 	if err != nil {
 		return errors.Wrap(err, "initializing Cluster Monitoring Operator Service failed")
 	}
 
-	err = t.client.CreateOrUpdateService(svc)
+	err = t.client.CreateOrUpdateService(ctx, svc)
 	if err != nil {
 		return errors.Wrap(err, "reconciling Cluster Monitoring Operator Service failed")
 	}
 
-	for name, crf := range map[string]func() (*rbacv1.ClusterRole, error){
-		"cluster-monitoring-view": t.factory.ClusterMonitoringClusterRole,
-		"monitoring-rules-edit":   t.factory.ClusterMonitoringRulesEditClusterRole,
-		"monitoring-rules-view":   t.factory.ClusterMonitoringRulesViewClusterRole,
-		"monitoring-edit":         t.factory.ClusterMonitoringEditClusterRole,
+	// The cluster roles are reconciled in a fixed order so that the
+	// rendered objects are the same on every run.
+	for _, cr := range []struct {
+		name string
+		crf  func() (*rbacv1.ClusterRole, error)
+	}{
+		{"cluster-monitoring-view", t.factory.ClusterMonitoringClusterRole},
+		{"monitoring-rules-edit", t.factory.ClusterMonitoringRulesEditClusterRole},
+		{"monitoring-rules-view", t.factory.ClusterMonitoringRulesViewClusterRole},
+		{"monitoring-edit", t.factory.ClusterMonitoringEditClusterRole},
 	} {
-		cr, err := crf()
+		obj, err := cr.crf()
 		if err != nil {
-			return errors.Wrapf(err, "initializing %s ClusterRole failed", name)
+			return errors.Wrapf(err, "initializing %s ClusterRole failed", cr.name)
 		}
 
-		err = t.client.CreateOrUpdateClusterRole(cr)
+		err = t.client.CreateOrUpdateClusterRole(ctx, obj)
 		if err != nil {
-			return errors.Wrapf(err, "reconciling %s ClusterRole failed", name)
+			return errors.Wrapf(err, "reconciling %s ClusterRole failed", cr.name)
 		}
 	}
 
@@ -68,7 +78,7 @@ func (t *ClusterMonitoringOperatorTask) Run() error {
 		return errors.Wrap(err, "initializing UserWorkloadConfigEdit Role failed")
 	}
 
-	err = t.client.CreateOrUpdateRole(uwcr)
+	err = t.client.CreateOrUpdateRole(ctx, uwcr)
 	if err != nil {
 		return errors.Wrap(err, "reconciling UserWorkloadConfigEdit Role failed")
 	}
@@ -78,7 +88,7 @@ func (t *ClusterMonitoringOperatorTask) Run() error {
 		return errors.Wrap(err, "initializing Cluster Monitoring Operator ServiceMonitor failed")
 	}
 
-	err = t.client.CreateOrUpdateServiceMonitor(smcmo)
+	err = t.client.CreateOrUpdateServiceMonitor(ctx, smcmo)
 	if err != nil {
 		return errors.Wrap(err, "reconciling Cluster Monitoring Operator ServiceMonitor failed")
 	}
@@ -88,7 +98,7 @@ func (t *ClusterMonitoringOperatorTask) Run() error {
 		return errors.Wrap(err, "error initializing Cluster Monitoring Operator GRPC TLS secret")
 	}
 
-	loaded, err := t.client.GetSecret(s.Namespace, s.Name)
+	loaded, err := t.client.GetSecret(ctx, s.Namespace, s.Name)
 	switch {
 	case apierrors.IsNotFound(err):
 		// No secret was found, proceed with the default empty secret from manifests.
@@ -101,15 +111,50 @@ func (t *ClusterMonitoringOperatorTask) Run() error {
 		return errors.Wrap(err, "error reading Cluster Monitoring Operator GRPC TLS secret")
 	}
 
-	err = manifests.RotateGRPCSecret(s)
-	if err != nil {
-		return errors.Wrap(err, "error rotating Cluster Monitoring Operator GRPC TLS secret")
+	_, forced := s.Annotations[manifests.GRPCTLSForcedRotationAnnotation]
+
+	var expiring *manifests.CAExpiringError
+	if caSecret := t.config.ClusterMonitoringConfiguration.GRPCTLSConfig.CASecret; caSecret != "" {
+		ca, err := t.client.GetSecret(ctx, s.Namespace, caSecret)
+		if err != nil {
+			return errors.Wrapf(err, "error reading Cluster Monitoring Operator GRPC TLS CA secret %s", caSecret)
+		}
+		issuer, err := manifests.NewCertificateIssuer(ca)
+		if err != nil {
+			return errors.Wrap(err, "invalid Cluster Monitoring Operator GRPC TLS CA")
+		}
+		err = manifests.IssueGRPCSecret(s, issuer)
+		if err != nil && !errors.As(err, &expiring) {
+			return errors.Wrap(err, "error issuing Cluster Monitoring Operator GRPC TLS certificates")
+		}
+	} else {
+		err = manifests.RotateGRPCSecret(s)
+		if err != nil {
+			return errors.Wrap(err, "error rotating Cluster Monitoring Operator GRPC TLS secret")
+		}
 	}
 
-	err = t.client.CreateOrUpdateSecret(s)
+	err = t.client.CreateOrUpdateSecret(ctx, s)
 	if err != nil {
 		return errors.Wrap(err, "error creating Cluster Monitoring Operator GRPC TLS secret")
 	}
 
+	// The annotation is set by the user, applying the secret without it
+	// doesn't remove it.
+	if forced {
+		err = t.client.RemoveSecretAnnotation(ctx, s.Namespace, s.Name, manifests.GRPCTLSForcedRotationAnnotation)
+		if err != nil {
+			return errors.Wrap(err, "error clearing Cluster Monitoring Operator GRPC TLS forced rotation")
+		}
+	}
+
+	// The certificates were issued, the CA must be replaced nevertheless.
+	// It is reported without failing the task as the components depending
+	// on the task can still use the certificates.
+	if expiring != nil {
+		klog.Warningf("Cluster Monitoring Operator GRPC TLS CA needs to be replaced: %v", expiring)
+		t.client.ClusterOperatorEventf(ctx, v1.EventTypeWarning, "GRPCTLSCAExpiring", "The GRPC TLS CA secret %s/%s needs to be replaced: %v", s.Namespace, t.config.ClusterMonitoringConfiguration.GRPCTLSConfig.CASecret, expiring)
+	}
+
 	return nil
 }
@@ -15,6 +15,8 @@
 package tasks
 
 import (
+	"context"
+
 	"github.com/openshift/cluster-monitoring-operator/pkg/client"
 	"github.com/openshift/cluster-monitoring-operator/pkg/manifests"
 	"github.com/pkg/errors"
@@ -32,13 +34,13 @@ func NewConfigSharingTask(client *client.Client, factory *manifests.Factory) *Co
 	}
 }
 
-func (t *ConfigSharingTask) Run() error {
+func (t *ConfigSharingTask) Run(ctx context.Context) error {
 	promRoute, err := t.factory.PrometheusK8sRoute()
 	if err != nil {
 		return errors.Wrap(err, "initializing Prometheus Route failed")
 	}
 
-	promURL, err := t.client.GetRouteURL(promRoute)
+	promURL, err := t.client.GetRouteURL(ctx, promRoute)
 	if err != nil {
 		return errors.Wrap(err, "failed to retrieve Prometheus host")
 	}
@@ -48,7 +50,7 @@ func (t *ConfigSharingTask) Run() error {
 		return errors.Wrap(err, "initializing Alertmanager Route failed")
 	}
 
-	amURL, err := t.client.GetRouteURL(amRoute)
+	amURL, err := t.client.GetRouteURL(ctx, amRoute)
 	if err != nil {
 		return errors.Wrap(err, "failed to retrieve Alertmanager host")
 	}
@@ -58,7 +60,7 @@ func (t *ConfigSharingTask) Run() error {
 		return errors.Wrap(err, "initializing Grafana Route failed")
 	}
 
-	grafanaURL, err := t.client.GetRouteURL(grafanaRoute)
+	grafanaURL, err := t.client.GetRouteURL(ctx, grafanaRoute)
 	if err != nil {
 		return errors.Wrap(err, "failed to retrieve Grafana host")
 	}
@@ -68,22 +70,23 @@ func (t *ConfigSharingTask) Run() error {
 		return errors.Wrap(err, "initializing Thanos Querier Route failed")
 	}
 
-	thanosURL, err := t.client.GetRouteURL(thanosRoute)
+	thanosURL, err := t.client.GetRouteURL(ctx, thanosRoute)
 	if err != nil {
 		return errors.Wrap(err, "failed to retrieve Thanos Querier host")
 	}
 
 	// TODO: remove in 4.7
 	// The sharing-config configmap isn't used anymore by the console in 4.6 and should be deleted if present.
+	// It predates the ownership labels, so it isn't pruned with the other stale objects.
 	cm := t.factory.SharingConfigDeprecated(promURL, amURL, grafanaURL, thanosURL)
-	err = t.client.DeleteConfigMap(cm)
+	err = t.client.DeleteConfigMap(ctx, cm)
 	if err != nil {
 		return errors.Wrapf(err, "failed to delete %s/%s ConfigMap", cm.Namespace, cm.Name)
 	}
 	// End of remove
 
 	cm = t.factory.SharingConfig(promURL, amURL, grafanaURL, thanosURL)
-	err = t.client.CreateOrUpdateConfigMap(cm)
+	err = t.client.CreateOrUpdateConfigMap(ctx, cm)
 	if err != nil {
 		return errors.Wrapf(err, "reconciling %s/%s Config ConfigMap failed", cm.Namespace, cm.Name)
 	}
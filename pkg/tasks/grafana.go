@@ -15,6 +15,8 @@
 package tasks
 
 import (
+	"context"
+
 	"github.com/openshift/cluster-monitoring-operator/pkg/client"
 	"github.com/openshift/cluster-monitoring-operator/pkg/manifests"
 	"github.com/pkg/errors"
@@ -32,13 +34,13 @@ func NewGrafanaTask(client *client.Client, factory *manifests.Factory) *GrafanaT
 	}
 }
 
-func (t *GrafanaTask) Run() error {
+func (t *GrafanaTask) Run(ctx context.Context) error {
 	cr, err := t.factory.GrafanaClusterRole()
 	if err != nil {
 		return errors.Wrap(err, "initializing Grafana ClusterRole failed")
 	}
 
-	err = t.client.CreateOrUpdateClusterRole(cr)
+	err = t.client.CreateOrUpdateClusterRole(ctx, cr)
 	if err != nil {
 		return errors.Wrap(err, "reconciling Grafana ClusterRole failed")
 	}
@@ -48,7 +50,7 @@ func (t *GrafanaTask) Run() error {
 		return errors.Wrap(err, "initializing Grafana ClusterRoleBinding failed")
 	}
 
-	err = t.client.CreateOrUpdateClusterRoleBinding(crb)
+	err = t.client.CreateOrUpdateClusterRoleBinding(ctx, crb)
 	if err != nil {
 		return errors.Wrap(err, "reconciling Grafana ClusterRoleBinding failed")
 	}
@@ -58,12 +60,12 @@ func (t *GrafanaTask) Run() error {
 		return errors.Wrap(err, "initializing Grafana Route failed")
 	}
 
-	err = t.client.CreateRouteIfNotExists(r)
+	err = t.client.CreateRouteIfNotExists(ctx, r)
 	if err != nil {
 		return errors.Wrap(err, "creating Grafana Route failed")
 	}
 
-	_, err = t.client.WaitForRouteReady(r)
+	_, err = t.client.WaitForRouteReady(ctx, r)
 	if err != nil {
 		return errors.Wrap(err, "waiting for Grafana Route to become ready failed")
 	}
@@ -73,9 +75,9 @@ func (t *GrafanaTask) Run() error {
 		return errors.Wrap(err, "initializing Grafana proxy Secret failed")
 	}
 
-	err = t.client.CreateIfNotExistSecret(ps)
+	ps, err = t.client.CreateOrRotateSecret(ctx, ps, t.factory.RotateGeneratedSecret(ps))
 	if err != nil {
-		return errors.Wrap(err, "creating Grafana proxy Secret failed")
+		return errors.Wrap(err, "reconciling Grafana proxy Secret failed")
 	}
 
 	smc, err := t.factory.GrafanaConfig()
@@ -83,7 +85,7 @@ func (t *GrafanaTask) Run() error {
 		return errors.Wrap(err, "initializing Grafana Config Secret failed")
 	}
 
-	err = t.client.CreateOrUpdateSecret(smc)
+	err = t.client.CreateOrUpdateSecret(ctx, smc)
 	if err != nil {
 		return errors.Wrap(err, "reconciling Grafana Config Secret failed")
 	}
@@ -93,9 +95,10 @@ func (t *GrafanaTask) Run() error {
 		return errors.Wrap(err, "initializing Grafana Datasources Secret failed")
 	}
 
-	err = t.client.CreateIfNotExistSecret(sds)
+	// The secret is reconciled by the Grafana datasources Secret task.
+	sds, err = t.client.WaitForSecret(ctx, sds)
 	if err != nil {
-		return errors.Wrap(err, "reconciling Grafana Datasources Secret failed")
+		return errors.Wrap(err, "waiting for Grafana Datasources Secret failed")
 	}
 
 	cmdds, err := t.factory.GrafanaDashboardDefinitions()
@@ -103,7 +106,7 @@ func (t *GrafanaTask) Run() error {
 		return errors.Wrap(err, "initializing Grafana Dashboard Definitions ConfigMaps failed")
 	}
 
-	err = t.client.CreateOrUpdateConfigMapList(cmdds)
+	err = t.client.CreateOrUpdateConfigMapList(ctx, cmdds)
 	if err != nil {
 		return errors.Wrap(err, "reconciling Grafana Dashboard Definitions ConfigMaps failed")
 	}
@@ -113,7 +116,7 @@ func (t *GrafanaTask) Run() error {
 		return errors.Wrap(err, "initializing Grafana Dashboard Sources ConfigMap failed")
 	}
 
-	err = t.client.CreateOrUpdateConfigMap(cmdbs)
+	err = t.client.CreateOrUpdateConfigMap(ctx, cmdbs)
 	if err != nil {
 		return errors.Wrap(err, "reconciling Grafana Dashboard Sources ConfigMap failed")
 	}
@@ -123,7 +126,7 @@ func (t *GrafanaTask) Run() error {
 		return errors.Wrap(err, "initializing Grafana ServiceAccount failed")
 	}
 
-	err = t.client.CreateOrUpdateServiceAccount(sa)
+	err = t.client.CreateOrUpdateServiceAccount(ctx, sa)
 	if err != nil {
 		return errors.Wrap(err, "reconciling Grafana ServiceAccount failed")
 	}
@@ -133,7 +136,7 @@ func (t *GrafanaTask) Run() error {
 		return errors.Wrap(err, "initializing Grafana Service failed")
 	}
 
-	err = t.client.CreateOrUpdateService(svc)
+	err = t.client.CreateOrUpdateService(ctx, svc)
 	if err != nil {
 		return errors.Wrap(err, "reconciling Grafana Service failed")
 	}
@@ -149,7 +152,7 @@ func (t *GrafanaTask) Run() error {
 			factory: t.factory,
 			prefix:  "grafana",
 		}
-		trustedCA, err = cbs.syncTrustedCABundle(trustedCA)
+		trustedCA, err = cbs.syncTrustedCABundle(ctx, trustedCA)
 		if err != nil {
 			return errors.Wrap(err, "syncing Grafana CA bundle ConfigMap failed")
 		}
@@ -159,7 +162,11 @@ func (t *GrafanaTask) Run() error {
 			return errors.Wrap(err, "initializing Grafana Deployment failed")
 		}
 
-		err = t.client.CreateOrUpdateDeployment(d)
+		// The proxy and Grafana read the generated secrets at startup, the
+		// staged credential isn't used yet.
+		d.Spec.Template.Annotations = manifests.WithSecretsHash(d.Spec.Template.Annotations, ps.Data["session_secret"], sds.Data["prometheus.yaml"])
+
+		err = t.client.CreateOrUpdateDeployment(ctx, d)
 		if err != nil {
 			return errors.Wrap(err, "reconciling Grafana Deployment failed")
 		}
@@ -170,6 +177,6 @@ func (t *GrafanaTask) Run() error {
 		return errors.Wrap(err, "initializing Grafana ServiceMonitor failed")
 	}
 
-	err = t.client.CreateOrUpdateServiceMonitor(sm)
+	err = t.client.CreateOrUpdateServiceMonitor(ctx, sm)
 	return errors.Wrap(err, "reconciling Grafana ServiceMonitor failed")
 }
@@ -0,0 +1,130 @@
+// Copyright 2020 The Cluster Monitoring Operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tasks
+
+import (
+	"context"
+	"time"
+
+	"github.com/openshift/cluster-monitoring-operator/pkg/client"
+	"github.com/openshift/cluster-monitoring-operator/pkg/manifests"
+	"github.com/pkg/errors"
+	v1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/klog"
+)
+
+// GrafanaDatasourcesSecretTask reconciles the Grafana datasources secret,
+// which holds the Prometheus credential of Grafana. It is separate from the
+// Grafana task as Prometheus and Thanos Querier derive their htpasswd
+// secrets from it and mustn't wait for the Grafana rollout.
+type GrafanaDatasourcesSecretTask struct {
+	client  *client.Client
+	factory *manifests.Factory
+}
+
+func NewGrafanaDatasourcesSecretTask(client *client.Client, factory *manifests.Factory) *GrafanaDatasourcesSecretTask {
+	return &GrafanaDatasourcesSecretTask{
+		client:  client,
+		factory: factory,
+	}
+}
+
+func (t *GrafanaDatasourcesSecretTask) Run(ctx context.Context) error {
+	sds, err := t.factory.GrafanaDatasources()
+	if err != nil {
+		return errors.Wrap(err, "initializing Grafana Datasources Secret failed")
+	}
+
+	// A rotation of the Prometheus credential is only staged here, see
+	// GrafanaDatasourcesTask.
+	_, err = t.client.CreateOrRotateSecret(ctx, sds, t.factory.StageGrafanaDatasources)
+	return errors.Wrap(err, "reconciling Grafana Datasources Secret failed")
+}
+
+// GrafanaDatasourcesTask completes the rotations of the Prometheus
+// credential staged by the Grafana datasources Secret task. The staged
+// credential becomes current once the htpasswd secrets of Prometheus and
+// Thanos Querier accept it, which requires this task to run after theirs.
+// Grafana switches to it at its next reconciliation while the previous
+// credential stays accepted. The previous credential is dropped by the run
+// of this task following that reconciliation, which requires this task to
+// run after the Grafana task as well.
+type GrafanaDatasourcesTask struct {
+	client  *client.Client
+	factory *manifests.Factory
+}
+
+func NewGrafanaDatasourcesTask(client *client.Client, factory *manifests.Factory) *GrafanaDatasourcesTask {
+	return &GrafanaDatasourcesTask{
+		client:  client,
+		factory: factory,
+	}
+}
+
+func (t *GrafanaDatasourcesTask) Run(ctx context.Context) error {
+	gs, err := t.factory.GrafanaDatasources()
+	if err != nil {
+		return errors.Wrap(err, "initializing Grafana Datasources Secret failed")
+	}
+
+	gs, err = t.client.WaitForSecret(ctx, gs)
+	if err != nil {
+		return errors.Wrap(err, "waiting for Grafana Datasources Secret failed")
+	}
+
+	// The credential was promoted by a previous run, the Grafana task of
+	// this reconciliation has rolled Grafana out with it.
+	if manifests.DropPreviousGrafanaDatasource(gs) {
+		klog.Info("dropping the previous Prometheus credential of Grafana")
+		err = t.client.UpdateSecret(ctx, gs)
+		return errors.Wrap(err, "dropping the previous credential of Grafana Datasources Secret failed")
+	}
+
+	for _, htpasswd := range []func(*v1.Secret) (*v1.Secret, error){
+		t.factory.PrometheusK8sHtpasswdSecret,
+		t.factory.ThanosQuerierHtpasswdSecret,
+	} {
+		hs, err := htpasswd(gs)
+		if err != nil {
+			return errors.Wrap(err, "initializing htpasswd Secret failed")
+		}
+
+		hs, err = t.client.GetSecret(ctx, hs.Namespace, hs.Name)
+		if apierrors.IsNotFound(err) {
+			return nil
+		}
+		if err != nil {
+			return errors.Wrap(err, "retrieving htpasswd Secret failed")
+		}
+
+		ok, err := manifests.HtpasswdAcceptsPendingDatasource(gs, hs)
+		if err != nil {
+			return errors.Wrapf(err, "checking %s Secret failed", hs.Name)
+		}
+		if !ok {
+			// Nothing is staged or the component isn't managed.
+			return nil
+		}
+	}
+
+	if !manifests.PromoteGrafanaDatasources(gs, time.Now()) {
+		return nil
+	}
+
+	klog.Info("promoting the staged Prometheus credential of Grafana")
+	err = t.client.UpdateSecret(ctx, gs)
+	return errors.Wrap(err, "promoting Grafana Datasources Secret failed")
+}
@@ -15,6 +15,7 @@
 package tasks
 
 import (
+	"context"
 	"time"
 
 	"github.com/openshift/cluster-monitoring-operator/pkg/client"
@@ -30,8 +31,8 @@ type caBundleSyncer struct {
 	factory *manifests.Factory
 }
 
-func (cbs *caBundleSyncer) syncTrustedCABundle(trustedCA *v1.ConfigMap) (*v1.ConfigMap, error) {
-	trustedCA, err := cbs.client.CreateIfNotExistConfigMap(trustedCA)
+func (cbs *caBundleSyncer) syncTrustedCABundle(ctx context.Context, trustedCA *v1.ConfigMap) (*v1.ConfigMap, error) {
+	trustedCA, err := cbs.client.CreateIfNotExistConfigMap(ctx, trustedCA)
 	if err != nil {
 		return nil, errors.Wrap(err, " creating root trusted CA bundle ConfigMap failed")
 	}
@@ -40,9 +41,11 @@ func (cbs *caBundleSyncer) syncTrustedCABundle(trustedCA *v1.ConfigMap) (*v1.Con
 		lastErr error
 		lastCM  *v1.ConfigMap
 	)
-	err = wait.PollImmediate(5*time.Second, 5*time.Minute, func() (bool, error) {
+	pollCtx, cancel := context.WithTimeout(ctx, 5*time.Minute)
+	defer cancel()
+	err = wait.PollImmediateUntil(5*time.Second, func() (bool, error) {
 		var err error
-		lastCM, err = cbs.client.GetConfigmap(trustedCA.GetNamespace(), trustedCA.GetName())
+		lastCM, err = cbs.client.GetConfigmap(ctx, trustedCA.GetNamespace(), trustedCA.GetName())
 
 		if err != nil {
 			lastErr = errors.Wrap(err, "retrieving ConfigMap object failed")
@@ -60,9 +63,11 @@ func (cbs *caBundleSyncer) syncTrustedCABundle(trustedCA *v1.ConfigMap) (*v1.Con
 		}
 
 		return true, nil
-	})
+	}, pollCtx.Done())
 	if err != nil {
-		if err == wait.ErrWaitTimeout && lastErr != nil {
+		if err == wait.ErrWaitTimeout && ctx.Err() != nil {
+			err = ctx.Err()
+		} else if err == wait.ErrWaitTimeout && lastErr != nil {
 			err = errors.Errorf("%v: %v", err, lastErr)
 		}
 		return nil, errors.Wrapf(err, "waiting for config map key %q in %s/%s ConfigMap object failed", manifests.TrustedCABundleKey, trustedCA.GetNamespace(), trustedCA.GetName())
@@ -73,12 +78,12 @@ func (cbs *caBundleSyncer) syncTrustedCABundle(trustedCA *v1.ConfigMap) (*v1.Con
 		return nil, errors.Wrap(err, "hashing trusted CA bundle failed")
 	}
 
-	err = cbs.client.CreateOrUpdateConfigMap(hashedCM)
+	err = cbs.client.CreateOrUpdateConfigMap(ctx, hashedCM)
 	if err != nil {
 		return nil, errors.Wrap(err, "reconciling trusted CA bundle ConfigMap failed")
 	}
 
-	err = cbs.client.DeleteHashedConfigMap(
+	err = cbs.client.DeleteHashedConfigMap(ctx,
 		trustedCA.GetNamespace(),
 		cbs.prefix,
 		string(hashedCM.Labels["monitoring.openshift.io/hash"]),
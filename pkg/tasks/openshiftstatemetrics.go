@@ -15,6 +15,8 @@
 package tasks
 
 import (
+	"context"
+
 	"github.com/openshift/cluster-monitoring-operator/pkg/client"
 	"github.com/openshift/cluster-monitoring-operator/pkg/manifests"
 	"github.com/pkg/errors"
@@ -23,22 +25,32 @@ import (
 type OpenShiftStateMetricsTask struct {
 	client  *client.Client
 	factory *manifests.Factory
+	config  *manifests.Config
 }
 
-func NewOpenShiftStateMetricsTask(client *client.Client, factory *manifests.Factory) *OpenShiftStateMetricsTask {
+func NewOpenShiftStateMetricsTask(client *client.Client, factory *manifests.Factory, config *manifests.Config) *OpenShiftStateMetricsTask {
 	return &OpenShiftStateMetricsTask{
 		client:  client,
 		factory: factory,
+		config:  config,
 	}
 }
 
-func (t *OpenShiftStateMetricsTask) Run() error {
+func (t *OpenShiftStateMetricsTask) Run(ctx context.Context) error {
+	if t.config.ClusterMonitoringConfiguration.OpenShiftMetricsConfig.ManagementState == manifests.Removed {
+		return t.destroy(ctx)
+	}
+
+	return t.create(ctx)
+}
+
+func (t *OpenShiftStateMetricsTask) create(ctx context.Context) error {
 	sa, err := t.factory.OpenShiftStateMetricsServiceAccount()
 	if err != nil {
 		return errors.Wrap(err, "initializing openshift-state-metrics Service failed")
 	}
 
-	err = t.client.CreateOrUpdateServiceAccount(sa)
+	err = t.client.CreateOrUpdateServiceAccount(ctx, sa)
 	if err != nil {
 		return errors.Wrap(err, "reconciling openshift-state-metrics ServiceAccount failed")
 	}
@@ -48,7 +60,7 @@ func (t *OpenShiftStateMetricsTask) Run() error {
 		return errors.Wrap(err, "initializing openshift-state-metrics ClusterRole failed")
 	}
 
-	err = t.client.CreateOrUpdateClusterRole(cr)
+	err = t.client.CreateOrUpdateClusterRole(ctx, cr)
 	if err != nil {
 		return errors.Wrap(err, "reconciling openshift-state-metrics ClusterRole failed")
 	}
@@ -58,7 +70,7 @@ func (t *OpenShiftStateMetricsTask) Run() error {
 		return errors.Wrap(err, "initializing openshift-state-metrics ClusterRoleBinding failed")
 	}
 
-	err = t.client.CreateOrUpdateClusterRoleBinding(crb)
+	err = t.client.CreateOrUpdateClusterRoleBinding(ctx, crb)
 	if err != nil {
 		return errors.Wrap(err, "reconciling openshift-state-metrics ClusterRoleBinding failed")
 	}
@@ -68,7 +80,7 @@ func (t *OpenShiftStateMetricsTask) Run() error {
 		return errors.Wrap(err, "initializing openshift-state-metrics Service failed")
 	}
 
-	err = t.client.CreateOrUpdateService(svc)
+	err = t.client.CreateOrUpdateService(ctx, svc)
 	if err != nil {
 		return errors.Wrap(err, "reconciling openshift-state-metrics Service failed")
 	}
@@ -78,7 +90,7 @@ func (t *OpenShiftStateMetricsTask) Run() error {
 		return errors.Wrap(err, "initializing openshift-state-metrics Deployment failed")
 	}
 
-	err = t.client.CreateOrUpdateDeployment(dep)
+	err = t.client.CreateOrUpdateDeployment(ctx, dep)
 	if err != nil {
 		return errors.Wrap(err, "reconciling openshift-state-metrics Deployment failed")
 	}
@@ -88,6 +100,66 @@ func (t *OpenShiftStateMetricsTask) Run() error {
 		return errors.Wrap(err, "initializing openshift-state-metrics ServiceMonitor failed")
 	}
 
-	err = t.client.CreateOrUpdateServiceMonitor(sm)
+	err = t.client.CreateOrUpdateServiceMonitor(ctx, sm)
 	return errors.Wrap(err, "reconciling openshift-state-metrics ServiceMonitor failed")
 }
+
+func (t *OpenShiftStateMetricsTask) destroy(ctx context.Context) error {
+	sm, err := t.factory.OpenShiftStateMetricsServiceMonitor()
+	if err != nil {
+		return errors.Wrap(err, "initializing openshift-state-metrics ServiceMonitor failed")
+	}
+
+	err = t.client.DeleteServiceMonitor(ctx, sm)
+	if err != nil {
+		return errors.Wrap(err, "deleting openshift-state-metrics ServiceMonitor failed")
+	}
+
+	dep, err := t.factory.OpenShiftStateMetricsDeployment()
+	if err != nil {
+		return errors.Wrap(err, "initializing openshift-state-metrics Deployment failed")
+	}
+
+	err = t.client.DeleteDeployment(ctx, dep)
+	if err != nil {
+		return errors.Wrap(err, "deleting openshift-state-metrics Deployment failed")
+	}
+
+	svc, err := t.factory.OpenShiftStateMetricsService()
+	if err != nil {
+		return errors.Wrap(err, "initializing openshift-state-metrics Service failed")
+	}
+
+	err = t.client.DeleteService(ctx, svc)
+	if err != nil {
+		return errors.Wrap(err, "deleting openshift-state-metrics Service failed")
+	}
+
+	crb, err := t.factory.OpenShiftStateMetricsClusterRoleBinding()
+	if err != nil {
+		return errors.Wrap(err, "initializing openshift-state-metrics ClusterRoleBinding failed")
+	}
+
+	err = t.client.DeleteClusterRoleBinding(ctx, crb)
+	if err != nil {
+		return errors.Wrap(err, "deleting openshift-state-metrics ClusterRoleBinding failed")
+	}
+
+	cr, err := t.factory.OpenShiftStateMetricsClusterRole()
+	if err != nil {
+		return errors.Wrap(err, "initializing openshift-state-metrics ClusterRole failed")
+	}
+
+	err = t.client.DeleteClusterRole(ctx, cr)
+	if err != nil {
+		return errors.Wrap(err, "deleting openshift-state-metrics ClusterRole failed")
+	}
+
+	sa, err := t.factory.OpenShiftStateMetricsServiceAccount()
+	if err != nil {
+		return errors.Wrap(err, "initializing openshift-state-metrics ServiceAccount failed")
+	}
+
+	err = t.client.DeleteServiceAccount(ctx, sa)
+	return errors.Wrap(err, "deleting openshift-state-metrics ServiceAccount failed")
+}
@@ -15,8 +15,9 @@
 package tasks
 
 import (
-	"encoding/json"
+	"context"
 
+	monv1 "github.com/coreos/prometheus-operator/pkg/apis/monitoring/v1"
 	"github.com/openshift/cluster-monitoring-operator/pkg/client"
 	"github.com/openshift/cluster-monitoring-operator/pkg/manifests"
 	"github.com/pkg/errors"
@@ -37,18 +38,18 @@ func NewPrometheusTask(client *client.Client, factory *manifests.Factory, config
 	}
 }
 
-func (t *PrometheusTask) Run() error {
+func (t *PrometheusTask) Run(ctx context.Context) error {
 	cacm, err := t.factory.PrometheusK8sServingCertsCABundle()
 	if err != nil {
 		return errors.Wrap(err, "initializing serving certs CA Bundle ConfigMap failed")
 	}
 
-	_, err = t.client.CreateIfNotExistConfigMap(cacm)
+	_, err = t.client.CreateIfNotExistConfigMap(ctx, cacm)
 	if err != nil {
 		return errors.Wrap(err, "creating serving certs CA Bundle ConfigMap failed")
 	}
 
-	kscm, err := t.client.GetConfigmap("openshift-config-managed", "kubelet-serving-ca")
+	kscm, err := t.client.GetConfigmap(ctx, "openshift-config-managed", "kubelet-serving-ca")
 	if err != nil {
 		return errors.Wrap(err, "openshift-config-managed/kubelet-serving-ca")
 	}
@@ -58,7 +59,7 @@ func (t *PrometheusTask) Run() error {
 		return errors.Wrap(err, "initializing kubelet serving CA Bundle ConfigMap failed")
 	}
 
-	err = t.client.CreateOrUpdateConfigMap(cacm)
+	err = t.client.CreateOrUpdateConfigMap(ctx, cacm)
 	if err != nil {
 		return errors.Wrap(err, "creating kubelet serving CA Bundle ConfigMap failed")
 	}
@@ -68,12 +69,12 @@ func (t *PrometheusTask) Run() error {
 		return errors.Wrap(err, "initializing Prometheus Route failed")
 	}
 
-	err = t.client.CreateRouteIfNotExists(r)
+	err = t.client.CreateRouteIfNotExists(ctx, r)
 	if err != nil {
 		return errors.Wrap(err, "creating Prometheus Route failed")
 	}
 
-	host, err := t.client.WaitForRouteReady(r)
+	host, err := t.client.WaitForRouteReady(ctx, r)
 	if err != nil {
 		return errors.Wrap(err, "waiting for Prometheus Route to become ready failed")
 	}
@@ -83,9 +84,9 @@ func (t *PrometheusTask) Run() error {
 		return errors.Wrap(err, "initializing Prometheus proxy Secret failed")
 	}
 
-	err = t.client.CreateIfNotExistSecret(ps)
+	ps, err = t.client.CreateOrRotateSecret(ctx, ps, t.factory.RotateGeneratedSecret(ps))
 	if err != nil {
-		return errors.Wrap(err, "creating Prometheus proxy Secret failed")
+		return errors.Wrap(err, "reconciling Prometheus proxy Secret failed")
 	}
 
 	gs, err := t.factory.GrafanaDatasources()
@@ -93,25 +94,19 @@ func (t *PrometheusTask) Run() error {
 		return errors.Wrap(err, "initializing Grafana Datasources Secret failed")
 	}
 
-	gs, err = t.client.WaitForSecret(gs)
+	gs, err = t.client.WaitForSecret(ctx, gs)
 	if err != nil {
 		return errors.Wrap(err, "waiting for Grafana Datasources Secret failed")
 	}
 
-	d := &manifests.GrafanaDatasources{}
-	err = json.Unmarshal(gs.Data["prometheus.yaml"], d)
-	if err != nil {
-		return errors.Wrap(err, "unmarshalling grafana datasource failed")
-	}
-
-	hs, err := t.factory.PrometheusK8sHtpasswdSecret(d.Datasources[0].BasicAuthPassword)
+	hs, err := t.factory.PrometheusK8sHtpasswdSecret(gs)
 	if err != nil {
 		return errors.Wrap(err, "initializing Prometheus htpasswd Secret failed")
 	}
 
-	err = t.client.CreateIfNotExistSecret(hs)
+	err = t.client.CreateOrUpdateSecret(ctx, hs)
 	if err != nil {
-		return errors.Wrap(err, "creating Prometheus htpasswd Secret failed")
+		return errors.Wrap(err, "reconciling Prometheus htpasswd Secret failed")
 	}
 
 	rs, err := t.factory.PrometheusRBACProxySecret()
@@ -119,7 +114,7 @@ func (t *PrometheusTask) Run() error {
 		return errors.Wrap(err, "initializing Prometheus RBAC proxy Secret failed")
 	}
 
-	err = t.client.CreateIfNotExistSecret(rs)
+	err = t.client.CreateIfNotExistSecret(ctx, rs)
 	if err != nil {
 		return errors.Wrap(err, "creating Prometheus RBAC proxy Secret failed")
 	}
@@ -129,7 +124,7 @@ func (t *PrometheusTask) Run() error {
 		return errors.Wrap(err, "initializing Prometheus ServiceAccount failed")
 	}
 
-	err = t.client.CreateOrUpdateServiceAccount(sa)
+	err = t.client.CreateOrUpdateServiceAccount(ctx, sa)
 	if err != nil {
 		return errors.Wrap(err, "reconciling Prometheus ServiceAccount failed")
 	}
@@ -139,7 +134,7 @@ func (t *PrometheusTask) Run() error {
 		return errors.Wrap(err, "initializing Prometheus ClusterRole failed")
 	}
 
-	err = t.client.CreateOrUpdateClusterRole(cr)
+	err = t.client.CreateOrUpdateClusterRole(ctx, cr)
 	if err != nil {
 		return errors.Wrap(err, "reconciling Prometheus ClusterRole failed")
 	}
@@ -149,7 +144,7 @@ func (t *PrometheusTask) Run() error {
 		return errors.Wrap(err, "initializing Prometheus ClusterRoleBinding failed")
 	}
 
-	err = t.client.CreateOrUpdateClusterRoleBinding(crb)
+	err = t.client.CreateOrUpdateClusterRoleBinding(ctx, crb)
 	if err != nil {
 		return errors.Wrap(err, "reconciling Prometheus ClusterRoleBinding failed")
 	}
@@ -159,7 +154,7 @@ func (t *PrometheusTask) Run() error {
 		return errors.Wrap(err, "initializing Prometheus Role config failed")
 	}
 
-	err = t.client.CreateOrUpdateRole(rc)
+	err = t.client.CreateOrUpdateRole(ctx, rc)
 	if err != nil {
 		return errors.Wrap(err, "reconciling Prometheus Role config failed")
 	}
@@ -170,7 +165,7 @@ func (t *PrometheusTask) Run() error {
 	}
 
 	for _, r := range rl.Items {
-		err = t.client.CreateOrUpdateRole(&r)
+		err = t.client.CreateOrUpdateRole(ctx, &r)
 		if err != nil {
 			return errors.Wrapf(err, "reconciling Prometheus Role %q failed", r.Name)
 		}
@@ -182,7 +177,7 @@ func (t *PrometheusTask) Run() error {
 	}
 
 	for _, rb := range rbl.Items {
-		err = t.client.CreateOrUpdateRoleBinding(&rb)
+		err = t.client.CreateOrUpdateRoleBinding(ctx, &rb)
 		if err != nil {
 			return errors.Wrapf(err, "reconciling Prometheus RoleBinding %q failed", rb.Name)
 		}
@@ -193,7 +188,7 @@ func (t *PrometheusTask) Run() error {
 		return errors.Wrap(err, "initializing Prometheus config RoleBinding failed")
 	}
 
-	err = t.client.CreateOrUpdateRoleBinding(rbc)
+	err = t.client.CreateOrUpdateRoleBinding(ctx, rbc)
 	if err != nil {
 		return errors.Wrap(err, "reconciling Prometheus config RoleBinding failed")
 	}
@@ -203,7 +198,7 @@ func (t *PrometheusTask) Run() error {
 		return errors.Wrap(err, "initializing Prometheus rules PrometheusRule failed")
 	}
 
-	err = t.client.CreateOrUpdatePrometheusRule(pm)
+	err = t.client.CreateOrUpdatePrometheusRule(ctx, pm)
 	if err != nil {
 		return errors.Wrap(err, "reconciling Prometheus rules PrometheusRule failed")
 	}
@@ -213,18 +208,18 @@ func (t *PrometheusTask) Run() error {
 		return errors.Wrap(err, "initializing Prometheus Service failed")
 	}
 
-	err = t.client.CreateOrUpdateService(svc)
+	err = t.client.CreateOrUpdateService(ctx, svc)
 	if err != nil {
 		return errors.Wrap(err, "reconciling Prometheus Service failed")
 	}
 
 	if t.config.ClusterMonitoringConfiguration.EtcdConfig.IsEnabled() {
-		etcdCA, err := t.client.GetConfigmap("openshift-config", "etcd-metric-serving-ca")
+		etcdCA, err := t.client.GetConfigmap(ctx, "openshift-config", "etcd-metric-serving-ca")
 		if err != nil {
 			return errors.Wrap(err, "failed to load etcd client CA")
 		}
 
-		etcdClientSecret, err := t.client.GetSecret("openshift-config", "etcd-metric-client")
+		etcdClientSecret, err := t.client.GetSecret(ctx, "openshift-config", "etcd-metric-client")
 		if err != nil {
 			return errors.Wrap(err, "failed to load etcd client secret")
 		}
@@ -234,7 +229,7 @@ func (t *PrometheusTask) Run() error {
 			return errors.Wrap(err, "initializing prometheus etcd service monitor secret failed")
 		}
 
-		err = t.client.CreateOrUpdateSecret(promEtcdSecret)
+		err = t.client.CreateOrUpdateSecret(ctx, promEtcdSecret)
 		if err != nil {
 			return errors.Wrap(err, "reconciling prometheus etcd service monitor secret")
 		}
@@ -245,7 +240,7 @@ func (t *PrometheusTask) Run() error {
 		return errors.Wrap(err, "initializing Prometheus GRPC secret failed")
 	}
 
-	grpcTLS, err = t.client.WaitForSecret(grpcTLS)
+	grpcTLS, err = t.client.WaitForSecret(ctx, grpcTLS)
 	if err != nil {
 		return errors.Wrap(err, "waiting for Prometheus GRPC secret failed")
 	}
@@ -264,12 +259,12 @@ func (t *PrometheusTask) Run() error {
 		return errors.Wrap(err, "error hashing Prometheus Client GRPC TLS secret")
 	}
 
-	err = t.client.CreateOrUpdateSecret(s)
+	err = t.client.CreateOrUpdateSecret(ctx, s)
 	if err != nil {
 		return errors.Wrap(err, "error creating Prometheus Client GRPC TLS secret")
 	}
 
-	err = t.client.DeleteHashedSecret(
+	err = t.client.DeleteHashedSecret(ctx,
 		s.GetNamespace(),
 		"prometheus-k8s-grpc-tls",
 		string(s.Labels["monitoring.openshift.io/hash"]),
@@ -289,7 +284,7 @@ func (t *PrometheusTask) Run() error {
 			factory: t.factory,
 			prefix:  "prometheus",
 		}
-		trustedCA, err = cbs.syncTrustedCABundle(trustedCA)
+		trustedCA, err = cbs.syncTrustedCABundle(ctx, trustedCA)
 		if err != nil {
 			return errors.Wrap(err, "syncing Prometheus trusted CA bundle ConfigMap failed")
 		}
@@ -300,14 +295,20 @@ func (t *PrometheusTask) Run() error {
 			return errors.Wrap(err, "initializing Prometheus object failed")
 		}
 
+		// The proxy reads the generated secrets at startup.
+		if p.Spec.PodMetadata == nil {
+			p.Spec.PodMetadata = &monv1.EmbeddedObjectMetadata{}
+		}
+		p.Spec.PodMetadata.Annotations = manifests.WithSecretsHash(p.Spec.PodMetadata.Annotations, ps.Data["session_secret"], hs.Data["auth"])
+
 		klog.V(4).Info("reconciling Prometheus object")
-		err = t.client.CreateOrUpdatePrometheus(p)
+		err = t.client.CreateOrUpdatePrometheus(ctx, p)
 		if err != nil {
 			return errors.Wrap(err, "reconciling Prometheus object failed")
 		}
 
 		klog.V(4).Info("waiting for Prometheus object changes")
-		err = t.client.WaitForPrometheus(p)
+		err = t.client.WaitForPrometheus(ctx, p)
 		if err != nil {
 			return errors.Wrap(err, "waiting for Prometheus object changes failed")
 		}
@@ -318,7 +319,7 @@ func (t *PrometheusTask) Run() error {
 		return errors.Wrap(err, "initializing Prometheus kubelet ServiceMonitor failed")
 	}
 
-	err = t.client.CreateOrUpdateServiceMonitor(smk)
+	err = t.client.CreateOrUpdateServiceMonitor(ctx, smk)
 	if err != nil {
 		return errors.Wrap(err, "reconciling Prometheus kubelet ServiceMonitor failed")
 	}
@@ -329,12 +330,12 @@ func (t *PrometheusTask) Run() error {
 	}
 
 	if t.config.ClusterMonitoringConfiguration.EtcdConfig.IsEnabled() {
-		err = t.client.CreateOrUpdateServiceMonitor(sme)
+		err = t.client.CreateOrUpdateServiceMonitor(ctx, sme)
 		if err != nil {
 			return errors.Wrap(err, "reconciling Prometheus etcd ServiceMonitor failed")
 		}
 	} else {
-		err = t.client.DeleteServiceMonitor(sme)
+		err = t.client.DeleteServiceMonitor(ctx, sme)
 		if err != nil {
 			return errors.Wrap(err, "deleting Prometheus etcd ServiceMonitor failed")
 		}
@@ -345,14 +346,14 @@ func (t *PrometheusTask) Run() error {
 		return errors.Wrap(err, "initializing Prometheus Prometheus ServiceMonitor failed")
 	}
 
-	err = t.client.CreateOrUpdateServiceMonitor(smp)
+	err = t.client.CreateOrUpdateServiceMonitor(ctx, smp)
 	if err != nil {
 		return errors.Wrap(err, "reconciling Prometheus Prometheus ServiceMonitor failed")
 	}
 
 	// Clean up the service monitors previously managed by the cluster monitoring operator.
 	for _, name := range []string{"cluster-version-operator", "kube-apiserver", "kube-controller-manager", "kube-scheduler", "openshift-apiserver"} {
-		err := t.client.DeleteServiceMonitorByNamespaceAndName(t.client.Namespace(), name)
+		err := t.client.DeleteServiceMonitorByNamespaceAndName(ctx, t.client.Namespace(), name)
 		if err != nil {
 			return errors.Wrapf(err, "deleting Prometheus %s ServiceMonitor failed", name)
 		}
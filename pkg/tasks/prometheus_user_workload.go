@@ -15,6 +15,8 @@
 package tasks
 
 import (
+	"context"
+
 	"github.com/openshift/cluster-monitoring-operator/pkg/client"
 	"github.com/openshift/cluster-monitoring-operator/pkg/manifests"
 
@@ -36,21 +38,21 @@ func NewPrometheusUserWorkloadTask(client *client.Client, factory *manifests.Fac
 	}
 }
 
-func (t *PrometheusUserWorkloadTask) Run() error {
+func (t *PrometheusUserWorkloadTask) Run(ctx context.Context) error {
 	if t.config.IsUserWorkloadEnabled() {
-		return t.create()
+		return t.create(ctx)
 	}
 
-	return t.destroy()
+	return t.destroy(ctx)
 }
 
-func (t *PrometheusUserWorkloadTask) create() error {
+func (t *PrometheusUserWorkloadTask) create(ctx context.Context) error {
 	cacm, err := t.factory.PrometheusUserWorkloadServingCertsCABundle()
 	if err != nil {
 		return errors.Wrap(err, "initializing UserWorkload serving certs CA Bundle ConfigMap failed")
 	}
 
-	_, err = t.client.CreateIfNotExistConfigMap(cacm)
+	_, err = t.client.CreateIfNotExistConfigMap(ctx, cacm)
 	if err != nil {
 		return errors.Wrap(err, "creating UserWorkload serving certs CA Bundle ConfigMap failed")
 	}
@@ -60,7 +62,7 @@ func (t *PrometheusUserWorkloadTask) create() error {
 		return errors.Wrap(err, "initializing UserWorkload Prometheus ServiceAccount failed")
 	}
 
-	err = t.client.CreateOrUpdateServiceAccount(sa)
+	err = t.client.CreateOrUpdateServiceAccount(ctx, sa)
 	if err != nil {
 		return errors.Wrap(err, "reconciling UserWorkload Prometheus ServiceAccount failed")
 	}
@@ -70,7 +72,7 @@ func (t *PrometheusUserWorkloadTask) create() error {
 		return errors.Wrap(err, "initializing UserWorkload Prometheus ClusterRole failed")
 	}
 
-	err = t.client.CreateOrUpdateClusterRole(cr)
+	err = t.client.CreateOrUpdateClusterRole(ctx, cr)
 	if err != nil {
 		return errors.Wrap(err, "reconciling UserWorkload Prometheus ClusterRole failed")
 	}
@@ -80,7 +82,7 @@ func (t *PrometheusUserWorkloadTask) create() error {
 		return errors.Wrap(err, "initializing UserWorkload Prometheus ClusterRoleBinding failed")
 	}
 
-	err = t.client.CreateOrUpdateClusterRoleBinding(crb)
+	err = t.client.CreateOrUpdateClusterRoleBinding(ctx, crb)
 	if err != nil {
 		return errors.Wrap(err, "reconciling UserWorkload Prometheus ClusterRoleBinding failed")
 	}
@@ -90,7 +92,7 @@ func (t *PrometheusUserWorkloadTask) create() error {
 		return errors.Wrap(err, "initializing UserWorkload Prometheus Role config failed")
 	}
 
-	err = t.client.CreateOrUpdateRole(rc)
+	err = t.client.CreateOrUpdateRole(ctx, rc)
 	if err != nil {
 		return errors.Wrap(err, "reconciling UserWorkload Prometheus Role config failed")
 	}
@@ -101,7 +103,7 @@ func (t *PrometheusUserWorkloadTask) create() error {
 	}
 
 	for _, r := range rl.Items {
-		err = t.client.CreateOrUpdateRole(&r)
+		err = t.client.CreateOrUpdateRole(ctx, &r)
 		if err != nil {
 			return errors.Wrapf(err, "reconciling UserWorkload Prometheus Role %q failed", r.Name)
 		}
@@ -113,7 +115,7 @@ func (t *PrometheusUserWorkloadTask) create() error {
 	}
 
 	for _, rb := range rbl.Items {
-		err = t.client.CreateOrUpdateRoleBinding(&rb)
+		err = t.client.CreateOrUpdateRoleBinding(ctx, &rb)
 		if err != nil {
 			return errors.Wrapf(err, "reconciling UserWorkload Prometheus RoleBinding %q failed", rb.Name)
 		}
@@ -124,7 +126,7 @@ func (t *PrometheusUserWorkloadTask) create() error {
 		return errors.Wrap(err, "initializing UserWorkload Prometheus config RoleBinding failed")
 	}
 
-	err = t.client.CreateOrUpdateRoleBinding(rbc)
+	err = t.client.CreateOrUpdateRoleBinding(ctx, rbc)
 	if err != nil {
 		return errors.Wrap(err, "reconciling UserWorkload Prometheus config RoleBinding failed")
 	}
@@ -134,7 +136,7 @@ func (t *PrometheusUserWorkloadTask) create() error {
 		return errors.Wrap(err, "initializing UserWorkload Prometheus Service failed")
 	}
 
-	err = t.client.CreateOrUpdateService(svc)
+	err = t.client.CreateOrUpdateService(ctx, svc)
 	if err != nil {
 		return errors.Wrap(err, "reconciling UserWorkload Prometheus Service failed")
 	}
@@ -144,7 +146,7 @@ func (t *PrometheusUserWorkloadTask) create() error {
 		return errors.Wrap(err, "initializing UserWorkload Prometheus GRPC secret failed")
 	}
 
-	grpcTLS, err = t.client.WaitForSecret(grpcTLS)
+	grpcTLS, err = t.client.WaitForSecret(ctx, grpcTLS)
 	if err != nil {
 		return errors.Wrap(err, "waiting for UserWorkload Prometheus GRPC secret failed")
 	}
@@ -156,19 +158,19 @@ func (t *PrometheusUserWorkloadTask) create() error {
 
 	s, err = t.factory.HashSecret(s,
 		"ca.crt", string(grpcTLS.Data["ca.crt"]),
-		"server.crt", string(grpcTLS.Data["prometheus-server.crt"]),
-		"server.key", string(grpcTLS.Data["prometheus-server.key"]),
+		"server.crt", string(grpcTLS.Data["prometheus-user-workload-server.crt"]),
+		"server.key", string(grpcTLS.Data["prometheus-user-workload-server.key"]),
 	)
 	if err != nil {
 		return errors.Wrap(err, "error hashing UserWorkload Prometheus Client GRPC TLS secret")
 	}
 
-	err = t.client.CreateOrUpdateSecret(s)
+	err = t.client.CreateOrUpdateSecret(ctx, s)
 	if err != nil {
 		return errors.Wrap(err, "error creating UserWorkload Prometheus Client GRPC TLS secret")
 	}
 
-	err = t.client.DeleteHashedSecret(
+	err = t.client.DeleteHashedSecret(ctx,
 		s.GetNamespace(),
 		"prometheus-user-workload-grpc-tls",
 		string(s.Labels["monitoring.openshift.io/hash"]),
@@ -184,13 +186,13 @@ func (t *PrometheusUserWorkloadTask) create() error {
 	}
 
 	klog.V(4).Info("reconciling UserWorkload Prometheus object")
-	err = t.client.CreateOrUpdatePrometheus(p)
+	err = t.client.CreateOrUpdatePrometheus(ctx, p)
 	if err != nil {
 		return errors.Wrap(err, "reconciling UserWorkload Prometheus object failed")
 	}
 
 	klog.V(4).Info("waiting for UserWorkload Prometheus object changes")
-	err = t.client.WaitForPrometheus(p)
+	err = t.client.WaitForPrometheus(ctx, p)
 	if err != nil {
 		return errors.Wrap(err, "waiting for UserWorkload Prometheus object changes failed")
 	}
@@ -200,17 +202,17 @@ func (t *PrometheusUserWorkloadTask) create() error {
 		return errors.Wrap(err, "initializing UserWorkload Prometheus ServiceMonitor failed")
 	}
 
-	err = t.client.CreateOrUpdateServiceMonitor(smp)
+	err = t.client.CreateOrUpdateServiceMonitor(ctx, smp)
 	return errors.Wrap(err, "reconciling UserWorkload Prometheus ServiceMonitor failed")
 }
 
-func (t *PrometheusUserWorkloadTask) destroy() error {
+func (t *PrometheusUserWorkloadTask) destroy(ctx context.Context) error {
 	smp, err := t.factory.PrometheusUserWorkloadPrometheusServiceMonitor()
 	if err != nil {
 		return errors.Wrap(err, "initializing UserWorkload Prometheus ServiceMonitor failed")
 	}
 
-	err = t.client.DeleteServiceMonitor(smp)
+	err = t.client.DeleteServiceMonitor(ctx, smp)
 	if err != nil {
 		return errors.Wrap(err, "deleting UserWorkload Prometheus ServiceMonitor failed")
 	}
@@ -220,7 +222,7 @@ func (t *PrometheusUserWorkloadTask) destroy() error {
 		return errors.Wrap(err, "initializing UserWorkload Prometheus GRPC secret failed")
 	}
 
-	grpcTLS, err = t.client.WaitForSecret(grpcTLS)
+	grpcTLS, err = t.client.WaitForSecret(ctx, grpcTLS)
 	if err != nil {
 		return errors.Wrap(err, "waiting for UserWorkload Prometheus GRPC secret failed")
 	}
@@ -232,8 +234,8 @@ func (t *PrometheusUserWorkloadTask) destroy() error {
 
 	s, err = t.factory.HashSecret(s,
 		"ca.crt", string(grpcTLS.Data["ca.crt"]),
-		"server.crt", string(grpcTLS.Data["prometheus-server.crt"]),
-		"server.key", string(grpcTLS.Data["prometheus-server.key"]),
+		"server.crt", string(grpcTLS.Data["prometheus-user-workload-server.crt"]),
+		"server.key", string(grpcTLS.Data["prometheus-user-workload-server.key"]),
 	)
 
 	p, err := t.factory.PrometheusUserWorkload(s)
@@ -241,12 +243,12 @@ func (t *PrometheusUserWorkloadTask) destroy() error {
 		return errors.Wrap(err, "initializing UserWorkload Prometheus object failed")
 	}
 
-	err = t.client.DeletePrometheus(p)
+	err = t.client.DeletePrometheus(ctx, p)
 	if err != nil {
 		return errors.Wrap(err, "deleting UserWorkload Prometheus object failed")
 	}
 
-	err = t.client.DeleteSecret(s)
+	err = t.client.DeleteSecret(ctx, s)
 	if err != nil {
 		return errors.Wrap(err, "deleting UserWorkload Prometheus TLS secret failed")
 	}
@@ -256,7 +258,7 @@ func (t *PrometheusUserWorkloadTask) destroy() error {
 		return errors.Wrap(err, "initializing UserWorkload Prometheus Service failed")
 	}
 
-	err = t.client.DeleteService(svc)
+	err = t.client.DeleteService(ctx, svc)
 	if err != nil {
 		return errors.Wrap(err, "deleting UserWorkload Prometheus Service failed")
 	}
@@ -266,7 +268,7 @@ func (t *PrometheusUserWorkloadTask) destroy() error {
 		return errors.Wrap(err, "initializing UserWorkload Prometheus config RoleBinding failed")
 	}
 
-	err = t.client.DeleteRoleBinding(rbc)
+	err = t.client.DeleteRoleBinding(ctx, rbc)
 	if err != nil {
 		return errors.Wrap(err, "deleting UserWorkload Prometheus Service failed")
 	}
@@ -277,7 +279,7 @@ func (t *PrometheusUserWorkloadTask) destroy() error {
 	}
 
 	for _, rb := range rbl.Items {
-		err = t.client.DeleteRoleBinding(&rb)
+		err = t.client.DeleteRoleBinding(ctx, &rb)
 		if err != nil {
 			return errors.Wrapf(err, "deleting UserWorkload Prometheus RoleBinding %q failed", rb.Name)
 		}
@@ -289,7 +291,7 @@ func (t *PrometheusUserWorkloadTask) destroy() error {
 	}
 
 	for _, r := range rl.Items {
-		err = t.client.DeleteRole(&r)
+		err = t.client.DeleteRole(ctx, &r)
 		if err != nil {
 			return errors.Wrapf(err, "deleting UserWorkload Prometheus Role %q failed", r.Name)
 		}
@@ -300,7 +302,7 @@ func (t *PrometheusUserWorkloadTask) destroy() error {
 		return errors.Wrap(err, "initializing UserWorkload Prometheus Role config failed")
 	}
 
-	err = t.client.DeleteRole(rc)
+	err = t.client.DeleteRole(ctx, rc)
 	if err != nil {
 		return errors.Wrap(err, "deleting UserWorkload Prometheus Role config failed")
 	}
@@ -310,7 +312,7 @@ func (t *PrometheusUserWorkloadTask) destroy() error {
 		return errors.Wrap(err, "initializing UserWorkload Prometheus ClusterRoleBinding failed")
 	}
 
-	err = t.client.DeleteClusterRoleBinding(crb)
+	err = t.client.DeleteClusterRoleBinding(ctx, crb)
 	if err != nil {
 		return errors.Wrap(err, "deleting UserWorkload Prometheus ClusterRoleBinding failed")
 	}
@@ -320,7 +322,7 @@ func (t *PrometheusUserWorkloadTask) destroy() error {
 		return errors.Wrap(err, "initializing UserWorkload Prometheus ClusterRole failed")
 	}
 
-	err = t.client.DeleteClusterRole(cr)
+	err = t.client.DeleteClusterRole(ctx, cr)
 	if err != nil {
 		return errors.Wrap(err, "deleting UserWorkload Prometheus ClusterRole failed")
 	}
@@ -330,7 +332,7 @@ func (t *PrometheusUserWorkloadTask) destroy() error {
 		return errors.Wrap(err, "initializing UserWorkload Prometheus ServiceAccount failed")
 	}
 
-	err = t.client.DeleteServiceAccount(sa)
+	err = t.client.DeleteServiceAccount(ctx, sa)
 	if err != nil {
 		return errors.Wrap(err, "deleting UserWorkload Prometheus ServiceAccount failed")
 	}
@@ -340,6 +342,6 @@ func (t *PrometheusUserWorkloadTask) destroy() error {
 		return errors.Wrap(err, "initializing UserWorkload serving certs CA Bundle ConfigMap failed")
 	}
 
-	err = t.client.DeleteConfigMap(cacm)
+	err = t.client.DeleteConfigMap(ctx, cacm)
 	return errors.Wrap(err, "deleting UserWorkload serving certs CA Bundle ConfigMap failed")
 }
@@ -23,14 +23,14 @@ func NewPrometheusAdapterTaks(namespace string, client *client.Client, factory *
 	}
 }
 
-func (t *PrometheusAdapterTask) Run() error {
+func (t *PrometheusAdapterTask) Run(ctx context.Context) error {
 	{
 		cr, err := t.factory.PrometheusAdapterClusterRole()
 		if err != nil {
 			return errors.Wrap(err, "initializing PrometheusAdapter ClusterRole failed")
 		}
 
-		err = t.client.CreateOrUpdateClusterRole(cr)
+		err = t.client.CreateOrUpdateClusterRole(ctx, cr)
 		if err != nil {
 			return errors.Wrap(err, "reconciling PrometheusAdapter ClusterRole failed")
 		}
@@ -41,7 +41,7 @@ func (t *PrometheusAdapterTask) Run() error {
 			return errors.Wrap(err, "initializing PrometheusAdapter ClusterRole for server resources failed")
 		}
 
-		err = t.client.CreateOrUpdateClusterRole(cr)
+		err = t.client.CreateOrUpdateClusterRole(ctx, cr)
 		if err != nil {
 			return errors.Wrap(err, "reconciling PrometheusAdapter ClusterRole for server resources failed")
 		}
@@ -52,7 +52,7 @@ func (t *PrometheusAdapterTask) Run() error {
 			return errors.Wrap(err, "initializing PrometheusAdapter ClusterRole aggregating resource metrics read permissions failed")
 		}
 
-		err = t.client.CreateOrUpdateClusterRole(cr)
+		err = t.client.CreateOrUpdateClusterRole(ctx, cr)
 		if err != nil {
 			return errors.Wrap(err, "reconciling PrometheusAdapter ClusterRole aggregating resource metrics read permissions failed")
 		}
@@ -63,7 +63,7 @@ func (t *PrometheusAdapterTask) Run() error {
 			return errors.Wrap(err, "initializing PrometheusAdapter ClusterRoleBinding failed")
 		}
 
-		err = t.client.CreateOrUpdateClusterRoleBinding(crb)
+		err = t.client.CreateOrUpdateClusterRoleBinding(ctx, crb)
 		if err != nil {
 			return errors.Wrap(err, "reconciling PrometheusAdapter ClusterRoleBinding failed")
 		}
@@ -74,7 +74,7 @@ func (t *PrometheusAdapterTask) Run() error {
 			return errors.Wrap(err, "initializing PrometheusAdapter ClusterRoleBinding for delegator failed")
 		}
 
-		err = t.client.CreateOrUpdateClusterRoleBinding(crb)
+		err = t.client.CreateOrUpdateClusterRoleBinding(ctx, crb)
 		if err != nil {
 			return errors.Wrap(err, "reconciling PrometheusAdapter ClusterRoleBinding for delegator failed")
 		}
@@ -85,7 +85,7 @@ func (t *PrometheusAdapterTask) Run() error {
 			return errors.Wrap(err, "initializing PrometheusAdapter ClusterRoleBinding for view failed")
 		}
 
-		err = t.client.CreateOrUpdateClusterRoleBinding(crb)
+		err = t.client.CreateOrUpdateClusterRoleBinding(ctx, crb)
 		if err != nil {
 			return errors.Wrap(err, "reconciling PrometheusAdapter ClusterRoleBinding for view failed")
 		}
@@ -96,7 +96,7 @@ func (t *PrometheusAdapterTask) Run() error {
 			return errors.Wrap(err, "initializing PrometheusAdapter RoleBinding for auth-reader failed")
 		}
 
-		err = t.client.CreateOrUpdateRoleBinding(rb)
+		err = t.client.CreateOrUpdateRoleBinding(ctx, rb)
 		if err != nil {
 			return errors.Wrap(err, "reconciling PrometheusAdapter RoleBinding for auth-reader failed")
 		}
@@ -107,7 +107,7 @@ func (t *PrometheusAdapterTask) Run() error {
 			return errors.Wrap(err, "initializing PrometheusAdapter ServiceAccount failed")
 		}
 
-		err = t.client.CreateOrUpdateServiceAccount(sa)
+		err = t.client.CreateOrUpdateServiceAccount(ctx, sa)
 		if err != nil {
 			return errors.Wrap(err, "reconciling PrometheusAdapter ServiceAccount failed")
 		}
@@ -118,7 +118,7 @@ func (t *PrometheusAdapterTask) Run() error {
 			return errors.Wrap(err, "initializing PrometheusAdapter ConfigMap failed")
 		}
 
-		err = t.client.CreateOrUpdateConfigMap(cm)
+		err = t.client.CreateOrUpdateConfigMap(ctx, cm)
 		if err != nil {
 			return errors.Wrap(err, "reconciling PrometheusAdapter ConfigMap failed")
 		}
@@ -129,7 +129,7 @@ func (t *PrometheusAdapterTask) Run() error {
 			return errors.Wrap(err, "initializing PrometheusAdapter ConfigMap for Prometheus failed")
 		}
 
-		err = t.client.CreateOrUpdateConfigMap(cm)
+		err = t.client.CreateOrUpdateConfigMap(ctx, cm)
 		if err != nil {
 			return errors.Wrap(err, "reconciling PrometheusAdapter ConfigMap for Prometheus failed")
 		}
@@ -140,18 +140,18 @@ func (t *PrometheusAdapterTask) Run() error {
 			return errors.Wrap(err, "initializing PrometheusAdapter Service failed")
 		}
 
-		err = t.client.CreateOrUpdateService(s)
+		err = t.client.CreateOrUpdateService(ctx, s)
 		if err != nil {
 			return errors.Wrap(err, "reconciling PrometheusAdapter Service failed")
 		}
 	}
 	{
-		tlsSecret, err := t.client.GetSecret(t.namespace, "prometheus-adapter-tls")
+		tlsSecret, err := t.client.GetSecret(ctx, t.namespace, "prometheus-adapter-tls")
 		if err != nil {
 			return errors.Wrap(err, "failed to load prometheus-adapter-tls secret")
 		}
 
-		apiAuthConfigmap, err := t.client.GetConfigmap("kube-system", "extension-apiserver-authentication")
+		apiAuthConfigmap, err := t.client.GetConfigmap(ctx, "kube-system", "extension-apiserver-authentication")
 		if err != nil {
 			return errors.Wrap(err, "failed to load kube-system/extension-apiserver-authentication configmap")
 		}
@@ -161,12 +161,12 @@ func (t *PrometheusAdapterTask) Run() error {
 			return errors.Wrap(err, "failed to create prometheus adapter secret")
 		}
 
-		err = t.deleteOldPrometheusAdapterSecrets(string(secret.Labels["monitoring.openshift.io/hash"]))
+		err = t.deleteOldPrometheusAdapterSecrets(ctx, string(secret.Labels["monitoring.openshift.io/hash"]))
 		if err != nil {
 			return errors.Wrap(err, "deleting old prometheus adapter secrets failed")
 		}
 
-		err = t.client.CreateOrUpdateSecret(secret)
+		err = t.client.CreateOrUpdateSecret(ctx, secret)
 		if err != nil {
 			return errors.Wrap(err, "reconciling PrometheusAdapter Secret failed")
 		}
@@ -176,7 +176,7 @@ func (t *PrometheusAdapterTask) Run() error {
 			return errors.Wrap(err, "initializing PrometheusAdapter Deployment failed")
 		}
 
-		err = t.client.CreateOrUpdateDeployment(dep)
+		err = t.client.CreateOrUpdateDeployment(ctx, dep)
 		if err != nil {
 			return errors.Wrap(err, "reconciling PrometheusAdapter Deployment failed")
 		}
@@ -187,7 +187,7 @@ func (t *PrometheusAdapterTask) Run() error {
 			return errors.Wrap(err, "initializing PrometheusAdapter APIService failed")
 		}
 
-		err = t.client.CreateOrUpdateAPIService(api)
+		err = t.client.CreateOrUpdateAPIService(ctx, api)
 		if err != nil {
 			return errors.Wrap(err, "reconciling PrometheusAdapter APIService failed")
 		}
@@ -196,8 +196,8 @@ func (t *PrometheusAdapterTask) Run() error {
 	return nil
 }
 
-func (t *PrometheusAdapterTask) deleteOldPrometheusAdapterSecrets(newHash string) error {
-	secrets, err := t.client.KubernetesInterface().CoreV1().Secrets(t.namespace).List(context.TODO(), metav1.ListOptions{
+func (t *PrometheusAdapterTask) deleteOldPrometheusAdapterSecrets(ctx context.Context, newHash string) error {
+	secrets, err := t.client.KubernetesInterface().CoreV1().Secrets(t.namespace).List(ctx, metav1.ListOptions{
 		LabelSelector: "monitoring.openshift.io/name=prometheus-adapter,monitoring.openshift.io/hash!=" + newHash,
 	})
 
@@ -206,7 +206,7 @@ func (t *PrometheusAdapterTask) deleteOldPrometheusAdapterSecrets(newHash string
 	}
 
 	for i := range secrets.Items {
-		err := t.client.KubernetesInterface().CoreV1().Secrets(t.namespace).Delete(context.TODO(), secrets.Items[i].Name, metav1.DeleteOptions{})
+		err := t.client.KubernetesInterface().CoreV1().Secrets(t.namespace).Delete(ctx, secrets.Items[i].Name, metav1.DeleteOptions{})
 		if err != nil {
 			return errors.Wrapf(err, "error deleting secret: %s", secrets.Items[i].Name)
 		}
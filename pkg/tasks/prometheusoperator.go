@@ -15,6 +15,8 @@
 package tasks
 
 import (
+	"context"
+
 	"github.com/openshift/cluster-monitoring-operator/pkg/client"
 	"github.com/openshift/cluster-monitoring-operator/pkg/manifests"
 	"github.com/pkg/errors"
@@ -32,13 +34,13 @@ func NewPrometheusOperatorTask(client *client.Client, factory *manifests.Factory
 	}
 }
 
-func (t *PrometheusOperatorTask) Run() error {
+func (t *PrometheusOperatorTask) Run(ctx context.Context) error {
 	cacm, err := t.factory.PrometheusOperatorCertsCABundle()
 	if err != nil {
 		return errors.Wrap(err, "initializing serving certs CA Bundle ConfigMap failed")
 	}
 
-	_, err = t.client.CreateIfNotExistConfigMap(cacm)
+	_, err = t.client.CreateIfNotExistConfigMap(ctx, cacm)
 	if err != nil {
 		return errors.Wrap(err, "creating serving certs CA Bundle ConfigMap failed")
 	}
@@ -48,7 +50,7 @@ func (t *PrometheusOperatorTask) Run() error {
 		return errors.Wrap(err, "initializing Prometheus Operator ServiceAccount failed")
 	}
 
-	err = t.client.CreateOrUpdateServiceAccount(sa)
+	err = t.client.CreateOrUpdateServiceAccount(ctx, sa)
 	if err != nil {
 		return errors.Wrap(err, "reconciling Prometheus Operator ServiceAccount failed")
 	}
@@ -58,7 +60,7 @@ func (t *PrometheusOperatorTask) Run() error {
 		return errors.Wrap(err, "initializing Prometheus Operator ClusterRole failed")
 	}
 
-	err = t.client.CreateOrUpdateClusterRole(cr)
+	err = t.client.CreateOrUpdateClusterRole(ctx, cr)
 	if err != nil {
 		return errors.Wrap(err, "reconciling Prometheus Operator ClusterRole failed")
 	}
@@ -68,7 +70,7 @@ func (t *PrometheusOperatorTask) Run() error {
 		return errors.Wrap(err, "initializing Prometheus Operator ClusterRoleBinding failed")
 	}
 
-	err = t.client.CreateOrUpdateClusterRoleBinding(crb)
+	err = t.client.CreateOrUpdateClusterRoleBinding(ctx, crb)
 	if err != nil {
 		return errors.Wrap(err, "reconciling Prometheus Operator ClusterRoleBinding failed")
 	}
@@ -78,12 +80,12 @@ func (t *PrometheusOperatorTask) Run() error {
 		return errors.Wrap(err, "initializing Prometheus Operator Service failed")
 	}
 
-	err = t.client.CreateOrUpdateService(svc)
+	err = t.client.CreateOrUpdateService(ctx, svc)
 	if err != nil {
 		return errors.Wrap(err, "reconciling Prometheus Operator Service failed")
 	}
 
-	clusterNamespaces, err := t.client.NamespacesToMonitor()
+	clusterNamespaces, err := t.client.NamespacesToMonitor(ctx)
 	if err != nil {
 		return errors.Wrap(err, "listing namespaces to monitor failed")
 	}
@@ -93,12 +95,12 @@ func (t *PrometheusOperatorTask) Run() error {
 		return errors.Wrap(err, "initializing Prometheus Operator Deployment failed")
 	}
 
-	err = t.client.CreateOrUpdateDeployment(d)
+	err = t.client.CreateOrUpdateDeployment(ctx, d)
 	if err != nil {
 		return errors.Wrap(err, "reconciling Prometheus Operator Deployment failed")
 	}
 
-	err = t.client.AssurePrometheusOperatorCRsExist()
+	err = t.client.AssurePrometheusOperatorCRsExist(ctx)
 	if err != nil {
 		return errors.Wrap(err, "waiting for Prometheus Operator CRs to become available failed")
 	}
@@ -108,7 +110,7 @@ func (t *PrometheusOperatorTask) Run() error {
 		return errors.Wrap(err, "initializing Prometheus Rule Validating Webhook failed")
 	}
 
-	err = t.client.CreateOrUpdateValidatingWebhookConfiguration(w)
+	err = t.client.CreateOrUpdateValidatingWebhookConfiguration(ctx, w)
 	if err != nil {
 		return errors.Wrap(err, "reconciling Prometheus Rule Validating Webhook failed")
 	}
@@ -118,6 +120,6 @@ func (t *PrometheusOperatorTask) Run() error {
 		return errors.Wrap(err, "initializing Prometheus Operator ServiceMonitor failed")
 	}
 
-	err = t.client.CreateOrUpdateServiceMonitor(smpo)
+	err = t.client.CreateOrUpdateServiceMonitor(ctx, smpo)
 	return errors.Wrap(err, "reconciling Prometheus Operator ServiceMonitor failed")
 }
@@ -15,6 +15,8 @@
 package tasks
 
 import (
+	"context"
+
 	"github.com/openshift/cluster-monitoring-operator/pkg/client"
 	"github.com/openshift/cluster-monitoring-operator/pkg/manifests"
 
@@ -35,21 +37,21 @@ func NewPrometheusOperatorUserWorkloadTask(client *client.Client, factory *manif
 	}
 }
 
-func (t *PrometheusOperatorUserWorkloadTask) Run() error {
+func (t *PrometheusOperatorUserWorkloadTask) Run(ctx context.Context) error {
 	if t.config.IsUserWorkloadEnabled() {
-		return t.create()
+		return t.create(ctx)
 	}
 
-	return t.destroy()
+	return t.destroy(ctx)
 }
 
-func (t *PrometheusOperatorUserWorkloadTask) create() error {
+func (t *PrometheusOperatorUserWorkloadTask) create(ctx context.Context) error {
 	sa, err := t.factory.PrometheusOperatorUserWorkloadServiceAccount()
 	if err != nil {
 		return errors.Wrap(err, "initializing UserWorkload Prometheus Operator ServiceAccount failed")
 	}
 
-	err = t.client.CreateOrUpdateServiceAccount(sa)
+	err = t.client.CreateOrUpdateServiceAccount(ctx, sa)
 	if err != nil {
 		return errors.Wrap(err, "reconciling UserWorkload Prometheus Operator ServiceAccount failed")
 	}
@@ -59,7 +61,7 @@ func (t *PrometheusOperatorUserWorkloadTask) create() error {
 		return errors.Wrap(err, "initializing UserWorkload Prometheus Operator ClusterRole failed")
 	}
 
-	err = t.client.CreateOrUpdateClusterRole(cr)
+	err = t.client.CreateOrUpdateClusterRole(ctx, cr)
 	if err != nil {
 		return errors.Wrap(err, "reconciling UserWorkload Prometheus Operator ClusterRole failed")
 	}
@@ -69,7 +71,7 @@ func (t *PrometheusOperatorUserWorkloadTask) create() error {
 		return errors.Wrap(err, "initializing UserWorkload Prometheus Operator ClusterRoleBinding failed")
 	}
 
-	err = t.client.CreateOrUpdateClusterRoleBinding(crb)
+	err = t.client.CreateOrUpdateClusterRoleBinding(ctx, crb)
 	if err != nil {
 		return errors.Wrap(err, "reconciling UserWorkload Prometheus Operator ClusterRoleBinding failed")
 	}
@@ -79,12 +81,12 @@ func (t *PrometheusOperatorUserWorkloadTask) create() error {
 		return errors.Wrap(err, "initializing UserWorkload Prometheus Operator Service failed")
 	}
 
-	err = t.client.CreateOrUpdateService(svc)
+	err = t.client.CreateOrUpdateService(ctx, svc)
 	if err != nil {
 		return errors.Wrap(err, "reconciling UserWorkload Prometheus Operator Service failed")
 	}
 
-	denyNamespaces, err := t.client.NamespacesToMonitor()
+	denyNamespaces, err := t.client.NamespacesToMonitor(ctx)
 	if err != nil {
 		return errors.Wrap(err, "initializing UserWorkload denied namespaces list failed")
 	}
@@ -94,14 +96,14 @@ func (t *PrometheusOperatorUserWorkloadTask) create() error {
 		return errors.Wrap(err, "initializing UserWorkload Prometheus Operator Deployment failed")
 	}
 
-	err = t.client.CreateOrUpdateDeployment(d)
+	err = t.client.CreateOrUpdateDeployment(ctx, d)
 	if err != nil {
 		return errors.Wrap(err, "reconciling UserWorkload Prometheus Operator Deployment failed")
 	}
 
 	// The CRs will be created externally,
 	// but we still have to wait for them here.
-	err = t.client.AssurePrometheusOperatorCRsExist()
+	err = t.client.AssurePrometheusOperatorCRsExist(ctx)
 	if err != nil {
 		return errors.Wrap(err, "waiting for Prometheus Operator CRs to become available failed")
 	}
@@ -111,17 +113,17 @@ func (t *PrometheusOperatorUserWorkloadTask) create() error {
 		return errors.Wrap(err, "initializing UserWorkload Prometheus Operator ServiceMonitor failed")
 	}
 
-	err = t.client.CreateOrUpdateServiceMonitor(smpo)
+	err = t.client.CreateOrUpdateServiceMonitor(ctx, smpo)
 	return errors.Wrap(err, "reconciling UserWorkload Prometheus Operator ServiceMonitor failed")
 }
 
-func (t *PrometheusOperatorUserWorkloadTask) destroy() error {
+func (t *PrometheusOperatorUserWorkloadTask) destroy(ctx context.Context) error {
 	dep, err := t.factory.PrometheusOperatorUserWorkloadDeployment(nil)
 	if err != nil {
 		return errors.Wrap(err, "initializing UserWorkload Prometheus Operator Deployment failed")
 	}
 
-	err = t.client.DeleteDeployment(dep)
+	err = t.client.DeleteDeployment(ctx, dep)
 	if err != nil {
 		return errors.Wrap(err, "deleting UserWorkload Prometheus Operator Deployment failed")
 	}
@@ -131,7 +133,7 @@ func (t *PrometheusOperatorUserWorkloadTask) destroy() error {
 		return errors.Wrap(err, "initializing UserWorkload Prometheus Operator ServiceMonitor failed")
 	}
 
-	err = t.client.DeleteServiceMonitor(sm)
+	err = t.client.DeleteServiceMonitor(ctx, sm)
 	if err != nil {
 		return errors.Wrap(err, "deleting UserWorkload Prometheus Operator ServiceMonitor failed")
 	}
@@ -141,7 +143,7 @@ func (t *PrometheusOperatorUserWorkloadTask) destroy() error {
 		return errors.Wrap(err, "initializing UserWorkload Prometheus Operator Service failed")
 	}
 
-	err = t.client.DeleteService(svc)
+	err = t.client.DeleteService(ctx, svc)
 	if err != nil {
 		return errors.Wrap(err, "deleting UserWorkload Prometheus Operator Service failed")
 	}
@@ -151,7 +153,7 @@ func (t *PrometheusOperatorUserWorkloadTask) destroy() error {
 		return errors.Wrap(err, "initializing UserWorkload Prometheus Operator ClusterRoleBinding failed")
 	}
 
-	err = t.client.DeleteClusterRoleBinding(crb)
+	err = t.client.DeleteClusterRoleBinding(ctx, crb)
 	if err != nil {
 		return errors.Wrap(err, "deleting UserWorkload Prometheus Operator ClusterRoleBinding failed")
 	}
@@ -161,7 +163,7 @@ func (t *PrometheusOperatorUserWorkloadTask) destroy() error {
 		return errors.Wrap(err, "initializing UserWorkload Prometheus Operator ClusterRole failed")
 	}
 
-	err = t.client.DeleteClusterRole(cr)
+	err = t.client.DeleteClusterRole(ctx, cr)
 	if err != nil {
 		return errors.Wrap(err, "deleting UserWorkload Prometheus Operator ClusterRoleBinding failed")
 	}
@@ -171,6 +173,6 @@ func (t *PrometheusOperatorUserWorkloadTask) destroy() error {
 		return errors.Wrap(err, "initializing UserWorkload Prometheus Operator ServiceAccount failed")
 	}
 
-	err = t.client.DeleteServiceAccount(sa)
+	err = t.client.DeleteServiceAccount(ctx, sa)
 	return errors.Wrap(err, "deleting Telemeter client ServiceAccount failed")
 }
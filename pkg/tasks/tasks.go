@@ -15,9 +15,14 @@
 package tasks
 
 import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
 	"github.com/openshift/cluster-monitoring-operator/pkg/client"
 	"github.com/pkg/errors"
-	"golang.org/x/sync/errgroup"
 	"k8s.io/klog"
 )
 
@@ -33,56 +38,218 @@ func NewTaskRunner(client *client.Client, tasks []*TaskSpec) *TaskRunner {
 	}
 }
 
-func (tl *TaskRunner) RunAll() (string, error) {
-	var g errgroup.Group
+// RunAll runs the tasks as a directed acyclic graph. A task is started as
+// soon as all of its dependencies succeeded and is skipped if any of them
+// failed or was skipped. Tasks not depending on a failed task keep running.
+// Tasks which haven't started when ctx is done are skipped.
+// It returns the result of every task in list order along with an error
+// aggregating all failed and skipped tasks.
+func (tl *TaskRunner) RunAll(ctx context.Context) (TaskResults, error) {
+	if err := tl.validate(); err != nil {
+		return nil, err
+	}
+
+	results := make(TaskResults, len(tl.tasks))
+	index := make(map[*TaskSpec]int, len(tl.tasks))
+	done := make([]chan struct{}, len(tl.tasks))
+	for i, ts := range tl.tasks {
+		results[i].Name = ts.Name
+		index[ts] = i
+		done[i] = make(chan struct{})
+	}
+
+	var wg sync.WaitGroup
 	for i, ts := range tl.tasks {
 		// shadow vars due to concurrency
 		ts := ts
 		i := i
 
-		g.Go(func() error {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			defer close(done[i])
+			res := &results[i]
+
+			for _, dep := range ts.Dependencies {
+				j := index[dep]
+				<-done[j]
+				if results[j].Err != nil {
+					klog.Warningf("skipping task %d of %d: %v, prerequisite task %v did not succeed", i+1, len(tl.tasks), ts.Name, dep.Name)
+					res.Skipped = true
+					res.Err = errors.Errorf("skipped because prerequisite task %v did not succeed", dep.Name)
+					return
+				}
+			}
+
+			if err := ctx.Err(); err != nil {
+				res.Skipped = true
+				res.Err = errors.Wrap(err, "skipped because the run was cancelled")
+				return
+			}
+
 			klog.V(3).Infof("running task %d of %d: %v", i+1, len(tl.tasks), ts.Name)
-			err := tl.ExecuteTask(ts)
+			start := time.Now()
+			res.Err = tl.ExecuteTask(ctx, ts)
+			res.Duration = time.Since(start)
 			klog.V(3).Infof("ran task %d of %d: %v", i+1, len(tl.tasks), ts.Name)
-			if err != nil {
-				return taskErr{error: errors.Wrapf(err, "running task %v failed", ts.Name), name: ts.Name}
+		}()
+	}
+	wg.Wait()
+
+	return results, results.Err()
+}
+
+// RunSerially runs the tasks one at a time, each one after all of its
+// dependencies and otherwise in list order. It stops at the first failing
+// task and calls done after every task which succeeded.
+func (tl *TaskRunner) RunSerially(ctx context.Context, done func(*TaskSpec)) error {
+	if err := tl.validate(); err != nil {
+		return err
+	}
+
+	ran := make(map[*TaskSpec]bool, len(tl.tasks))
+	for len(ran) < len(tl.tasks) {
+		for _, ts := range tl.tasks {
+			if ran[ts] || !allRan(ran, ts.Dependencies) {
+				continue
+			}
+
+			klog.V(3).Infof("running task %d of %d: %v", len(ran)+1, len(tl.tasks), ts.Name)
+			if err := tl.ExecuteTask(ctx, ts); err != nil {
+				return errors.Wrapf(err, "running task %v failed", ts.Name)
 			}
+			ran[ts] = true
+			done(ts)
+			break
+		}
+	}
+
+	return nil
+}
+
+func allRan(ran map[*TaskSpec]bool, specs []*TaskSpec) bool {
+	for _, ts := range specs {
+		if !ran[ts] {
+			return false
+		}
+	}
+	return true
+}
+
+// validate ensures that all dependencies are scheduled by the runner and
+// that they don't form a cycle.
+func (tl *TaskRunner) validate() error {
+	const (
+		unvisited = iota
+		visiting
+		visited
+	)
+
+	state := make(map[*TaskSpec]int, len(tl.tasks))
+	for _, ts := range tl.tasks {
+		state[ts] = unvisited
+	}
+
+	var visit func(ts *TaskSpec) error
+	visit = func(ts *TaskSpec) error {
+		switch state[ts] {
+		case visiting:
+			return errors.Errorf("task %v has a cyclic dependency", ts.Name)
+		case visited:
 			return nil
-		})
+		}
+
+		state[ts] = visiting
+		for _, dep := range ts.Dependencies {
+			if _, ok := state[dep]; !ok {
+				return errors.Errorf("task %v depends on task %v which is not scheduled", ts.Name, dep.Name)
+			}
+			if err := visit(dep); err != nil {
+				return err
+			}
+		}
+		state[ts] = visited
+		return nil
 	}
-	if err := g.Wait(); err != nil {
-		taskName := ""
-		if tErr, ok := err.(taskErr); ok {
-			taskName = tErr.name
-			err = tErr.error
 
+	for _, ts := range tl.tasks {
+		if err := visit(ts); err != nil {
+			return err
 		}
-		return taskName, err
 	}
-	return "", nil
+	return nil
 }
 
-func (tl *TaskRunner) ExecuteTask(ts *TaskSpec) error {
-	return ts.Task.Run()
+// ExecuteTask runs the task, cancelling it once its timeout is exceeded.
+func (tl *TaskRunner) ExecuteTask(ctx context.Context, ts *TaskSpec) error {
+	if ts.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, ts.Timeout)
+		defer cancel()
+	}
+	return ts.Task.Run(ctx)
 }
 
-func NewTaskSpec(name string, task Task) *TaskSpec {
+// NewTaskSpec returns a task spec which is run once all given dependencies
+// have succeeded.
+func NewTaskSpec(name string, task Task, dependencies ...*TaskSpec) *TaskSpec {
 	return &TaskSpec{
-		Name: name,
-		Task: task,
+		Name:         name,
+		Task:         task,
+		Dependencies: dependencies,
 	}
 }
 
 type TaskSpec struct {
-	Name string
-	Task Task
+	Name         string
+	Task         Task
+	Dependencies []*TaskSpec
+	// Timeout is the deadline for running the task. No deadline is set if
+	// it is zero.
+	Timeout time.Duration
 }
 
 type Task interface {
-	Run() error
+	Run(ctx context.Context) error
+}
+
+// TaskResult is the outcome of a single task run.
+type TaskResult struct {
+	Name string
+	// Err is set if the task failed or was skipped.
+	Err error
+	// Skipped is true if the task didn't run because one of its
+	// dependencies didn't succeed.
+	Skipped bool
+	// Duration is the time it took to run the task.
+	Duration time.Duration
+}
+
+// TaskResults holds the outcome of all tasks of a runner in list order.
+type TaskResults []TaskResult
+
+// Failed returns the results of the tasks which ran and failed.
+func (rs TaskResults) Failed() TaskResults {
+	var failed TaskResults
+	for _, r := range rs {
+		if r.Err != nil && !r.Skipped {
+			failed = append(failed, r)
+		}
+	}
+	return failed
 }
 
-type taskErr struct {
-	error
-	name string
+// Err returns an error listing every failed and skipped task with its own
+// error, or nil if all tasks succeeded.
+func (rs TaskResults) Err() error {
+	var msgs []string
+	for _, r := range rs {
+		if r.Err != nil {
+			msgs = append(msgs, fmt.Sprintf("%v: %v", r.Name, r.Err))
+		}
+	}
+	if len(msgs) == 0 {
+		return nil
+	}
+	return errors.New(strings.Join(msgs, "\n"))
 }
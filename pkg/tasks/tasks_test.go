@@ -0,0 +1,215 @@
+// Copyright 2020 The Cluster Monitoring Operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tasks
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// recorder records the order in which tasks are run.
+type recorder struct {
+	mtx sync.Mutex
+	ran []string
+}
+
+func (r *recorder) task(name string, err error) Task {
+	return taskFunc(func(context.Context) error {
+		r.mtx.Lock()
+		defer r.mtx.Unlock()
+		r.ran = append(r.ran, name)
+		return err
+	})
+}
+
+func (r *recorder) index(name string) int {
+	for i, n := range r.ran {
+		if n == name {
+			return i
+		}
+	}
+	return -1
+}
+
+type taskFunc func(context.Context) error
+
+func (f taskFunc) Run(ctx context.Context) error {
+	return f(ctx)
+}
+
+func TestRunAllOrdersDependencies(t *testing.T) {
+	r := &recorder{}
+	a := NewTaskSpec("a", r.task("a", nil))
+	b := NewTaskSpec("b", r.task("b", nil), a)
+	c := NewTaskSpec("c", r.task("c", nil), a, b)
+
+	results, err := NewTaskRunner(nil, []*TaskSpec{c, b, a}).RunAll(context.Background())
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(results) != 3 || results[0].Name != "c" || results[2].Name != "a" {
+		t.Fatalf("expected results in list order, got %v", results)
+	}
+
+	if len(r.ran) != 3 {
+		t.Fatalf("expected 3 tasks to run, got %v", r.ran)
+	}
+	if !(r.index("a") < r.index("b") && r.index("b") < r.index("c")) {
+		t.Fatalf("expected tasks to run in dependency order, got %v", r.ran)
+	}
+}
+
+func TestRunAllSkipsDependentsOfFailedTasks(t *testing.T) {
+	r := &recorder{}
+	a := NewTaskSpec("a", r.task("a", errors.New("boom")))
+	b := NewTaskSpec("b", r.task("b", nil), a)
+	c := NewTaskSpec("c", r.task("c", nil), b)
+	d := NewTaskSpec("d", r.task("d", nil))
+
+	results, err := NewTaskRunner(nil, []*TaskSpec{a, b, c, d}).RunAll(context.Background())
+	if err == nil || !strings.Contains(err.Error(), "a: boom") {
+		t.Fatalf("expected error of task a, got %v", err)
+	}
+
+	for i, expected := range []struct {
+		failed  bool
+		skipped bool
+	}{
+		{failed: true},
+		{failed: true, skipped: true},
+		{failed: true, skipped: true},
+		{},
+	} {
+		if (results[i].Err != nil) != expected.failed || results[i].Skipped != expected.skipped {
+			t.Errorf("unexpected result for task %q: %+v", results[i].Name, results[i])
+		}
+	}
+
+	if r.index("b") != -1 || r.index("c") != -1 {
+		t.Fatalf("expected dependent tasks to be skipped, got %v", r.ran)
+	}
+	if r.index("d") == -1 {
+		t.Fatalf("expected independent task to run, got %v", r.ran)
+	}
+}
+
+func TestRunAllAggregatesFailures(t *testing.T) {
+	r := &recorder{}
+	a := NewTaskSpec("a", r.task("a", errors.New("first")))
+	b := NewTaskSpec("b", r.task("b", nil))
+	c := NewTaskSpec("c", r.task("c", errors.New("second")))
+
+	results, err := NewTaskRunner(nil, []*TaskSpec{a, b, c}).RunAll(context.Background())
+	if err == nil {
+		t.Fatal("expected an error")
+	}
+	for _, e := range []string{"a: first", "c: second"} {
+		if !strings.Contains(err.Error(), e) {
+			t.Errorf("expected error to contain %q, got %v", e, err)
+		}
+	}
+
+	failed := results.Failed()
+	if len(failed) != 2 || failed[0].Name != "a" || failed[1].Name != "c" {
+		t.Fatalf("expected tasks a and c to fail, got %v", failed)
+	}
+}
+
+func TestRunAllValidatesGraph(t *testing.T) {
+	r := &recorder{}
+	unscheduled := NewTaskSpec("unscheduled", r.task("unscheduled", nil))
+	a := NewTaskSpec("a", r.task("a", nil), unscheduled)
+
+	_, err := NewTaskRunner(nil, []*TaskSpec{a}).RunAll(context.Background())
+	if err == nil || !strings.Contains(err.Error(), "not scheduled") {
+		t.Fatalf("expected unscheduled dependency error, got %v", err)
+	}
+
+	b := NewTaskSpec("b", r.task("b", nil))
+	c := NewTaskSpec("c", r.task("c", nil), b)
+	b.Dependencies = append(b.Dependencies, c)
+
+	_, err = NewTaskRunner(nil, []*TaskSpec{b, c}).RunAll(context.Background())
+	if err == nil || !strings.Contains(err.Error(), "cyclic") {
+		t.Fatalf("expected cyclic dependency error, got %v", err)
+	}
+
+	if len(r.ran) != 0 {
+		t.Fatalf("expected no task to run for an invalid graph, got %v", r.ran)
+	}
+}
+
+func TestRunAllCancellation(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	r := &recorder{}
+	a := NewTaskSpec("a", taskFunc(func(context.Context) error {
+		cancel()
+		return nil
+	}))
+	b := NewTaskSpec("b", r.task("b", nil), a)
+
+	results, err := NewTaskRunner(nil, []*TaskSpec{a, b}).RunAll(ctx)
+	if err == nil || !strings.Contains(err.Error(), "cancelled") {
+		t.Fatalf("expected cancellation error, got %v", err)
+	}
+	if !results[1].Skipped {
+		t.Fatalf("expected task b to be skipped, got %+v", results[1])
+	}
+	if len(r.ran) != 0 {
+		t.Fatalf("expected no task to run after cancellation, got %v", r.ran)
+	}
+}
+
+func TestRunAllTimeout(t *testing.T) {
+	a := NewTaskSpec("a", taskFunc(func(ctx context.Context) error {
+		<-ctx.Done()
+		return ctx.Err()
+	}))
+	a.Timeout = time.Millisecond
+
+	_, err := NewTaskRunner(nil, []*TaskSpec{a}).RunAll(context.Background())
+	if err == nil || !strings.Contains(err.Error(), context.DeadlineExceeded.Error()) {
+		t.Fatalf("expected deadline exceeded error, got %v", err)
+	}
+}
+
+func TestRunSerially(t *testing.T) {
+	r := &recorder{}
+	a := NewTaskSpec("a", r.task("a", nil))
+	b := NewTaskSpec("b", r.task("b", nil), a)
+	c := NewTaskSpec("c", r.task("c", nil))
+	d := NewTaskSpec("d", r.task("d", errors.New("boom")), b)
+	e := NewTaskSpec("e", r.task("e", nil))
+
+	var done []string
+	err := NewTaskRunner(nil, []*TaskSpec{b, c, a, d, e}).RunSerially(context.Background(), func(ts *TaskSpec) {
+		done = append(done, ts.Name)
+	})
+	if err == nil || !strings.Contains(err.Error(), "boom") {
+		t.Fatalf("expected the error of task d, got %v", err)
+	}
+
+	if strings.Join(r.ran, ",") != "c,a,b,d" {
+		t.Fatalf("expected tasks to run in list order after their dependencies, got %v", r.ran)
+	}
+	if strings.Join(done, ",") != "c,a,b" {
+		t.Fatalf("expected done to be called for the succeeded tasks, got %v", done)
+	}
+}
@@ -0,0 +1,31 @@
+// Copyright 2020 The Cluster Monitoring Operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tasks
+
+import "testing"
+
+func TestGenerateTelemeterWhitelistRec(t *testing.T) {
+	rec, err := GenerateTelemeterWhitelistRec([]string{`{__name__="ALERTS",alertstate!="pending"}`})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if expected := `count({__name__="ALERTS",alertstate!="pending"})`; rec != expected {
+		t.Fatalf("expected %s, got %s", expected, rec)
+	}
+
+	if _, err := GenerateTelemeterWhitelistRec([]string{`{__name__=`}); err == nil {
+		t.Fatal("expected an error for an invalid match")
+	}
+}
@@ -15,7 +15,7 @@
 package tasks
 
 import (
-	"encoding/json"
+	"context"
 
 	"github.com/openshift/cluster-monitoring-operator/pkg/client"
 	"github.com/openshift/cluster-monitoring-operator/pkg/manifests"
@@ -36,13 +36,13 @@ func NewThanosQuerierTask(client *client.Client, factory *manifests.Factory, cfg
 	}
 }
 
-func (t *ThanosQuerierTask) Run() error {
+func (t *ThanosQuerierTask) Run(ctx context.Context) error {
 	svc, err := t.factory.ThanosQuerierService()
 	if err != nil {
 		return errors.Wrap(err, "initializing Thanos Querier Service failed")
 	}
 
-	err = t.client.CreateOrUpdateService(svc)
+	err = t.client.CreateOrUpdateService(ctx, svc)
 	if err != nil {
 		return errors.Wrap(err, "reconciling Thanos Querier Service failed")
 	}
@@ -52,12 +52,12 @@ func (t *ThanosQuerierTask) Run() error {
 		return errors.Wrap(err, "initializing Thanos Querier Route failed")
 	}
 
-	err = t.client.CreateRouteIfNotExists(r)
+	err = t.client.CreateRouteIfNotExists(ctx, r)
 	if err != nil {
 		return errors.Wrap(err, "creating Thanos Querier Route failed")
 	}
 
-	_, err = t.client.WaitForRouteReady(r)
+	_, err = t.client.WaitForRouteReady(ctx, r)
 	if err != nil {
 		return errors.Wrap(err, "waiting for Thanos Querier Route to become ready failed")
 	}
@@ -67,9 +67,9 @@ func (t *ThanosQuerierTask) Run() error {
 		return errors.Wrap(err, "initializing Thanos Querier OAuth Cookie Secret failed")
 	}
 
-	err = t.client.CreateIfNotExistSecret(s)
+	cs, err := t.client.CreateOrRotateSecret(ctx, s, t.factory.RotateGeneratedSecret(s))
 	if err != nil {
-		return errors.Wrap(err, "creating Thanos Querier OAuth Cookie Secret failed")
+		return errors.Wrap(err, "reconciling Thanos Querier OAuth Cookie Secret failed")
 	}
 
 	gs, err := t.factory.GrafanaDatasources()
@@ -77,25 +77,19 @@ func (t *ThanosQuerierTask) Run() error {
 		return errors.Wrap(err, "initializing Grafana Datasources Secret failed")
 	}
 
-	gs, err = t.client.WaitForSecret(gs)
+	gs, err = t.client.WaitForSecret(ctx, gs)
 	if err != nil {
 		return errors.Wrap(err, "waiting for Grafana Datasources Secret failed")
 	}
 
-	d := &manifests.GrafanaDatasources{}
-	err = json.Unmarshal(gs.Data["prometheus.yaml"], d)
-	if err != nil {
-		return errors.Wrap(err, "unmarshalling grafana datasource failed")
-	}
-
-	hs, err := t.factory.ThanosQuerierHtpasswdSecret(d.Datasources[0].BasicAuthPassword)
+	hs, err := t.factory.ThanosQuerierHtpasswdSecret(gs)
 	if err != nil {
 		return errors.Wrap(err, "initializing Thanos Querier htpasswd Secret failed")
 	}
 
-	err = t.client.CreateIfNotExistSecret(hs)
+	err = t.client.CreateOrUpdateSecret(ctx, hs)
 	if err != nil {
-		return errors.Wrap(err, "creating Thanos Querier htpasswd Secret failed")
+		return errors.Wrap(err, "reconciling Thanos Querier htpasswd Secret failed")
 	}
 
 	rs, err := t.factory.ThanosQuerierRBACProxySecret()
@@ -103,7 +97,7 @@ func (t *ThanosQuerierTask) Run() error {
 		return errors.Wrap(err, "initializing Thanos Querier RBAC proxy Secret failed")
 	}
 
-	err = t.client.CreateIfNotExistSecret(rs)
+	err = t.client.CreateIfNotExistSecret(ctx, rs)
 	if err != nil {
 		return errors.Wrap(err, "creating Thanos Querier RBAC proxy Secret failed")
 	}
@@ -113,7 +107,7 @@ func (t *ThanosQuerierTask) Run() error {
 		return errors.Wrap(err, "initializing Thanos Querier RBAC proxy rules Secret failed")
 	}
 
-	err = t.client.CreateIfNotExistSecret(rs)
+	err = t.client.CreateIfNotExistSecret(ctx, rs)
 	if err != nil {
 		return errors.Wrap(err, "creating Thanos Querier RBAC proxy rules Secret failed")
 	}
@@ -123,7 +117,7 @@ func (t *ThanosQuerierTask) Run() error {
 		return errors.Wrap(err, "initializing Thanos Querier ServiceAccount failed")
 	}
 
-	err = t.client.CreateOrUpdateServiceAccount(sa)
+	err = t.client.CreateOrUpdateServiceAccount(ctx, sa)
 	if err != nil {
 		return errors.Wrap(err, "reconciling Thanos Querier ServiceAccount failed")
 	}
@@ -133,7 +127,7 @@ func (t *ThanosQuerierTask) Run() error {
 		return errors.Wrap(err, "initializing Thanos Querier ClusterRole failed")
 	}
 
-	err = t.client.CreateOrUpdateClusterRole(cr)
+	err = t.client.CreateOrUpdateClusterRole(ctx, cr)
 	if err != nil {
 		return errors.Wrap(err, "reconciling Thanos Querier ClusterRole failed")
 	}
@@ -143,7 +137,7 @@ func (t *ThanosQuerierTask) Run() error {
 		return errors.Wrap(err, "initializing Thanos Querier ClusterRoleBinding failed")
 	}
 
-	err = t.client.CreateOrUpdateClusterRoleBinding(crb)
+	err = t.client.CreateOrUpdateClusterRoleBinding(ctx, crb)
 	if err != nil {
 		return errors.Wrap(err, "reconciling Thanos Querier ClusterRoleBinding failed")
 	}
@@ -153,7 +147,7 @@ func (t *ThanosQuerierTask) Run() error {
 		return errors.Wrap(err, "initializing Thanos Querier GRPC secret failed")
 	}
 
-	grpcTLS, err = t.client.WaitForSecret(grpcTLS)
+	grpcTLS, err = t.client.WaitForSecret(ctx, grpcTLS)
 	if err != nil {
 		return errors.Wrap(err, "waiting for Thanos Querier GRPC secret failed")
 	}
@@ -172,12 +166,12 @@ func (t *ThanosQuerierTask) Run() error {
 		return errors.Wrap(err, "error hashing Thanos Querier Client GRPC TLS secret")
 	}
 
-	err = t.client.CreateOrUpdateSecret(s)
+	err = t.client.CreateOrUpdateSecret(ctx, s)
 	if err != nil {
 		return errors.Wrap(err, "error creating Thanos Querier Client GRPC TLS secret")
 	}
 
-	err = t.client.DeleteHashedSecret(
+	err = t.client.DeleteHashedSecret(ctx,
 		s.GetNamespace(),
 		"thanos-querier-grpc-tls",
 		string(s.Labels["monitoring.openshift.io/hash"]),
@@ -198,7 +192,7 @@ func (t *ThanosQuerierTask) Run() error {
 			factory: t.factory,
 			prefix:  "thanos-querier",
 		}
-		trustedCA, err = cbs.syncTrustedCABundle(trustedCA)
+		trustedCA, err = cbs.syncTrustedCABundle(ctx, trustedCA)
 		if err != nil {
 			return errors.Wrap(err, "syncing Thanos Querier trusted CA bundle ConfigMap failed")
 		}
@@ -208,7 +202,10 @@ func (t *ThanosQuerierTask) Run() error {
 			return errors.Wrap(err, "initializing Thanos Querier Deployment failed")
 		}
 
-		err = t.client.CreateOrUpdateDeployment(dep)
+		// The proxy reads the generated secrets at startup.
+		dep.Spec.Template.Annotations = manifests.WithSecretsHash(dep.Spec.Template.Annotations, cs.Data["session_secret"], hs.Data["auth"])
+
+		err = t.client.CreateOrUpdateDeployment(ctx, dep)
 		if err != nil {
 			return errors.Wrap(err, "reconciling Thanos Querier Deployment failed")
 		}
@@ -219,7 +216,7 @@ func (t *ThanosQuerierTask) Run() error {
 		return errors.Wrap(err, "initializing Thanos Querier ServiceMonitor failed")
 	}
 
-	err = t.client.CreateOrUpdateServiceMonitor(tqsm)
+	err = t.client.CreateOrUpdateServiceMonitor(ctx, tqsm)
 	if err != nil {
 		return errors.Wrap(err, "reconciling Thanos Querier ServiceMonitor failed")
 	}
@@ -229,7 +226,7 @@ func (t *ThanosQuerierTask) Run() error {
 		return errors.Wrap(err, "initializing Thanos Querier PrometheusRule failed")
 	}
 
-	err = t.client.CreateOrUpdatePrometheusRule(tqpr)
+	err = t.client.CreateOrUpdatePrometheusRule(ctx, tqpr)
 	if err != nil {
 		return errors.Wrap(err, "reconciling Thanos Querier PrometheusRule failed")
 	}
@@ -15,6 +15,9 @@
 package tasks
 
 import (
+	"context"
+
+	monv1 "github.com/coreos/prometheus-operator/pkg/apis/monitoring/v1"
 	"github.com/openshift/cluster-monitoring-operator/pkg/client"
 	"github.com/openshift/cluster-monitoring-operator/pkg/manifests"
 	"github.com/pkg/errors"
@@ -34,21 +37,21 @@ func NewThanosRulerUserWorkloadTask(client *client.Client, factory *manifests.Fa
 	}
 }
 
-func (t *ThanosRulerUserWorkloadTask) Run() error {
+func (t *ThanosRulerUserWorkloadTask) Run(ctx context.Context) error {
 	if t.config.IsUserWorkloadEnabled() {
-		return t.create()
+		return t.create(ctx)
 	}
 
-	return t.destroy()
+	return t.destroy(ctx)
 }
 
-func (t *ThanosRulerUserWorkloadTask) create() error {
+func (t *ThanosRulerUserWorkloadTask) create(ctx context.Context) error {
 	svc, err := t.factory.ThanosRulerService()
 	if err != nil {
 		return errors.Wrap(err, "initializing Thanos Ruler Service failed")
 	}
 
-	err = t.client.CreateOrUpdateService(svc)
+	err = t.client.CreateOrUpdateService(ctx, svc)
 	if err != nil {
 		return errors.Wrap(err, "reconciling Thanos Ruler Service failed")
 	}
@@ -58,12 +61,12 @@ func (t *ThanosRulerUserWorkloadTask) create() error {
 		return errors.Wrap(err, "initializing Thanos Ruler Route failed")
 	}
 
-	err = t.client.CreateRouteIfNotExists(r)
+	err = t.client.CreateRouteIfNotExists(ctx, r)
 	if err != nil {
 		return errors.Wrap(err, "creating Thanos Ruler Route failed")
 	}
 
-	_, err = t.client.WaitForRouteReady(r)
+	_, err = t.client.WaitForRouteReady(ctx, r)
 	if err != nil {
 		return errors.Wrap(err, "waiting for Thanos Ruler Route to become ready failed")
 	}
@@ -73,7 +76,7 @@ func (t *ThanosRulerUserWorkloadTask) create() error {
 		return errors.Wrap(err, "initializing Thanos Ruler ClusterRole failed")
 	}
 
-	err = t.client.CreateOrUpdateClusterRole(cr)
+	err = t.client.CreateOrUpdateClusterRole(ctx, cr)
 	if err != nil {
 		return errors.Wrap(err, "reconciling Thanos Ruler ClusterRole failed")
 	}
@@ -83,7 +86,7 @@ func (t *ThanosRulerUserWorkloadTask) create() error {
 		return errors.Wrap(err, "initializing Thanos Ruler ClusterRoleBinding failed")
 	}
 
-	err = t.client.CreateOrUpdateClusterRoleBinding(crb)
+	err = t.client.CreateOrUpdateClusterRoleBinding(ctx, crb)
 	if err != nil {
 		return errors.Wrap(err, "reconciling Thanos Ruler ClusterRoleBinding failed")
 	}
@@ -93,7 +96,7 @@ func (t *ThanosRulerUserWorkloadTask) create() error {
 		return errors.Wrap(err, "initializing Thanos Ruler monitoring ClusterRoleBinding failed")
 	}
 
-	err = t.client.CreateOrUpdateClusterRoleBinding(moncrb)
+	err = t.client.CreateOrUpdateClusterRoleBinding(ctx, moncrb)
 	if err != nil {
 		return errors.Wrap(err, "reconciling Thanos Ruler monitoring ClusterRoleBinding failed")
 	}
@@ -103,7 +106,7 @@ func (t *ThanosRulerUserWorkloadTask) create() error {
 		return errors.Wrap(err, "initializing Thanos Ruler ServiceAccount failed")
 	}
 
-	err = t.client.CreateOrUpdateServiceAccount(sa)
+	err = t.client.CreateOrUpdateServiceAccount(ctx, sa)
 	if err != nil {
 		return errors.Wrap(err, "reconciling Thanos Ruler ServiceAccount failed")
 	}
@@ -113,9 +116,9 @@ func (t *ThanosRulerUserWorkloadTask) create() error {
 		return errors.Wrap(err, "initializing Thanos Ruler OAuth Cookie Secret failed")
 	}
 
-	err = t.client.CreateIfNotExistSecret(s)
+	s, err = t.client.CreateOrRotateSecret(ctx, s, t.factory.RotateGeneratedSecret(s))
 	if err != nil {
-		return errors.Wrap(err, "creating Thanos Ruler OAuth Cookie Secret failed")
+		return errors.Wrap(err, "reconciling Thanos Ruler OAuth Cookie Secret failed")
 	}
 
 	// Thanos components use https://godoc.org/github.com/prometheus/common/config#NewClientFromConfig
@@ -126,7 +129,7 @@ func (t *ThanosRulerUserWorkloadTask) create() error {
 		return errors.Wrap(err, "initializing Thanos Ruler query config Secret failed")
 	}
 
-	err = t.client.CreateIfNotExistSecret(qcs)
+	err = t.client.CreateIfNotExistSecret(ctx, qcs)
 	if err != nil {
 		return errors.Wrap(err, "creating Thanos Ruler query config Secret failed")
 	}
@@ -139,7 +142,7 @@ func (t *ThanosRulerUserWorkloadTask) create() error {
 		return errors.Wrap(err, "initializing Thanos Ruler Alertmanager config Secret failed")
 	}
 
-	err = t.client.CreateIfNotExistSecret(acs)
+	err = t.client.CreateIfNotExistSecret(ctx, acs)
 	if err != nil {
 		return errors.Wrap(err, "creating Thanos Ruler alertmanager config Secret failed")
 	}
@@ -156,7 +159,7 @@ func (t *ThanosRulerUserWorkloadTask) create() error {
 			factory: t.factory,
 			prefix:  "thanos-ruler",
 		}
-		trustedCA, err = cbs.syncTrustedCABundle(trustedCA)
+		trustedCA, err = cbs.syncTrustedCABundle(ctx, trustedCA)
 		if err != nil {
 			return errors.Wrap(err, "syncing Thanos Ruler trusted CA bundle ConfigMap failed")
 		}
@@ -166,7 +169,7 @@ func (t *ThanosRulerUserWorkloadTask) create() error {
 			return errors.Wrap(err, "initializing UserWorkload Thanos Ruler GRPC secret failed")
 		}
 
-		grpcTLS, err = t.client.WaitForSecret(grpcTLS)
+		grpcTLS, err = t.client.WaitForSecret(ctx, grpcTLS)
 		if err != nil {
 			return errors.Wrap(err, "waiting for UserWorkload Thanos Ruler GRPC secret failed")
 		}
@@ -178,19 +181,19 @@ func (t *ThanosRulerUserWorkloadTask) create() error {
 
 		grpcSecret, err = t.factory.HashSecret(grpcSecret,
 			"ca.crt", string(grpcTLS.Data["ca.crt"]),
-			"server.crt", string(grpcTLS.Data["prometheus-server.crt"]),
-			"server.key", string(grpcTLS.Data["prometheus-server.key"]),
+			"server.crt", string(grpcTLS.Data["thanos-ruler-server.crt"]),
+			"server.key", string(grpcTLS.Data["thanos-ruler-server.key"]),
 		)
 		if err != nil {
 			return errors.Wrap(err, "error hashing UserWorkload Thanos Ruler GRPC TLS secret")
 		}
 
-		err = t.client.CreateOrUpdateSecret(grpcSecret)
+		err = t.client.CreateOrUpdateSecret(ctx, grpcSecret)
 		if err != nil {
 			return errors.Wrap(err, "error creating UserWorkload Thanos Ruler GRPC TLS secret")
 		}
 
-		err = t.client.DeleteHashedSecret(
+		err = t.client.DeleteHashedSecret(ctx,
 			grpcSecret.GetNamespace(),
 			"thanos-ruler-grpc-tls",
 			string(grpcSecret.Labels["monitoring.openshift.io/hash"]),
@@ -203,19 +206,25 @@ func (t *ThanosRulerUserWorkloadTask) create() error {
 		if err != nil {
 			return errors.Wrap(err, "initializing Thanos Querier Route failed")
 		}
-		queryURL, err := t.client.GetRouteURL(querierRoute)
+		queryURL, err := t.client.GetRouteURL(ctx, querierRoute)
 
 		tr, err := t.factory.ThanosRulerCustomResource(queryURL.String(), trustedCA, grpcSecret)
 		if err != nil {
 			return errors.Wrap(err, "initializing ThanosRuler object failed")
 		}
 
-		err = t.client.CreateOrUpdateThanosRuler(tr)
+		// The proxy reads the generated secret at startup.
+		if tr.Spec.PodMetadata == nil {
+			tr.Spec.PodMetadata = &monv1.EmbeddedObjectMetadata{}
+		}
+		tr.Spec.PodMetadata.Annotations = manifests.WithSecretsHash(tr.Spec.PodMetadata.Annotations, s.Data["session_secret"])
+
+		err = t.client.CreateOrUpdateThanosRuler(ctx, tr)
 		if err != nil {
 			return errors.Wrap(err, "reconciling ThanosRuler object failed")
 		}
 
-		err = t.client.WaitForThanosRuler(tr)
+		err = t.client.WaitForThanosRuler(ctx, tr)
 		if err != nil {
 			return errors.Wrap(err, "waiting for ThanosRuler object changes failed")
 		}
@@ -226,7 +235,7 @@ func (t *ThanosRulerUserWorkloadTask) create() error {
 		return errors.Wrap(err, "initializing Thanos Ruler ServiceMonitor failed")
 	}
 
-	err = t.client.CreateOrUpdateServiceMonitor(trsm)
+	err = t.client.CreateOrUpdateServiceMonitor(ctx, trsm)
 	if err != nil {
 		return errors.Wrap(err, "reconciling Thanos Ruler ServiceMonitor failed")
 	}
@@ -236,20 +245,20 @@ func (t *ThanosRulerUserWorkloadTask) create() error {
 		return errors.Wrap(err, "initializing Thanos Ruler PrometheusRule failed")
 	}
 
-	err = t.client.CreateOrUpdatePrometheusRule(pm)
+	err = t.client.CreateOrUpdatePrometheusRule(ctx, pm)
 	if err != nil {
 		return errors.Wrap(err, "reconciling Thanos Ruler PrometheusRule failed")
 	}
 	return nil
 }
 
-func (t *ThanosRulerUserWorkloadTask) destroy() error {
+func (t *ThanosRulerUserWorkloadTask) destroy(ctx context.Context) error {
 	prmrl, err := t.factory.ThanosRulerPrometheusRule()
 	if err != nil {
 		return errors.Wrap(err, "initializing Thanos Ruler PrometheusRule failed")
 	}
 
-	err = t.client.DeletePrometheusRule(prmrl)
+	err = t.client.DeletePrometheusRule(ctx, prmrl)
 	if err != nil {
 		return errors.Wrap(err, "deleting Thanos Ruler PrometheusRule failed")
 	}
@@ -259,7 +268,7 @@ func (t *ThanosRulerUserWorkloadTask) destroy() error {
 		return errors.Wrap(err, "initializing Thanos Ruler Route failed")
 	}
 
-	err = t.client.DeleteRoute(route)
+	err = t.client.DeleteRoute(ctx, route)
 	if err != nil {
 		return errors.Wrap(err, "deleting Thanos Ruler Route failed")
 	}
@@ -269,7 +278,7 @@ func (t *ThanosRulerUserWorkloadTask) destroy() error {
 		return errors.Wrap(err, "initializing Thanos Ruler Service failed")
 	}
 
-	err = t.client.DeleteService(svc)
+	err = t.client.DeleteService(ctx, svc)
 	if err != nil {
 		return errors.Wrap(err, "deleting Thanos Ruler Service failed")
 	}
@@ -279,7 +288,7 @@ func (t *ThanosRulerUserWorkloadTask) destroy() error {
 		return errors.Wrap(err, "initializing Thanos Ruler ClusterRole failed")
 	}
 
-	err = t.client.DeleteClusterRole(cr)
+	err = t.client.DeleteClusterRole(ctx, cr)
 	if err != nil {
 		return errors.Wrap(err, "deleting Thanos Ruler ClusterRole failed")
 	}
@@ -289,7 +298,7 @@ func (t *ThanosRulerUserWorkloadTask) destroy() error {
 		return errors.Wrap(err, "initializing Thanos Ruler ClusterRoleBinding failed")
 	}
 
-	err = t.client.DeleteClusterRoleBinding(crb)
+	err = t.client.DeleteClusterRoleBinding(ctx, crb)
 	if err != nil {
 		return errors.Wrap(err, "deleting Thanos Ruler ClusterRoleBinding failed")
 	}
@@ -299,7 +308,7 @@ func (t *ThanosRulerUserWorkloadTask) destroy() error {
 		return errors.Wrap(err, "initializing Thanos Ruler ServiceAccount failed")
 	}
 
-	err = t.client.DeleteServiceAccount(sa)
+	err = t.client.DeleteServiceAccount(ctx, sa)
 	if err != nil {
 		return errors.Wrap(err, "deleting Thanos Ruler ServiceAccount failed")
 	}
@@ -309,7 +318,7 @@ func (t *ThanosRulerUserWorkloadTask) destroy() error {
 		return errors.Wrap(err, "initializing Thanos Ruler OAuth Cookie Secret failed")
 	}
 
-	err = t.client.DeleteSecret(oauthSecret)
+	err = t.client.DeleteSecret(ctx, oauthSecret)
 	if err != nil {
 		return errors.Wrap(err, "deleting Thanos Ruler OAuth Cookie Secret failed")
 	}
@@ -319,12 +328,12 @@ func (t *ThanosRulerUserWorkloadTask) destroy() error {
 		return errors.Wrap(err, "initializing Thanos Ruler trusted CA bundle ConfigMap failed")
 	}
 
-	err = t.client.DeleteConfigMap(trustedCA)
+	err = t.client.DeleteConfigMap(ctx, trustedCA)
 	if err != nil {
 		return errors.Wrap(err, "deleting Thanos Ruler trusted CA bundle ConfigMap failed")
 	}
 
-	err = t.client.DeleteHashedConfigMap(trustedCA.GetNamespace(), "thanos-ruler", "")
+	err = t.client.DeleteHashedConfigMap(ctx, trustedCA.GetNamespace(), "thanos-ruler", "")
 	if err != nil {
 		return errors.Wrap(err, "deleting all hashed Thanos Ruler trusted CA bundle ConfigMap failed")
 	}
@@ -334,7 +343,7 @@ func (t *ThanosRulerUserWorkloadTask) destroy() error {
 		return errors.Wrap(err, "initializing UserWorkload Thanos Ruler GRPC secret failed")
 	}
 
-	grpcTLS, err = t.client.WaitForSecret(grpcTLS)
+	grpcTLS, err = t.client.WaitForSecret(ctx, grpcTLS)
 	if err != nil {
 		return errors.Wrap(err, "waiting for UserWorkload Thanos Ruler GRPC secret failed")
 	}
@@ -346,8 +355,8 @@ func (t *ThanosRulerUserWorkloadTask) destroy() error {
 
 	grpcSecret, err = t.factory.HashSecret(grpcSecret,
 		"ca.crt", string(grpcTLS.Data["ca.crt"]),
-		"server.crt", string(grpcTLS.Data["prometheus-server.crt"]),
-		"server.key", string(grpcTLS.Data["prometheus-server.key"]),
+		"server.crt", string(grpcTLS.Data["thanos-ruler-server.crt"]),
+		"server.key", string(grpcTLS.Data["thanos-ruler-server.key"]),
 	)
 	if err != nil {
 		return errors.Wrap(err, "error hashing UserWorkload Thanos Ruler GRPC TLS secret")
@@ -358,12 +367,12 @@ func (t *ThanosRulerUserWorkloadTask) destroy() error {
 		return errors.Wrap(err, "initializing ThanosRuler object failed")
 	}
 
-	err = t.client.DeleteThanosRuler(tr)
+	err = t.client.DeleteThanosRuler(ctx, tr)
 	if err != nil {
 		return errors.Wrap(err, "deleting ThanosRuler object failed")
 	}
 
-	err = t.client.DeleteSecret(grpcSecret)
+	err = t.client.DeleteSecret(ctx, grpcSecret)
 	if err != nil {
 		return errors.Wrap(err, "error deleting UserWorkload Thanos Ruler GRPC TLS secret")
 	}
@@ -373,7 +382,7 @@ func (t *ThanosRulerUserWorkloadTask) destroy() error {
 		return errors.Wrap(err, "initializing Thanos Ruler query config Secret failed")
 	}
 
-	err = t.client.DeleteSecret(qcs)
+	err = t.client.DeleteSecret(ctx, qcs)
 	if err != nil {
 		return errors.Wrap(err, "deleting Thanos Ruler query config Secret failed")
 	}
@@ -383,7 +392,7 @@ func (t *ThanosRulerUserWorkloadTask) destroy() error {
 		return errors.Wrap(err, "initializing Thanos Ruler Alertmanager config Secret failed")
 	}
 
-	err = t.client.DeleteSecret(acs)
+	err = t.client.DeleteSecret(ctx, acs)
 	if err != nil {
 		return errors.Wrap(err, "creating Thanos Ruler alertmanager config Secret failed")
 	}
@@ -393,6 +402,6 @@ func (t *ThanosRulerUserWorkloadTask) destroy() error {
 		return errors.Wrap(err, "initializing Thanos Ruler ServiceMonitor failed")
 	}
 
-	err = t.client.DeleteServiceMonitor(trsm)
+	err = t.client.DeleteServiceMonitor(ctx, trsm)
 	return errors.Wrap(err, "deleting Thanos Ruler ServiceMonitor failed")
 }
@@ -0,0 +1,145 @@
+// Copyright 2020 The Cluster Monitoring Operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package telemetry
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"sort"
+
+	"github.com/pkg/errors"
+	"github.com/prometheus/common/expfmt"
+	"github.com/prometheus/prometheus/pkg/labels"
+)
+
+// seriesResponse is the response of the /api/v1/series endpoint of the
+// Prometheus HTTP API.
+type seriesResponse struct {
+	Status string              `json:"status"`
+	Error  string              `json:"error"`
+	Data   []map[string]string `json:"data"`
+}
+
+// ParseSeries reads a series dump, either a response of the /api/v1/series
+// endpoint of the Prometheus HTTP API or metrics in the Prometheus text
+// exposition format such as Documentation/timeseries.txt. The series are
+// returned sorted and without duplicates. Labels with empty values are
+// dropped as they are equivalent to missing labels.
+func ParseSeries(r io.Reader) ([]labels.Labels, error) {
+	b, err := ioutil.ReadAll(r)
+	if err != nil {
+		return nil, errors.Wrap(err, "reading series failed")
+	}
+
+	var series []labels.Labels
+	if t := bytes.TrimSpace(b); len(t) > 0 && t[0] == '{' {
+		series, err = parseSeriesResponse(t)
+	} else {
+		series, err = parseTextFormat(b)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return dedupSeries(series), nil
+}
+
+func parseSeriesResponse(b []byte) ([]labels.Labels, error) {
+	var resp seriesResponse
+	if err := json.Unmarshal(b, &resp); err != nil {
+		return nil, errors.Wrap(err, "unmarshalling series response failed")
+	}
+	if resp.Status != "success" {
+		return nil, errors.Errorf("series request failed with status %q: %s", resp.Status, resp.Error)
+	}
+
+	series := make([]labels.Labels, 0, len(resp.Data))
+	for _, m := range resp.Data {
+		series = append(series, fromMap(m))
+	}
+	return series, nil
+}
+
+func parseTextFormat(b []byte) ([]labels.Labels, error) {
+	var p expfmt.TextParser
+	mfs, err := p.TextToMetricFamilies(bytes.NewReader(b))
+	if err != nil {
+		return nil, errors.Wrap(err, "parsing metrics failed")
+	}
+
+	var series []labels.Labels
+	for name, mf := range mfs {
+		for _, m := range mf.GetMetric() {
+			ls := map[string]string{}
+			for _, lp := range m.GetLabel() {
+				ls[lp.GetName()] = lp.GetValue()
+			}
+			with := func(name, label, value string) labels.Labels {
+				l := map[string]string{labels.MetricName: name}
+				for k, v := range ls {
+					l[k] = v
+				}
+				if label != "" {
+					l[label] = value
+				}
+				return fromMap(l)
+			}
+
+			// Summaries and histograms are made of several series.
+			switch {
+			case m.GetSummary() != nil:
+				for _, q := range m.GetSummary().GetQuantile() {
+					series = append(series, with(name, "quantile", fmt.Sprint(q.GetQuantile())))
+				}
+				series = append(series, with(name+"_sum", "", ""), with(name+"_count", "", ""))
+			case m.GetHistogram() != nil:
+				for _, b := range m.GetHistogram().GetBucket() {
+					series = append(series, with(name+"_bucket", "le", fmt.Sprint(b.GetUpperBound())))
+				}
+				series = append(series, with(name+"_sum", "", ""), with(name+"_count", "", ""))
+			default:
+				series = append(series, with(name, "", ""))
+			}
+		}
+	}
+	return series, nil
+}
+
+func fromMap(m map[string]string) labels.Labels {
+	for k, v := range m {
+		if v == "" {
+			delete(m, k)
+		}
+	}
+	return labels.FromMap(m)
+}
+
+func dedupSeries(series []labels.Labels) []labels.Labels {
+	sort.Slice(series, func(i, j int) bool {
+		return labels.Compare(series[i], series[j]) < 0
+	})
+
+	res := series[:0]
+	for i, s := range series {
+		if i > 0 && labels.Equal(s, series[i-1]) {
+			continue
+		}
+		res = append(res, s)
+	}
+	return res
+}
@@ -0,0 +1,293 @@
+// Copyright 2020 The Cluster Monitoring Operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package telemetry
+
+import (
+	"regexp"
+	"sort"
+
+	"github.com/pkg/errors"
+	"github.com/prometheus/prometheus/pkg/labels"
+	promql "github.com/prometheus/prometheus/promql/parser"
+
+	"github.com/openshift/cluster-monitoring-operator/pkg/promqlgen"
+	"github.com/openshift/cluster-monitoring-operator/pkg/tasks"
+)
+
+// Rule is the outcome of a telemetry match.
+type Rule struct {
+	Match string
+	// Series are the series selected by the match.
+	Series []labels.Labels
+	// Redundant is true if the match selects series but the other
+	// non-redundant matches select all of them too. Removing all redundant
+	// matches doesn't change the series sent.
+	Redundant bool
+}
+
+// RelabelMismatch is a series which the relabel configs of the matches
+// don't treat like the PromQL selectors.
+type RelabelMismatch struct {
+	Series labels.Labels
+	// Selected is true if the PromQL selectors select the series.
+	Selected bool
+	// Relabeled is the series after relabeling, nil if it was dropped.
+	Relabeled labels.Labels
+}
+
+// Report is the outcome of telemetry matches on a set of series.
+type Report struct {
+	Rules []Rule
+	// Series are the series sent to Telemeter.
+	Series []labels.Labels
+	// RelabelMismatches are the series which the relabel configs used for
+	// remote write keep or drop unlike the PromQL selectors, or modify.
+	RelabelMismatches []RelabelMismatch
+	// RecordingRule is the expression of the recording rule counting the
+	// series sent and RecordingRuleCount its value on the series.
+	RecordingRule      string
+	RecordingRuleCount int
+}
+
+// Cardinality returns the number of series sent by metric name.
+func (r *Report) Cardinality() map[string]int {
+	c := map[string]int{}
+	for _, s := range r.Series {
+		c[s.Get(labels.MetricName)]++
+	}
+	return c
+}
+
+// Simulate returns which of the series the telemetry matches select. The
+// matches are evaluated as PromQL selectors, as done by the federation of the
+// Telemeter client, and with the relabel configs of
+// promqlgen.LabelSelectorsToRelabelConfigs, as done by remote write. Series
+// for which both disagree are reported as mismatches.
+func Simulate(matches []string, series []labels.Labels) (*Report, error) {
+	r := &Report{Rules: make([]Rule, len(matches))}
+	selected := make([][]bool, len(matches))
+	for i, m := range matches {
+		lms, err := promql.ParseMetricSelector(m)
+		if err != nil {
+			return nil, errors.Wrapf(err, "parsing match %q failed", m)
+		}
+		r.Rules[i].Match = m
+		selected[i] = make([]bool, len(series))
+		for j, s := range series {
+			if matchesAll(lms, s) {
+				selected[i][j] = true
+				r.Rules[i].Series = append(r.Rules[i].Series, s)
+			}
+		}
+	}
+
+	// A series is covered as long as a non-redundant match selects it.
+	covered := make([]int, len(series))
+	for i := range matches {
+		for j := range series {
+			if selected[i][j] {
+				covered[j]++
+			}
+		}
+	}
+	for j, s := range series {
+		if covered[j] > 0 {
+			r.Series = append(r.Series, s)
+		}
+	}
+
+	rcs, err := promqlgen.LabelSelectorsToRelabelConfigs(matches)
+	if err != nil {
+		return nil, err
+	}
+	for j, s := range series {
+		relabeled, err := promqlgen.Relabel(s, rcs)
+		if err != nil {
+			return nil, errors.Wrapf(err, "relabeling series %s failed", s)
+		}
+		selected := covered[j] > 0
+		if selected != (relabeled != nil) || (relabeled != nil && !labels.Equal(relabeled, s)) {
+			r.RelabelMismatches = append(r.RelabelMismatches, RelabelMismatch{Series: s, Selected: selected, Relabeled: relabeled})
+		}
+	}
+	for i := range matches {
+		if len(r.Rules[i].Series) == 0 {
+			continue
+		}
+		redundant := true
+		for j := range series {
+			if selected[i][j] && covered[j] == 1 {
+				redundant = false
+				break
+			}
+		}
+		if !redundant {
+			continue
+		}
+		r.Rules[i].Redundant = true
+		for j := range series {
+			if selected[i][j] {
+				covered[j]--
+			}
+		}
+	}
+
+	rec, err := tasks.GenerateTelemeterWhitelistRec(matches)
+	if err != nil {
+		return nil, err
+	}
+	r.RecordingRule = rec
+	r.RecordingRuleCount, err = evalCount(rec, series)
+	if err != nil {
+		return nil, errors.Wrapf(err, "evaluating recording rule %q failed", rec)
+	}
+
+	return r, nil
+}
+
+// evalCount evaluates the count(<expr>) expression of the telemetry
+// recording rule on series.
+func evalCount(expr string, series []labels.Labels) (int, error) {
+	e, err := promql.ParseExpr(expr)
+	if err != nil {
+		return 0, err
+	}
+	agg, ok := e.(*promql.AggregateExpr)
+	if !ok || agg.Op != promql.COUNT {
+		return 0, errors.New("expected a count aggregation")
+	}
+
+	v, err := eval(agg.Expr, series)
+	if err != nil {
+		return 0, err
+	}
+	return len(v), nil
+}
+
+// eval evaluates the vector selectors, label_replace calls and or operations
+// of the telemetry recording rule on series.
+func eval(e promql.Expr, series []labels.Labels) ([]labels.Labels, error) {
+	switch e := e.(type) {
+	case *promql.ParenExpr:
+		return eval(e.Expr, series)
+	case *promql.VectorSelector:
+		var v []labels.Labels
+		for _, s := range series {
+			if matchesAll(e.LabelMatchers, s) {
+				v = append(v, s)
+			}
+		}
+		return v, nil
+	case *promql.Call:
+		if e.Func.Name != "label_replace" {
+			return nil, errors.Errorf("unsupported function %q", e.Func.Name)
+		}
+		v, err := eval(e.Args[0], series)
+		if err != nil {
+			return nil, err
+		}
+		return labelReplace(v, e.Args[1:])
+	case *promql.BinaryExpr:
+		if e.Op != promql.LOR || e.VectorMatching == nil || e.VectorMatching.On || len(e.VectorMatching.MatchingLabels) > 0 {
+			return nil, errors.Errorf("unsupported binary expression %q", e)
+		}
+		lhs, err := eval(e.LHS, series)
+		if err != nil {
+			return nil, err
+		}
+		rhs, err := eval(e.RHS, series)
+		if err != nil {
+			return nil, err
+		}
+		// The or operator ignores the metric name when comparing series.
+		var (
+			seen = map[uint64]struct{}{}
+			buf  []byte
+			h    uint64
+		)
+		for _, s := range lhs {
+			h, buf = s.HashWithoutLabels(buf)
+			seen[h] = struct{}{}
+		}
+		for _, s := range rhs {
+			h, buf = s.HashWithoutLabels(buf)
+			if _, ok := seen[h]; !ok {
+				lhs = append(lhs, s)
+			}
+		}
+		return lhs, nil
+	}
+	return nil, errors.Errorf("unsupported expression %q", e)
+}
+
+// labelReplace applies label_replace(v, dst, replacement, src, regex).
+func labelReplace(v []labels.Labels, args promql.Expressions) ([]labels.Labels, error) {
+	var strs [4]string
+	for i, a := range args {
+		sl, ok := a.(*promql.StringLiteral)
+		if !ok {
+			return nil, errors.Errorf("expected a string literal, got %q", a)
+		}
+		strs[i] = sl.Val
+	}
+	dst, replacement, src := strs[0], strs[1], strs[2]
+	re, err := regexp.Compile("^(?:" + strs[3] + ")$")
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]labels.Labels, 0, len(v))
+	for _, s := range v {
+		val := s.Get(src)
+		idx := re.FindStringSubmatchIndex(val)
+		if idx == nil {
+			res = append(res, s)
+			continue
+		}
+		b := labels.NewBuilder(s)
+		if r := re.ExpandString(nil, replacement, val, idx); len(r) > 0 {
+			b.Set(dst, string(r))
+		} else {
+			b.Del(dst)
+		}
+		res = append(res, b.Labels())
+	}
+	return res, nil
+}
+
+func matchesAll(lms []*labels.Matcher, s labels.Labels) bool {
+	for _, lm := range lms {
+		if !lm.Matches(s.Get(lm.Name)) {
+			return false
+		}
+	}
+	return true
+}
+
+// MetricNames returns the metric names of a cardinality map sorted by
+// decreasing number of series.
+func MetricNames(cardinality map[string]int) []string {
+	names := make([]string, 0, len(cardinality))
+	for n := range cardinality {
+		names = append(names, n)
+	}
+	sort.Slice(names, func(i, j int) bool {
+		if cardinality[names[i]] != cardinality[names[j]] {
+			return cardinality[names[i]] > cardinality[names[j]]
+		}
+		return names[i] < names[j]
+	})
+	return names
+}
@@ -0,0 +1,224 @@
+// Copyright 2020 The Cluster Monitoring Operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package telemetry
+
+import (
+	"os"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/prometheus/prometheus/pkg/labels"
+)
+
+func TestParseSeries(t *testing.T) {
+	expected := []labels.Labels{
+		labels.FromStrings("__name__", "ALERTS", "alertname", "Watchdog", "alertstate", "firing"),
+		labels.FromStrings("__name__", "up", "job", "a"),
+	}
+
+	for _, tc := range []struct {
+		name   string
+		series string
+	}{
+		{
+			name: "text format",
+			series: `# TYPE ALERTS untyped
+ALERTS{alertname="Watchdog",alertstate="firing",instance=""} 1 1562168610163
+# TYPE up untyped
+up{job="a"} 1 1562168610163
+`,
+		},
+		{
+			name:   "series response",
+			series: `{"status":"success","data":[{"__name__":"up","job":"a"},{"__name__":"ALERTS","alertname":"Watchdog","alertstate":"firing","instance":""},{"__name__":"up","job":"a"}]}`,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			series, err := ParseSeries(strings.NewReader(tc.series))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(series, expected) {
+				t.Fatalf("expected %v, got %v", expected, series)
+			}
+		})
+	}
+}
+
+func TestParseSeriesHistogram(t *testing.T) {
+	series, err := ParseSeries(strings.NewReader(`# TYPE d histogram
+d_bucket{le="1"} 1
+d_bucket{le="+Inf"} 2
+d_sum 3
+d_count 2
+`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var got []string
+	for _, s := range series {
+		got = append(got, s.String())
+	}
+	expected := []string{
+		`{__name__="d_bucket", le="+Inf"}`,
+		`{__name__="d_bucket", le="1"}`,
+		`{__name__="d_count"}`,
+		`{__name__="d_sum"}`,
+	}
+	if !reflect.DeepEqual(got, expected) {
+		t.Fatalf("expected %v, got %v", expected, got)
+	}
+}
+
+func TestParseSeriesError(t *testing.T) {
+	_, err := ParseSeries(strings.NewReader(`{"status":"error","error":"bad request"}`))
+	if err == nil {
+		t.Fatal("expected an error for a failed series request")
+	}
+}
+
+func TestSimulate(t *testing.T) {
+	series := []labels.Labels{
+		labels.FromStrings("__name__", "ALERTS", "alertstate", "firing"),
+		labels.FromStrings("__name__", "ALERTS", "alertstate", "pending"),
+		labels.FromStrings("__name__", "up", "job", "a"),
+		labels.FromStrings("__name__", "up", "job", "b"),
+		labels.FromStrings("__name__", "up", "job", "c"),
+		labels.FromStrings("__name__", "up"),
+	}
+	matches := []string{
+		`{__name__="up",job="a"}`,
+		`{__name__="up",job=~"a|b"}`,
+		`{__name__="up",job="b"}`,
+		`{__name__="ALERTS",alertstate="firing"}`,
+		`{__name__="missing"}`,
+	}
+
+	r, err := Simulate(matches, series)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for i, tc := range []struct {
+		series    int
+		redundant bool
+	}{
+		// Matches are checked in order: the first and the third ones
+		// are covered by the second one, which must then be kept.
+		{series: 1, redundant: true},
+		{series: 2},
+		{series: 1, redundant: true},
+		{series: 1},
+		{},
+	} {
+		rule := r.Rules[i]
+		if rule.Match != matches[i] {
+			t.Fatalf("expected rule %d to be %q, got %q", i, matches[i], rule.Match)
+		}
+		if len(rule.Series) != tc.series || rule.Redundant != tc.redundant {
+			t.Fatalf("expected %s to select %d series (redundant: %v), got %d (redundant: %v)", rule.Match, tc.series, tc.redundant, len(rule.Series), rule.Redundant)
+		}
+	}
+
+	expected := []labels.Labels{series[0], series[2], series[3]}
+	if !reflect.DeepEqual(r.Series, expected) {
+		t.Fatalf("expected series %v, got %v", expected, r.Series)
+	}
+	if c := r.Cardinality(); c["up"] != 2 || c["ALERTS"] != 1 || len(c) != 2 {
+		t.Fatalf("unexpected cardinality %v", c)
+	}
+
+	if len(r.RelabelMismatches) != 0 {
+		t.Fatalf("expected the relabel configs to keep the selected series, got mismatches %v", r.RelabelMismatches)
+	}
+	if r.RecordingRuleCount != len(expected) {
+		t.Fatalf("expected the recording rule to count %d series, got %d (%s)", len(expected), r.RecordingRuleCount, r.RecordingRule)
+	}
+}
+
+func TestSimulateRecordingRule(t *testing.T) {
+	// The or operator ignores metric names, series of different metrics
+	// with the same labels must still be counted once each.
+	series := []labels.Labels{
+		labels.FromStrings("__name__", "a", "job", "x"),
+		labels.FromStrings("__name__", "b", "job", "x"),
+		labels.FromStrings("__name__", "b", "job", "y"),
+	}
+
+	r, err := Simulate([]string{`{__name__="a"}`, `{__name__="b",job!="y"}`, `{__name__=~"a|b",job="x"}`}, series)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if r.RecordingRuleCount != 2 || len(r.Series) != 2 {
+		t.Fatalf("expected 2 series to be sent and counted, got %d and %d (%s)", len(r.Series), r.RecordingRuleCount, r.RecordingRule)
+	}
+}
+
+func TestSimulateRelabelMismatches(t *testing.T) {
+	// The relabel configs use temporary labels, which series must not have.
+	series := []labels.Labels{
+		labels.FromStrings("__name__", "a"),
+		labels.FromStrings("__name__", "a", "__tmp_selected", "0"),
+		labels.FromStrings("__name__", "c", "__tmp_selected", "1"),
+	}
+
+	r, err := Simulate([]string{`{__name__="a"}`, `{job="x"}`}, series)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := []RelabelMismatch{
+		{Series: series[1], Selected: true, Relabeled: labels.FromStrings("__name__", "a")},
+		{Series: series[2], Selected: false, Relabeled: labels.FromStrings("__name__", "c")},
+	}
+	if !reflect.DeepEqual(r.RelabelMismatches, expected) {
+		t.Fatalf("expected mismatches %v, got %v", expected, r.RelabelMismatches)
+	}
+}
+
+func TestSimulateInvalidMatch(t *testing.T) {
+	if _, err := Simulate([]string{`{__name__=`}, nil); err == nil {
+		t.Fatal("expected an error for an invalid match")
+	}
+}
+
+func TestSimulateTimeseries(t *testing.T) {
+	f, err := os.Open("../../Documentation/timeseries.txt")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+
+	series, err := ParseSeries(f)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	r, err := Simulate([]string{`{__name__="ALERTS",alertstate="firing"}`, `{__name__="cluster_version"}`}, series)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(r.Series) != len(r.Rules[0].Series)+len(r.Rules[1].Series) || len(r.Series) == 0 {
+		t.Fatalf("expected the union of the series of both matches, got %d series", len(r.Series))
+	}
+	if r.RecordingRuleCount != len(r.Series) {
+		t.Fatalf("expected the recording rule to count %d series, got %d", len(r.Series), r.RecordingRuleCount)
+	}
+	if len(r.RelabelMismatches) != 0 {
+		t.Fatalf("expected the relabel configs to keep the selected series, got mismatches %v", r.RelabelMismatches)
+	}
+}
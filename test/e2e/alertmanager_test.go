@@ -33,7 +33,7 @@ import (
 )
 
 func TestAlertmanagerVolumeClaim(t *testing.T) {
-	err := f.OperatorClient.WaitForStatefulsetRollout(&appsv1.StatefulSet{
+	err := f.OperatorClient.WaitForStatefulsetRollout(context.TODO(), &appsv1.StatefulSet{
 		ObjectMeta: metav1.ObjectMeta{
 			Name:      "alertmanager-main",
 			Namespace: f.Ns,
@@ -60,7 +60,7 @@ func TestAlertmanagerVolumeClaim(t *testing.T) {
 		},
 	}
 
-	if err := f.OperatorClient.CreateOrUpdateConfigMap(cm); err != nil {
+	if err := f.OperatorClient.CreateOrUpdateConfigMap(context.TODO(), cm); err != nil {
 		t.Fatal(err)
 	}
 
@@ -81,7 +81,7 @@ func TestAlertmanagerVolumeClaim(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	err = f.OperatorClient.WaitForStatefulsetRollout(&appsv1.StatefulSet{
+	err = f.OperatorClient.WaitForStatefulsetRollout(context.TODO(), &appsv1.StatefulSet{
 		ObjectMeta: metav1.ObjectMeta{
 			Name:      "alertmanager-main",
 			Namespace: f.Ns,
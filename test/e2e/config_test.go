@@ -42,7 +42,7 @@ func TestClusterMonitoringOperatorConfiguration(t *testing.T) {
 		},
 	}
 
-	if err := f.OperatorClient.CreateOrUpdateConfigMap(validCM); err != nil {
+	if err := f.OperatorClient.CreateOrUpdateConfigMap(context.TODO(), validCM); err != nil {
 		t.Fatal(err)
 	}
 
@@ -73,7 +73,7 @@ func TestClusterMonitoringOperatorConfiguration(t *testing.T) {
 		},
 	}
 
-	if err := f.OperatorClient.CreateOrUpdateConfigMap(cm); err != nil {
+	if err := f.OperatorClient.CreateOrUpdateConfigMap(context.TODO(), cm); err != nil {
 		t.Fatal(err)
 	}
 
@@ -87,7 +87,7 @@ func TestClusterMonitoringOperatorConfiguration(t *testing.T) {
 	}
 
 	// Restore the first configuration.
-	if err := f.OperatorClient.CreateOrUpdateConfigMap(validCM); err != nil {
+	if err := f.OperatorClient.CreateOrUpdateConfigMap(context.TODO(), validCM); err != nil {
 		t.Fatal(err)
 	}
 
@@ -101,7 +101,7 @@ func assertOperatorCondition(t *testing.T, conditionType configv1.ClusterStatusC
 
 	reporter := f.OperatorClient.StatusReporter()
 	err := framework.Poll(time.Second, 5*time.Minute, func() error {
-		co, err := reporter.Get()
+		co, err := reporter.Get(context.TODO())
 		if err != nil {
 			t.Fatal(err)
 		}
@@ -15,6 +15,7 @@
 package e2e
 
 import (
+	"context"
 	"strconv"
 	"testing"
 	"time"
@@ -32,7 +33,7 @@ func TestMultinamespacePrometheusRule(t *testing.T) {
 
 	nsName := "openshift-test-prometheus-rules" + strconv.FormatInt(time.Now().Unix(), 36)
 
-	err := f.OperatorClient.CreateOrUpdateNamespace(&v1.Namespace{
+	err := f.OperatorClient.CreateOrUpdateNamespace(context.TODO(), &v1.Namespace{
 		ObjectMeta: metav1.ObjectMeta{
 			Name: nsName,
 			Labels: map[string]string{
@@ -43,9 +44,9 @@ func TestMultinamespacePrometheusRule(t *testing.T) {
 	if err != nil {
 		t.Fatal(err)
 	}
-	defer f.OperatorClient.DeleteIfExists(nsName)
+	defer f.OperatorClient.DeleteIfExists(context.TODO(), nsName)
 
-	err = f.OperatorClient.CreateOrUpdatePrometheusRule(&monv1.PrometheusRule{
+	err = f.OperatorClient.CreateOrUpdatePrometheusRule(context.TODO(), &monv1.PrometheusRule{
 		ObjectMeta: metav1.ObjectMeta{
 			Name:      "non-monitoring-prometheus-rules",
 			Namespace: nsName,
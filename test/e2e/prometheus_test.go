@@ -28,7 +28,7 @@ import (
 )
 
 func TestPrometheusVolumeClaim(t *testing.T) {
-	err := f.OperatorClient.WaitForStatefulsetRollout(&appsv1.StatefulSet{
+	err := f.OperatorClient.WaitForStatefulsetRollout(context.TODO(), &appsv1.StatefulSet{
 		ObjectMeta: metav1.ObjectMeta{
 			Name:      "prometheus-k8s",
 			Namespace: f.Ns,
@@ -55,7 +55,7 @@ func TestPrometheusVolumeClaim(t *testing.T) {
 		},
 	}
 
-	if err := f.OperatorClient.CreateOrUpdateConfigMap(cm); err != nil {
+	if err := f.OperatorClient.CreateOrUpdateConfigMap(context.TODO(), cm); err != nil {
 		t.Fatal(err)
 	}
 
@@ -76,7 +76,7 @@ func TestPrometheusVolumeClaim(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	err = f.OperatorClient.WaitForStatefulsetRollout(&appsv1.StatefulSet{
+	err = f.OperatorClient.WaitForStatefulsetRollout(context.TODO(), &appsv1.StatefulSet{
 		ObjectMeta: metav1.ObjectMeta{
 			Name:      "prometheus-k8s",
 			Namespace: f.Ns,
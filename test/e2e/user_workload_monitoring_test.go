@@ -124,7 +124,7 @@ func TestUserWorkloadMonitoringNewConfig(t *testing.T) {
 
 func assertPrometheusVCConfig(cm *v1.ConfigMap) func(*testing.T) {
 	return func(t *testing.T) {
-		if err := f.OperatorClient.CreateOrUpdateConfigMap(cm); err != nil {
+		if err := f.OperatorClient.CreateOrUpdateConfigMap(context.TODO(), cm); err != nil {
 			t.Fatal(err)
 		}
 
@@ -145,7 +145,7 @@ func assertPrometheusVCConfig(cm *v1.ConfigMap) func(*testing.T) {
 			t.Fatal(err)
 		}
 
-		err = f.OperatorClient.WaitForStatefulsetRollout(&appsv1.StatefulSet{
+		err = f.OperatorClient.WaitForStatefulsetRollout(context.TODO(), &appsv1.StatefulSet{
 			ObjectMeta: metav1.ObjectMeta{
 				Name:      "prometheus-user-workload",
 				Namespace: f.UserWorkloadMonitoringNs,
@@ -160,7 +160,7 @@ func assertPrometheusVCConfig(cm *v1.ConfigMap) func(*testing.T) {
 
 func createUserWorkloadAssets(cm *v1.ConfigMap) func(*testing.T) {
 	return func(t *testing.T) {
-		if err := f.OperatorClient.CreateOrUpdateConfigMap(cm); err != nil {
+		if err := f.OperatorClient.CreateOrUpdateConfigMap(context.TODO(), cm); err != nil {
 			t.Fatal(err)
 		}
 
@@ -190,7 +190,7 @@ func createUserWorkloadAssets(cm *v1.ConfigMap) func(*testing.T) {
 
 		// this will only poll if the statefulset is there in the first place
 		// otherwise it will fail immediately.
-		err = f.OperatorClient.WaitForPrometheus(&monitoringv1.Prometheus{
+		err = f.OperatorClient.WaitForPrometheus(context.TODO(), &monitoringv1.Prometheus{
 			ObjectMeta: metav1.ObjectMeta{
 				Name:      "user-workload",
 				Namespace: f.UserWorkloadMonitoringNs,
@@ -200,7 +200,7 @@ func createUserWorkloadAssets(cm *v1.ConfigMap) func(*testing.T) {
 			t.Fatal(err)
 		}
 
-		err = f.OperatorClient.WaitForStatefulsetRollout(&appsv1.StatefulSet{
+		err = f.OperatorClient.WaitForStatefulsetRollout(context.TODO(), &appsv1.StatefulSet{
 			ObjectMeta: metav1.ObjectMeta{
 				Name:      "prometheus-user-workload",
 				Namespace: f.UserWorkloadMonitoringNs,
@@ -225,7 +225,7 @@ func assertThanosRulerDeployment(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	err = f.OperatorClient.WaitForThanosRuler(&monitoringv1.ThanosRuler{
+	err = f.OperatorClient.WaitForThanosRuler(context.TODO(), &monitoringv1.ThanosRuler{
 		ObjectMeta: metav1.ObjectMeta{
 			Name:      "user-workload",
 			Namespace: f.UserWorkloadMonitoringNs,
@@ -235,7 +235,7 @@ func assertThanosRulerDeployment(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	err = f.OperatorClient.WaitForStatefulsetRollout(&appsv1.StatefulSet{
+	err = f.OperatorClient.WaitForStatefulsetRollout(context.TODO(), &appsv1.StatefulSet{
 		ObjectMeta: metav1.ObjectMeta{
 			Name:      "thanos-ruler-user-workload",
 			Namespace: f.UserWorkloadMonitoringNs,
@@ -424,7 +424,7 @@ func deployUserApplication(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	err = f.OperatorClient.WaitForDeploymentRollout(app)
+	err = f.OperatorClient.WaitForDeploymentRollout(context.TODO(), app)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -958,7 +958,7 @@ func assertGRPCTLSRotation(t *testing.T) {
 		return result
 	}
 
-	s, err := f.OperatorClient.WaitForSecret(&v1.Secret{
+	s, err := f.OperatorClient.WaitForSecret(context.TODO(), &v1.Secret{
 		ObjectMeta: metav1.ObjectMeta{
 			Name:      "grpc-tls",
 			Namespace: f.Ns,
@@ -974,7 +974,7 @@ func assertGRPCTLSRotation(t *testing.T) {
 
 	s.Annotations["monitoring.openshift.io/grpc-tls-forced-rotate"] = "true"
 
-	if err := f.OperatorClient.CreateOrUpdateSecret(s); err != nil {
+	if err := f.OperatorClient.CreateOrUpdateSecret(context.TODO(), s); err != nil {
 		t.Fatalf("error saving grpc-tls secret: %v", err)
 	}
 
@@ -1014,7 +1014,7 @@ func assertGRPCTLSRotation(t *testing.T) {
 
 func assertDeletedUserWorkloadAssets(cm *v1.ConfigMap) func(*testing.T) {
 	return func(t *testing.T) {
-		err := f.OperatorClient.DeleteConfigMap(cm)
+		err := f.OperatorClient.DeleteConfigMap(context.TODO(), cm)
 		if err != nil {
 			t.Fatal(err)
 		}